@@ -22,4 +22,10 @@ type VDRIController interface {
 
 	// GetDIDRecords retrieves the did doc containing name and didID.
 	GetDIDRecords(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// UpdateDID updates the did doc by applying the patches.
+	UpdateDID(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// DeactivateDID deactivates the did.
+	DeactivateDID(request *models.RequestEnvelope) *models.ResponseEnvelope
 }
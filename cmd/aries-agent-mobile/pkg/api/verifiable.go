@@ -37,6 +37,24 @@ type VerifiableController interface {
 	// GetCredentials retrieves the verifiable credential records containing name and fields of interest.
 	GetCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope
 
+	// QueryCredentials retrieves a page of the verifiable credential records matching the query.
+	QueryCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// GetExpiringCredentials retrieves the verifiable credential records expiring within the given number of days.
+	GetExpiringCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// RefreshCredential refreshes the stored verifiable credential using its refresh service.
+	RefreshCredential(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// AddTrustList adds the signed trust list credential to the issuer trust registry.
+	AddTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// RemoveTrustList removes the trust list from the issuer trust registry.
+	RemoveTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// GetTrustLists retrieves the trust lists of the issuer trust registry.
+	GetTrustLists(request *models.RequestEnvelope) *models.ResponseEnvelope
+
 	// GetPresentations retrieves the verifiable presentation records containing name and fields of interest.
 	GetPresentations(request *models.RequestEnvelope) *models.ResponseEnvelope
 
@@ -46,9 +64,19 @@ type VerifiableController interface {
 	// GeneratePresentationByID generates verifiable presentation from a stored verifiable credential.
 	GeneratePresentationByID(request *models.RequestEnvelope) *models.ResponseEnvelope
 
+	// GeneratePresentationByDefinition generates verifiable presentation satisfying the presentation definition
+	// from the stored verifiable credentials.
+	GeneratePresentationByDefinition(request *models.RequestEnvelope) *models.ResponseEnvelope
+
 	// RemoveCredentialByName will remove a VC that matches the specified name from the verifiable store.
 	RemoveCredentialByName(request *models.RequestEnvelope) *models.ResponseEnvelope
 
 	// RemovePresentationByName will remove a VP that matches the specified name from the verifiable store.
 	RemovePresentationByName(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// VerifyCredential verifies the verifiable credential according to the policy.
+	VerifyCredential(request *models.RequestEnvelope) *models.ResponseEnvelope
+
+	// VerifyPresentation verifies the verifiable presentation and its credentials according to the policy.
+	VerifyPresentation(request *models.RequestEnvelope) *models.ResponseEnvelope
 }
@@ -76,3 +76,35 @@ func (v *VDRI) GetDIDRecords(request *models.RequestEnvelope) *models.ResponseEn
 
 	return &models.ResponseEnvelope{Payload: response}
 }
+
+// UpdateDID updates the did doc by applying the patches.
+func (v *VDRI) UpdateDID(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdvdri.UpdateDIDArgs{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdvdri.UpdateDIDCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// DeactivateDID deactivates the did.
+func (v *VDRI) DeactivateDID(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdvdri.IDArg{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdvdri.DeactivateDIDCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
@@ -118,3 +118,43 @@ func TestVDRI_SaveDID(t *testing.T) {
 			string(resp.Payload))
 	})
 }
+
+func TestVDRI_UpdateDID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		vdriController := getVDRIController(t)
+
+		mockResponse := mockDocument
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		vdriController.handlers[cmdvdri.UpdateDIDCommandMethod] = fakeHandler.exec
+
+		payload := `{"id":"did:peer:21tDAKCERh95uGgKbJNHYp","patches":[{"action":"remove-services","ids":["#did-communication"]}]}`
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := vdriController.UpdateDID(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVDRI_DeactivateDID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		vdriController := getVDRIController(t)
+
+		mockResponse := emptyJSON
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		vdriController.handlers[cmdvdri.DeactivateDIDCommandMethod] = fakeHandler.exec
+
+		payload := mockDIDReq
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := vdriController.DeactivateDID(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
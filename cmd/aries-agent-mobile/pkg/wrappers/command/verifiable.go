@@ -183,6 +183,23 @@ func (v *Verifiable) GeneratePresentationByID(request *models.RequestEnvelope) *
 	return &models.ResponseEnvelope{Payload: response}
 }
 
+// GeneratePresentationByDefinition generates verifiable presentation satisfying the presentation definition
+// from the stored verifiable credentials.
+func (v *Verifiable) GeneratePresentationByDefinition(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.PresentationByDefinitionRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.GeneratePresentationByDefinitionCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
 // RemoveCredentialByName will remove a VC that matches the specified name from the verifiable store.
 func (v *Verifiable) RemoveCredentialByName(request *models.RequestEnvelope) *models.ResponseEnvelope {
 	args := cmdverifiable.NameArg{}
@@ -214,3 +231,125 @@ func (v *Verifiable) RemovePresentationByName(request *models.RequestEnvelope) *
 
 	return &models.ResponseEnvelope{Payload: response}
 }
+
+// VerifyCredential verifies the verifiable credential according to the policy.
+func (v *Verifiable) VerifyCredential(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.VerifyCredentialRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.VerifyCredentialCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// VerifyPresentation verifies the verifiable presentation and its credentials according to the policy.
+func (v *Verifiable) VerifyPresentation(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.VerifyPresentationRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.VerifyPresentationCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// QueryCredentials retrieves a page of the verifiable credential records matching the query.
+func (v *Verifiable) QueryCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.QueryCredentialsRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.QueryCredentialsCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// GetExpiringCredentials retrieves the verifiable credential records expiring within the given number of days.
+func (v *Verifiable) GetExpiringCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.ExpiringCredentialsRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.GetExpiringCredentialsCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// RefreshCredential refreshes the stored verifiable credential using its refresh service.
+func (v *Verifiable) RefreshCredential(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.RefreshCredentialRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.RefreshCredentialCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// AddTrustList adds the signed trust list credential to the issuer trust registry.
+func (v *Verifiable) AddTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.AddTrustListRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.AddTrustListCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// RemoveTrustList removes the trust list from the issuer trust registry.
+func (v *Verifiable) RemoveTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	args := cmdverifiable.RemoveTrustListRequest{}
+
+	if err := json.Unmarshal(request.Payload, &args); err != nil {
+		return &models.ResponseEnvelope{Error: &models.CommandError{Message: err.Error()}}
+	}
+
+	response, cmdErr := exec(v.handlers[cmdverifiable.RemoveTrustListCommandMethod], args)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
+
+// GetTrustLists retrieves the trust lists of the issuer trust registry.
+func (v *Verifiable) GetTrustLists(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	response, cmdErr := exec(v.handlers[cmdverifiable.GetTrustListsCommandMethod], request.Payload)
+	if cmdErr != nil {
+		return &models.ResponseEnvelope{Error: cmdErr}
+	}
+
+	return &models.ResponseEnvelope{Payload: response}
+}
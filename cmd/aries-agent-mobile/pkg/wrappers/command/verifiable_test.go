@@ -338,6 +338,31 @@ func TestVerifiable_GeneratePresentationByID(t *testing.T) {
 	})
 }
 
+func TestVerifiable_GeneratePresentationByDefinition(t *testing.T) {
+	t.Run("test it generates a presentation by definition", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := mockPresentationResponse
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.GeneratePresentationByDefinitionCommandMethod] = fakeHandler.exec
+
+		payload := fmt.Sprintf(`{
+		"presentationDefinition": {"input_descriptors": [{"id": "any"}]},
+		"selection": {"any": "%s"},
+		"did": "%s",
+		"signatureType": "%s"
+}`, mockCredentialID, mockDID, cmdverifiable.Ed25519Signature2018)
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := v.GeneratePresentationByDefinition(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
 func TestVerifiable_RemoveCredentialByName(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		v := getVerifiableController(t)
@@ -377,3 +402,145 @@ func TestVerifiable_RemovePresentationByName(t *testing.T) {
 			string(resp.Payload))
 	})
 }
+
+func TestVerifiable_VerifyCredential(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verified":true,"checks":[{"check":"format","passed":true}]}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.VerifyCredentialCommandMethod] = fakeHandler.exec
+
+		payload := `{"credential":{"id":"http://example.edu/credentials/1872"},"policy":{"expiry":true}}`
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := v.VerifyCredential(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVerifiable_VerifyPresentation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verified":true,"checks":[{"check":"format","passed":true}]}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.VerifyPresentationCommandMethod] = fakeHandler.exec
+
+		payload := `{"presentation":{"id":"urn:uuid:3978344f-8596-4c3a-a978-8fcaba3903c5"},"policy":{"proof":true}}`
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := v.VerifyPresentation(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVerifiable_QueryCredentials(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"result":[{"name":"sampleVCName","id":"http://example.edu/credentials/1989"}],"total":1}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.QueryCredentialsCommandMethod] = fakeHandler.exec
+
+		payload := `{"type":"UniversityDegreeCredential","limit":10}`
+
+		req := &models.RequestEnvelope{Payload: []byte(payload)}
+		resp := v.QueryCredentials(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVerifiable_GetExpiringCredentials(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"result":[{"name":"sampleVCName","id":"http://example.edu/credentials/1989"}]}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.GetExpiringCredentialsCommandMethod] = fakeHandler.exec
+
+		req := &models.RequestEnvelope{Payload: []byte(`{"days":30}`)}
+		resp := v.GetExpiringCredentials(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVerifiable_RefreshCredential(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verifiableCredential":{"id":"http://example.edu/credentials/1989"}}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.RefreshCredentialCommandMethod] = fakeHandler.exec
+
+		req := &models.RequestEnvelope{Payload: []byte(`{"name":"sampleVCName","signatureType":"Ed25519Signature2018"}`)}
+		resp := v.RefreshCredential(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
+
+func TestVerifiable_TrustLists(t *testing.T) {
+	t.Run("add trust list", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"trustList":{"id":"https://example.com/trust-lists/education"}}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.AddTrustListCommandMethod] = fakeHandler.exec
+
+		req := &models.RequestEnvelope{Payload: []byte(`{"trustList":{"id":"https://example.com/trust-lists/education"}}`)}
+		resp := v.AddTrustList(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+
+	t.Run("remove trust list", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		fakeHandler := mockCommandRunner{data: []byte(``)}
+		v.handlers[cmdverifiable.RemoveTrustListCommandMethod] = fakeHandler.exec
+
+		req := &models.RequestEnvelope{Payload: []byte(`{"id":"https://example.com/trust-lists/education"}`)}
+		resp := v.RemoveTrustList(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+	})
+
+	t.Run("get trust lists", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"trustLists":[{"id":"https://example.com/trust-lists/education"}]}`
+		fakeHandler := mockCommandRunner{data: []byte(mockResponse)}
+		v.handlers[cmdverifiable.GetTrustListsCommandMethod] = fakeHandler.exec
+
+		req := &models.RequestEnvelope{Payload: []byte(`{}`)}
+		resp := v.GetTrustLists(req)
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t,
+			mockResponse,
+			string(resp.Payload))
+	})
+}
@@ -150,6 +150,10 @@ func getVerifiableEndpoints() map[string]*endpoint {
 			Path:   opverifiable.GeneratePresentationByIDPath,
 			Method: http.MethodPost,
 		},
+		cmdverifiable.GeneratePresentationByDefinitionCommandMethod: {
+			Path:   opverifiable.GeneratePresentationByDefinitionPath,
+			Method: http.MethodPost,
+		},
 		cmdverifiable.RemoveCredentialByNameCommandMethod: {
 			Path:   opverifiable.RemoveCredentialByNamePath,
 			Method: http.MethodPost,
@@ -158,6 +162,38 @@ func getVerifiableEndpoints() map[string]*endpoint {
 			Path:   opverifiable.RemovePresentationByNamePath,
 			Method: http.MethodPost,
 		},
+		cmdverifiable.QueryCredentialsCommandMethod: {
+			Path:   opverifiable.QueryCredentialsPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.GetExpiringCredentialsCommandMethod: {
+			Path:   opverifiable.ExpiringCredentialsPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.RefreshCredentialCommandMethod: {
+			Path:   opverifiable.RefreshCredentialPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.AddTrustListCommandMethod: {
+			Path:   opverifiable.AddTrustListPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.RemoveTrustListCommandMethod: {
+			Path:   opverifiable.RemoveTrustListPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.GetTrustListsCommandMethod: {
+			Path:   opverifiable.GetTrustListsPath,
+			Method: http.MethodGet,
+		},
+		cmdverifiable.VerifyCredentialCommandMethod: {
+			Path:   opverifiable.VerifyCredentialPath,
+			Method: http.MethodPost,
+		},
+		cmdverifiable.VerifyPresentationCommandMethod: {
+			Path:   opverifiable.VerifyPresentationPath,
+			Method: http.MethodPost,
+		},
 	}
 }
 
@@ -330,6 +366,14 @@ func getVDRIEndpoints() map[string]*endpoint {
 			Path:   opvdri.ResolveDIDPath,
 			Method: http.MethodGet,
 		},
+		cmdvdri.UpdateDIDCommandMethod: {
+			Path:   opvdri.UpdateDIDPath,
+			Method: http.MethodPost,
+		},
+		cmdvdri.DeactivateDIDCommandMethod: {
+			Path:   opvdri.DeactivateDIDPath,
+			Method: http.MethodPost,
+		},
 	}
 }
 
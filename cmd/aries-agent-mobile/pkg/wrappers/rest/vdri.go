@@ -40,6 +40,16 @@ func (v *VDRI) GetDIDRecords(request *models.RequestEnvelope) *models.ResponseEn
 	return v.createRespEnvelope(request, cmdvdri.GetDIDsCommandMethod)
 }
 
+// UpdateDID updates the did doc by applying the patches.
+func (v *VDRI) UpdateDID(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return v.createRespEnvelope(request, cmdvdri.UpdateDIDCommandMethod)
+}
+
+// DeactivateDID deactivates the did.
+func (v *VDRI) DeactivateDID(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return v.createRespEnvelope(request, cmdvdri.DeactivateDIDCommandMethod)
+}
+
 func (v *VDRI) createRespEnvelope(request *models.RequestEnvelope, endpoint string) *models.ResponseEnvelope {
 	return exec(&restOperation{
 		url:        v.URL,
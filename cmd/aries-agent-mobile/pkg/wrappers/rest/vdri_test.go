@@ -122,3 +122,41 @@ func TestVDRI_SaveDID(t *testing.T) {
 		require.Equal(t, mockResponse, string(resp.Payload))
 	})
 }
+
+func TestVDRI_UpdateDID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		vdriController := getVDRIController(t)
+
+		reqData := `{"id":"did:peer:21tDAKCERh95uGgKbJNHYp","patches":[{"action":"remove-services","ids":["#did-communication"]}]}`
+
+		mockResponse := mockDocument
+		vdriController.httpClient = &mockHTTPClient{data: mockResponse,
+			method: http.MethodPost, url: mockAgentURL + opvdri.UpdateDIDPath}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := vdriController.UpdateDID(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVDRI_DeactivateDID(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		vdriController := getVDRIController(t)
+
+		reqData := mockDIDReq
+
+		mockResponse := emptyJSON
+		vdriController.httpClient = &mockHTTPClient{data: mockResponse,
+			method: http.MethodPost, url: mockAgentURL + opvdri.DeactivateDIDPath}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := vdriController.DeactivateDID(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
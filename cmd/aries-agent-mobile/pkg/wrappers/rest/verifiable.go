@@ -75,6 +75,12 @@ func (vr *Verifiable) GeneratePresentationByID(request *models.RequestEnvelope)
 	return vr.createRespEnvelope(request, cmdverifiable.GeneratePresentationByIDCommandMethod)
 }
 
+// GeneratePresentationByDefinition generates verifiable presentation satisfying the presentation definition
+// from the stored verifiable credentials.
+func (vr *Verifiable) GeneratePresentationByDefinition(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.GeneratePresentationByDefinitionCommandMethod)
+}
+
 // RemoveCredentialByName will remove a VC that matches the specified name from the verifiable store.
 func (vr *Verifiable) RemoveCredentialByName(request *models.RequestEnvelope) *models.ResponseEnvelope {
 	return vr.createRespEnvelope(request, cmdverifiable.RemoveCredentialByNameCommandMethod)
@@ -85,6 +91,46 @@ func (vr *Verifiable) RemovePresentationByName(request *models.RequestEnvelope)
 	return vr.createRespEnvelope(request, cmdverifiable.RemovePresentationByNameCommandMethod)
 }
 
+// QueryCredentials retrieves a page of the verifiable credential records matching the query.
+func (vr *Verifiable) QueryCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.QueryCredentialsCommandMethod)
+}
+
+// GetExpiringCredentials retrieves the verifiable credential records expiring within the given number of days.
+func (vr *Verifiable) GetExpiringCredentials(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.GetExpiringCredentialsCommandMethod)
+}
+
+// RefreshCredential refreshes the stored verifiable credential using its refresh service.
+func (vr *Verifiable) RefreshCredential(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.RefreshCredentialCommandMethod)
+}
+
+// AddTrustList adds the signed trust list credential to the issuer trust registry.
+func (vr *Verifiable) AddTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.AddTrustListCommandMethod)
+}
+
+// RemoveTrustList removes the trust list from the issuer trust registry.
+func (vr *Verifiable) RemoveTrustList(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.RemoveTrustListCommandMethod)
+}
+
+// GetTrustLists retrieves the trust lists of the issuer trust registry.
+func (vr *Verifiable) GetTrustLists(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.GetTrustListsCommandMethod)
+}
+
+// VerifyCredential verifies the verifiable credential according to the policy.
+func (vr *Verifiable) VerifyCredential(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.VerifyCredentialCommandMethod)
+}
+
+// VerifyPresentation verifies the verifiable presentation and its credentials according to the policy.
+func (vr *Verifiable) VerifyPresentation(request *models.RequestEnvelope) *models.ResponseEnvelope {
+	return vr.createRespEnvelope(request, cmdverifiable.VerifyPresentationCommandMethod)
+}
+
 func (vr *Verifiable) createRespEnvelope(request *models.RequestEnvelope, endpoint string) *models.ResponseEnvelope {
 	return exec(&restOperation{
 		url:        vr.URL,
@@ -329,6 +329,26 @@ func TestVerifiable_GeneratePresentationByID(t *testing.T) {
 	})
 }
 
+func TestVerifiable_GeneratePresentationByDefinition(t *testing.T) {
+	t.Run("test it performs a generate presentation by definition request", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := mockPresentationResponse
+		v.httpClient = &mockHTTPClient{data: mockResponse,
+			method: http.MethodPost, url: mockAgentURL + opverifiable.GeneratePresentationByDefinitionPath}
+
+		reqData := fmt.Sprintf(`{"presentationDefinition": {"input_descriptors": [{"id": "any"}]},
+"did": "%s", "signatureType": "%s"}`, mockDID, cmdverifiable.Ed25519Signature2018)
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.GeneratePresentationByDefinition(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
 func TestVerifiable_RemoveCredentialByName(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		v := getVerifiableController(t)
@@ -370,3 +390,165 @@ func TestVerifiable_RemovePresentationByName(t *testing.T) {
 		require.Equal(t, mockResponse, string(resp.Payload))
 	})
 }
+
+func TestVerifiable_VerifyCredential(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verified":true,"checks":[{"check":"format","passed":true}]}`
+		reqData := `{"credential":{"id":"http://example.edu/credentials/1872"},"policy":{"expiry":true}}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.VerifyCredentialPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.VerifyCredential(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVerifiable_VerifyPresentation(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verified":true,"checks":[{"check":"format","passed":true}]}`
+		reqData := `{"presentation":{"id":"urn:uuid:3978344f-8596-4c3a-a978-8fcaba3903c5"},"policy":{"proof":true}}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.VerifyPresentationPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.VerifyPresentation(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVerifiable_QueryCredentials(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"result":[{"name":"sampleVCName","id":"http://example.edu/credentials/1989"}],"total":1}`
+		reqData := `{"type":"UniversityDegreeCredential","limit":10}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.QueryCredentialsPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.QueryCredentials(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVerifiable_GetExpiringCredentials(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"result":[{"name":"sampleVCName","id":"http://example.edu/credentials/1989"}]}`
+		reqData := `{"days":30}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.ExpiringCredentialsPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.GetExpiringCredentials(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVerifiable_RefreshCredential(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"verifiableCredential":{"id":"http://example.edu/credentials/1989"}}`
+		reqData := `{"name":"sampleVCName","signatureType":"Ed25519Signature2018"}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.RefreshCredentialPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.RefreshCredential(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
+
+func TestVerifiable_TrustLists(t *testing.T) {
+	t.Run("add trust list", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"trustList":{"id":"https://example.com/trust-lists/education"}}`
+		reqData := `{"trustList":{"id":"https://example.com/trust-lists/education"}}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.AddTrustListPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.AddTrustList(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+
+	t.Run("remove trust list", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		reqData := `{"id":"https://example.com/trust-lists/education"}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.RemoveTrustListPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: `{}`, method: http.MethodPost, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.RemoveTrustList(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+	})
+
+	t.Run("get trust lists", func(t *testing.T) {
+		v := getVerifiableController(t)
+
+		mockResponse := `{"trustLists":[{"id":"https://example.com/trust-lists/education"}]}`
+		reqData := `{}`
+
+		mockURL, err := parseURL(mockAgentURL, opverifiable.GetTrustListsPath, reqData)
+		require.NoError(t, err, "failed to parse test url")
+
+		v.httpClient = &mockHTTPClient{data: mockResponse, method: http.MethodGet, url: mockURL}
+
+		req := &models.RequestEnvelope{Payload: []byte(reqData)}
+		resp := v.GetTrustLists(req)
+
+		require.NotNil(t, resp)
+		require.Nil(t, resp.Error)
+		require.Equal(t, mockResponse, string(resp.Payload))
+	})
+}
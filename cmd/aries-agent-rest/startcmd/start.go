@@ -21,6 +21,8 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/common/log"
 	"github.com/hyperledger/aries-framework-go/pkg/controller"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/rest"
+	vdrirest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/messaging/msghandler"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
 	arieshttp "github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/http"
@@ -138,6 +140,13 @@ const (
 		" Refer https://github.com/hyperledger/aries-framework-go/blob/8449c727c7c44f47ed7c9f10f35f0cd051dcb4e9/pkg/framework/aries/framework.go#L165-L168." + // nolint lll
 		" Alternatively, this can be set with the following environment variable: " + agentTransportReturnRouteEnvKey
 
+	// resolver only flag
+	agentResolverOnlyFlagName  = "resolver-only"
+	agentResolverOnlyEnvKey    = "ARIESD_RESOLVER_ONLY"
+	agentResolverOnlyFlagUsage = "Run slim server exposing only the DID resolver endpoint (GET /1.0/identifiers/{did})." +
+		" Possible values [true] [false]. Defaults to false if not set." +
+		" Alternatively, this can be set with the following environment variable: " + agentResolverOnlyEnvKey
+
 	httpProtocol      = "http"
 	websocketProtocol = "ws"
 )
@@ -152,7 +161,7 @@ type agentParameters struct {
 	token                                            string
 	webhookURLs, httpResolvers, outboundTransports   []string
 	inboundHostInternals, inboundHostExternals       []string
-	autoAccept                                       bool
+	autoAccept, resolverOnly                         bool
 	msgHandler                                       command.MessageHandler
 }
 
@@ -229,7 +238,12 @@ func createStartCMD(server server) *cobra.Command { //nolint funlen gocyclo
 				return err
 			}
 
-			autoAccept, err := getAutoAcceptValue(cmd)
+			autoAccept, err := getBoolValue(cmd, agentAutoAcceptFlagName, agentAutoAcceptEnvKey)
+			if err != nil {
+				return err
+			}
+
+			resolverOnly, err := getBoolValue(cmd, agentResolverOnlyFlagName, agentResolverOnlyEnvKey)
 			if err != nil {
 				return err
 			}
@@ -278,6 +292,7 @@ func createStartCMD(server server) *cobra.Command { //nolint funlen gocyclo
 				httpResolvers:        httpResolvers,
 				outboundTransports:   outboundTransports,
 				autoAccept:           autoAccept,
+				resolverOnly:         resolverOnly,
 				transportReturnRoute: transportReturnRoute,
 				tlsCertFile:          tlsCertFile,
 				tlsKeyFile:           tlsKeyFile,
@@ -288,8 +303,8 @@ func createStartCMD(server server) *cobra.Command { //nolint funlen gocyclo
 	}
 }
 
-func getAutoAcceptValue(cmd *cobra.Command) (bool, error) {
-	v, err := getUserSetVar(cmd, agentAutoAcceptFlagName, agentAutoAcceptEnvKey, true)
+func getBoolValue(cmd *cobra.Command, flagName, envKey string) (bool, error) {
+	v, err := getUserSetVar(cmd, flagName, envKey, true)
 	if err != nil {
 		return false, err
 	}
@@ -343,6 +358,9 @@ func createFlags(startCmd *cobra.Command) {
 	// transport return route option flag
 	startCmd.Flags().StringP(agentTransportReturnRouteFlagName, "", "", agentTransportReturnRouteFlagUsage)
 
+	// resolver only flag
+	startCmd.Flags().StringP(agentResolverOnlyFlagName, "", "", agentResolverOnlyFlagUsage)
+
 	// tls cert file
 	startCmd.Flags().StringP(agentTLSCertFileFlagName,
 		agentTLSCertFileFlagShorthand, "", agentTLSCertFileFlagUsage)
@@ -551,10 +569,7 @@ func startAgent(parameters *agentParameters) error {
 		return err
 	}
 
-	// get all HTTP REST API handlers available for controller API
-	handlers, err := controller.GetRESTHandlers(ctx, controller.WithWebhookURLs(parameters.webhookURLs...),
-		controller.WithDefaultLabel(parameters.defaultLabel), controller.WithAutoAccept(parameters.autoAccept),
-		controller.WithMessageHandler(parameters.msgHandler))
+	handlers, err := getRESTHandlers(ctx, parameters)
 	if err != nil {
 		return fmt.Errorf("failed to start aries agent rest on port [%s], failed to get rest service api :  %w",
 			parameters.host, err)
@@ -587,6 +602,18 @@ func startAgent(parameters *agentParameters) error {
 	return nil
 }
 
+// getRESTHandlers returns all HTTP REST API handlers available for controller API or only
+// the DID resolver handler in slim server mode.
+func getRESTHandlers(ctx *context.Provider, parameters *agentParameters) ([]rest.Handler, error) {
+	if parameters.resolverOnly {
+		return vdrirest.NewResolver(ctx.VDRIRegistry()).GetRESTHandlers(), nil
+	}
+
+	return controller.GetRESTHandlers(ctx, controller.WithWebhookURLs(parameters.webhookURLs...),
+		controller.WithDefaultLabel(parameters.defaultLabel), controller.WithAutoAccept(parameters.autoAccept),
+		controller.WithMessageHandler(parameters.msgHandler))
+}
+
 func createAriesAgent(parameters *agentParameters) (*context.Provider, error) {
 	var opts []aries.Option
 
@@ -81,6 +81,7 @@ func TestStartCmdContents(t *testing.T) {
 	checkFlagPropertiesCorrect(t, startCmd, agentInboundHostFlagName,
 		agentInboundHostFlagShorthand, agentInboundHostFlagUsage, "[]")
 	checkFlagPropertiesCorrect(t, startCmd, agentDBPathFlagName, agentDBPathFlagShorthand, agentDBPathFlagUsage, "")
+	checkFlagPropertiesCorrect(t, startCmd, agentResolverOnlyFlagName, "", agentResolverOnlyFlagUsage, "")
 }
 
 func checkFlagPropertiesCorrect(t *testing.T, cmd *cobra.Command, flagName,
@@ -583,7 +584,7 @@ func TestStartMultipleAgentsWithSameDBPath(t *testing.T) {
 	err := startAgent(parameters)
 
 	require.NotNil(t, err)
-	require.Contains(t, err.Error(), "failed to open vc store")
+	require.Contains(t, err.Error(), "failed to open context store")
 }
 
 func TestStartAriesErrorWithResolvers(t *testing.T) {
@@ -748,6 +749,59 @@ func TestStartAriesWithAutoAccept(t *testing.T) {
 	})
 }
 
+func TestStartAriesResolverOnly(t *testing.T) {
+	path, cleanup := generateTempDir(t)
+	defer cleanup()
+
+	testHostURL := randomURL()
+	testInboundHostURL := randomURL()
+
+	go func() {
+		parameters := &agentParameters{
+			server:               &HTTPServer{},
+			host:                 testHostURL,
+			inboundHostInternals: []string{httpProtocol + "@" + testInboundHostURL},
+			dbPath:               path,
+			defaultLabel:         "x",
+			resolverOnly:         true,
+		}
+
+		err := startAgent(parameters)
+		require.NoError(t, err)
+		require.FailNow(t, agentUnexpectedExitErrMsg+": "+err.Error())
+	}()
+
+	waitForServerToStart(t, testHostURL, testInboundHostURL)
+
+	newreq := func(url string) *http.Request {
+		r, err := http.NewRequest(http.MethodGet, url, nil)
+		require.NoError(t, err)
+
+		return r
+	}
+
+	runRequestTests(t, []requestTestParams{
+		{
+			name: "resolve did",
+			r: newreq(fmt.Sprintf("http://%s/1.0/identifiers/%s", testHostURL,
+				"did:peer:0z6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V")),
+			expectedStatus:     http.StatusOK,
+			expectResponseData: true,
+		},
+		{
+			name:               "resolve not existing did",
+			r:                  newreq(fmt.Sprintf("http://%s/1.0/identifiers/did:peer:123", testHostURL)),
+			expectedStatus:     http.StatusNotFound,
+			expectResponseData: true,
+		},
+		{
+			name:           "controller API is not served",
+			r:              newreq(fmt.Sprintf("http://%s/connections", testHostURL)),
+			expectedStatus: http.StatusNotFound,
+		},
+	})
+}
+
 func TestStartAriesTLS(t *testing.T) {
 	path, cleanup := generateTempDir(t)
 	defer cleanup()
@@ -70,6 +70,14 @@ const pkgs = {
             path: "/vdri/did/records",
             method: "GET",
         },
+        UpdateDID: {
+            path: "/vdri/did/update",
+            method: "POST"
+        },
+        DeactivateDID: {
+            path: "/vdri/did/deactivate",
+            method: "POST"
+        },
     },
     messaging: {
         RegisteredServices: {
@@ -146,6 +154,30 @@ const pkgs = {
             path: "/verifiable/credentials",
             method: "GET",
         },
+        QueryCredentials: {
+            path: "/verifiable/credentials/query",
+            method: "POST"
+        },
+        GetExpiringCredentials: {
+            path: "/verifiable/credentials/expiring",
+            method: "POST"
+        },
+        RefreshCredential: {
+            path: "/verifiable/credential/refresh",
+            method: "POST"
+        },
+        AddTrustList: {
+            path: "/verifiable/trustlist",
+            method: "POST"
+        },
+        RemoveTrustList: {
+            path: "/verifiable/trustlist/remove",
+            method: "POST"
+        },
+        GetTrustLists: {
+            path: "/verifiable/trustlists",
+            method: "GET"
+        },
         SignCredential: {
             path: "/verifiable/signcredential",
             method: "POST"
@@ -159,6 +191,10 @@ const pkgs = {
             method: "GET",
             pathParam:"id"
         },
+        GeneratePresentationByDefinition: {
+            path: "/verifiable/presentation/generatebydefinition",
+            method: "POST"
+        },
         SavePresentation: {
             path: "/verifiable/presentation",
             method: "POST"
@@ -172,6 +208,14 @@ const pkgs = {
             path: "/verifiable/presentations",
             method: "GET",
         },
+        VerifyCredential: {
+            path: "/verifiable/credential/verify",
+            method: "POST"
+        },
+        VerifyPresentation: {
+            path: "/verifiable/presentation/verify",
+            method: "POST"
+        },
     },
     introduce:{
         Actions: {
@@ -395,6 +439,12 @@ const pkgs = {
             path: "/legacykms/keyset",
             method: "POST",
         }
+    },
+    jsonld: {
+        AddContexts: {
+            path: "/jsonld/context/add",
+            method: "POST",
+        }
     }
 }
 
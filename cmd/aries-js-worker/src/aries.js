@@ -852,6 +852,26 @@ const Aries = function (opts) {
             getDIDRecords: async function () {
                 return invoke(aw, pending, this.pkgname, "GetDIDRecords", {}, "timeout while retrieving did records")
             },
+
+            /**
+             * Updates a did document by applying the patches.
+             *
+             * @param req - json document containing did and patches
+             * @returns {Promise<Object>}
+             */
+            updateDID: async function (req) {
+                return invoke(aw, pending, this.pkgname, "UpdateDID", req, "timeout while updating did document")
+            },
+
+            /**
+             * Deactivates a did.
+             *
+             * @param req - json document containing did
+             * @returns {Promise<Object>}
+             */
+            deactivateDID: async function (req) {
+                return invoke(aw, pending, this.pkgname, "DeactivateDID", req, "timeout while deactivating did")
+            },
         },
 
         /**
@@ -977,6 +997,66 @@ const Aries = function (opts) {
                 return invoke(aw, pending, this.pkgname, "GetCredentials", {}, "timeout while retrieving verifiable credentials")
             },
 
+            /**
+             * Retrieves a page of verifiable credential records matching the query
+             * (type, issuer, subject, context, issuance/expiration range and name text).
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            queryCredentials: async function (req) {
+                return invoke(aw, pending, this.pkgname, "QueryCredentials", req, "timeout while querying verifiable credentials")
+            },
+
+            /**
+             * Retrieves the verifiable credential records expiring within the given number of days sorted by expiration date.
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            getExpiringCredentials: async function (req) {
+                return invoke(aw, pending, this.pkgname, "GetExpiringCredentials", req, "timeout while retrieving expiring verifiable credentials")
+            },
+
+            /**
+             * Refreshes the stored verifiable credential using its refresh service.
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            refreshCredential: async function (req) {
+                return invoke(aw, pending, this.pkgname, "RefreshCredential", req, "timeout while refreshing verifiable credential")
+            },
+
+            /**
+             * Verifies the signed trust list credential and adds it to the issuer trust registry.
+             *
+             * @param req - json document containing the signed trust list credential
+             * @returns {Promise<Object>}
+             */
+            addTrustList: async function (req) {
+                return invoke(aw, pending, this.pkgname, "AddTrustList", req, "timeout while adding trust list")
+            },
+
+            /**
+             * Removes the trust list from the issuer trust registry.
+             *
+             * @param req - json document containing the trust list ID
+             * @returns {Promise<Object>}
+             */
+            removeTrustList: async function (req) {
+                return invoke(aw, pending, this.pkgname, "RemoveTrustList", req, "timeout while removing trust list")
+            },
+
+            /**
+             * Retrieves the trust lists of the issuer trust registry.
+             *
+             * @returns {Promise<Object>}
+             */
+            getTrustLists: async function () {
+                return invoke(aw, pending, this.pkgname, "GetTrustLists", {}, "timeout while retrieving trust lists")
+            },
+
             /**
              * Signs and adds proof to given credential using provided proof options
              *
@@ -1007,6 +1087,16 @@ const Aries = function (opts) {
                 return invoke(aw, pending,  this.pkgname, "GeneratePresentationByID", req, "timeout while generating verifiable presentation by id")
             },
 
+            /**
+             * Generates a verifiable presentation satisfying the presentation definition from the stored verifiable credentials.
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            generatePresentationByDefinition: async function (req) {
+                return invoke(aw, pending,  this.pkgname, "GeneratePresentationByDefinition", req, "timeout while generating verifiable presentation by definition")
+            },
+
             /**
              * Saves a presentation.
              *
@@ -1035,6 +1125,27 @@ const Aries = function (opts) {
             getPresentations: async function () {
                 return invoke(aw, pending, this.pkgname, "GetPresentations", {}, "timeout while retrieving presentations")
             },
+
+            /**
+             * Verifies a verifiable credential according to the policy and returns a report of the checks made.
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            verifyCredential: async function (req) {
+                return invoke(aw, pending, this.pkgname, "VerifyCredential", req, "timeout while verifying verifiable credential")
+            },
+
+            /**
+             * Verifies a verifiable presentation and its credentials according to the policy and returns
+             * a report of the checks made.
+             *
+             * @param req - json document
+             * @returns {Promise<Object>}
+             */
+            verifyPresentation: async function (req) {
+                return invoke(aw, pending, this.pkgname, "VerifyPresentation", req, "timeout while verifying verifiable presentation")
+            },
         },
 
         /**
@@ -1078,6 +1189,23 @@ const Aries = function (opts) {
             createKeySet: async function () {
                 return invoke(aw, pending, this.pkgname, "CreateKeySet", {}, "timeout while creating key set")
             },
+        },
+
+        /**
+         * JSON-LD contexts - Refer to [OpenAPI spec](docs/rest/openapi_spec.md#generate-openapi-spec) for
+         * input params and output return json values.
+         */
+        jsonld: {
+            pkgname: "jsonld",
+
+            /**
+             * Adds JSON-LD context documents to the agent's document loader.
+             *
+             * @returns {Promise<Object>}
+             */
+            addContexts: async function (req) {
+                return invoke(aw, pending, this.pkgname, "AddContexts", req, "timeout while adding JSON-LD contexts")
+            },
         }
     }
 
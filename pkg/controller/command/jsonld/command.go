@@ -0,0 +1,100 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/internal/cmdutil"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/logutil"
+)
+
+var logger = log.New("aries-framework/command/jsonld")
+
+// Error codes
+const (
+	// InvalidRequestErrorCode is typically a code for invalid requests.
+	InvalidRequestErrorCode = command.Code(iota + command.JSONLD)
+	// AddContextsErrorCode is for failures while adding JSON-LD contexts.
+	AddContextsErrorCode
+)
+
+// constants for JSON-LD commands
+const (
+	// command name
+	CommandName = "jsonld"
+
+	// command methods
+	AddContextsCommandMethod = "AddContexts"
+
+	// error messages
+	errEmptyDocuments   = "context documents are mandatory"
+	errNoDocumentLoader = "JSON-LD document loader is not configured"
+)
+
+// provider contains dependencies for the JSON-LD command and is typically created by using aries.Context().
+type provider interface {
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
+}
+
+// Command contains command operations for managing JSON-LD contexts.
+type Command struct {
+	ctx provider
+}
+
+// New returns new JSON-LD command instance.
+func New(p provider) *Command {
+	return &Command{ctx: p}
+}
+
+// GetHandlers returns list of all commands supported by this controller command.
+func (c *Command) GetHandlers() []command.Handler {
+	return []command.Handler{
+		cmdutil.NewCommandHandler(CommandName, AddContextsCommandMethod, c.AddContexts),
+	}
+}
+
+// AddContexts adds JSON-LD context documents to the agent's document loader. Added contexts are persisted
+// and are used for JSON-LD processing without fetching them from the network.
+func (c *Command) AddContexts(rw io.Writer, req io.Reader) command.Error {
+	var request AddContextsRequest
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, AddContextsCommandMethod, err.Error())
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("failed request decode : %w", err))
+	}
+
+	if len(request.Documents) == 0 {
+		logutil.LogDebug(logger, CommandName, AddContextsCommandMethod, errEmptyDocuments)
+		return command.NewValidationError(InvalidRequestErrorCode, errors.New(errEmptyDocuments))
+	}
+
+	loader := c.ctx.JSONLDDocumentLoader()
+	if loader == nil {
+		logutil.LogError(logger, CommandName, AddContextsCommandMethod, errNoDocumentLoader)
+		return command.NewExecuteError(AddContextsErrorCode, errors.New(errNoDocumentLoader))
+	}
+
+	err = loader.AddContexts(request.Documents...)
+	if err != nil {
+		logutil.LogError(logger, CommandName, AddContextsCommandMethod, err.Error())
+		return command.NewExecuteError(AddContextsErrorCode, fmt.Errorf("add contexts: %w", err))
+	}
+
+	command.WriteNillableResponse(rw, nil, logger)
+
+	logutil.LogDebug(logger, CommandName, AddContextsCommandMethod, "success")
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstorage "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+const (
+	testContextURL = "https://example.com/context/v1"
+	testContext    = `{"@context":{"name":"http://schema.org/name"}}`
+)
+
+func TestNew(t *testing.T) {
+	cmd := New(&mockprovider.Provider{})
+	require.NotNil(t, cmd)
+	require.Len(t, cmd.GetHandlers(), 1)
+}
+
+func TestCommand_AddContexts(t *testing.T) {
+	t.Run("add contexts - success", func(t *testing.T) {
+		storeProvider := mockstorage.NewMockStoreProvider()
+
+		loader, err := jsonld.NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+
+		cmd := New(&mockprovider.Provider{JSONLDDocumentLoaderValue: loader})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBuffer(addContextsRequest(t, testContextURL, testContext)))
+		require.NoError(t, cmdErr)
+
+		doc, err := loader.LoadDocument(testContextURL)
+		require.NoError(t, err)
+		require.Equal(t, testContextURL, doc.DocumentURL)
+
+		// context is persisted
+		loader, err = jsonld.NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+
+		_, err = loader.LoadDocument(testContextURL)
+		require.NoError(t, err)
+	})
+
+	t.Run("add contexts - invalid request", func(t *testing.T) {
+		cmd := New(&mockprovider.Provider{})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+		require.Equal(t, command.ValidationError, cmdErr.Type())
+		require.Contains(t, cmdErr.Error(), "failed request decode")
+	})
+
+	t.Run("add contexts - no documents", func(t *testing.T) {
+		cmd := New(&mockprovider.Provider{})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBufferString(`{"documents":[]}`))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+		require.EqualError(t, cmdErr, errEmptyDocuments)
+	})
+
+	t.Run("add contexts - no document loader", func(t *testing.T) {
+		cmd := New(&mockprovider.Provider{})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBuffer(addContextsRequest(t, testContextURL, testContext)))
+		require.Error(t, cmdErr)
+		require.Equal(t, AddContextsErrorCode, cmdErr.Code())
+		require.Equal(t, command.ExecuteError, cmdErr.Type())
+		require.EqualError(t, cmdErr, errNoDocumentLoader)
+	})
+
+	t.Run("add contexts - invalid context", func(t *testing.T) {
+		loader, err := jsonld.NewDocumentLoader(mockstorage.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		cmd := New(&mockprovider.Provider{JSONLDDocumentLoaderValue: loader})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBuffer(addContextsRequest(t, testContextURL, `{"name":"value"}`)))
+		require.Error(t, cmdErr)
+		require.Equal(t, AddContextsErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), "@context is missing")
+	})
+
+	t.Run("add contexts - store error", func(t *testing.T) {
+		storeProvider := mockstorage.NewMockStoreProvider()
+		storeProvider.Store.ErrPut = errors.New("put error")
+
+		loader, err := jsonld.NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+
+		cmd := New(&mockprovider.Provider{JSONLDDocumentLoaderValue: loader})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.AddContexts(&rw, bytes.NewBuffer(addContextsRequest(t, testContextURL, testContext)))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), "put error")
+	})
+}
+
+func addContextsRequest(t *testing.T, url, content string) []byte {
+	t.Helper()
+
+	request, err := json.Marshal(&AddContextsRequest{
+		Documents: []jsonld.ContextDocument{{URL: url, Content: json.RawMessage(content)}},
+	})
+	require.NoError(t, err)
+
+	return request
+}
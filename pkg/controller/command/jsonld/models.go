@@ -0,0 +1,16 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+)
+
+// AddContextsRequest is model for add JSON-LD contexts request.
+type AddContextsRequest struct {
+	Documents []jsonld.ContextDocument `json:"documents"`
+}
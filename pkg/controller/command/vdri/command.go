@@ -36,6 +36,12 @@ const (
 
 	// ResolveDIDErrorCode for get did error.
 	ResolveDIDErrorCode
+
+	// UpdateDIDErrorCode for update did error.
+	UpdateDIDErrorCode
+
+	// DeactivateDIDErrorCode for deactivate did error.
+	DeactivateDIDErrorCode
 )
 
 // constants for the VDRI controller's methods
@@ -44,10 +50,12 @@ const (
 	CommandName = "vdri"
 
 	// command methods
-	SaveDIDCommandMethod    = "SaveDID"
-	GetDIDsCommandMethod    = "GetDIDRecords"
-	GetDIDCommandMethod     = "GetDID"
-	ResolveDIDCommandMethod = "ResolveDID"
+	SaveDIDCommandMethod       = "SaveDID"
+	GetDIDsCommandMethod       = "GetDIDRecords"
+	GetDIDCommandMethod        = "GetDID"
+	ResolveDIDCommandMethod    = "ResolveDID"
+	UpdateDIDCommandMethod     = "UpdateDID"
+	DeactivateDIDCommandMethod = "DeactivateDID"
 
 	// error messages
 	errEmptyDIDName = "name is mandatory"
@@ -90,6 +98,8 @@ func (o *Command) GetHandlers() []command.Handler {
 		cmdutil.NewCommandHandler(CommandName, GetDIDCommandMethod, o.GetDID),
 		cmdutil.NewCommandHandler(CommandName, GetDIDsCommandMethod, o.GetDIDRecords),
 		cmdutil.NewCommandHandler(CommandName, ResolveDIDCommandMethod, o.ResolveDID),
+		cmdutil.NewCommandHandler(CommandName, UpdateDIDCommandMethod, o.UpdateDID),
+		cmdutil.NewCommandHandler(CommandName, DeactivateDIDCommandMethod, o.DeactivateDID),
 	}
 }
 
@@ -134,6 +144,84 @@ func (o *Command) ResolveDID(rw io.Writer, req io.Reader) command.Error {
 	return nil
 }
 
+// UpdateDID applies patches to the did document.
+func (o *Command) UpdateDID(rw io.Writer, req io.Reader) command.Error {
+	var request UpdateDIDArgs
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, UpdateDIDCommandMethod, err.Error())
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.ID == "" {
+		logutil.LogDebug(logger, CommandName, UpdateDIDCommandMethod, errEmptyDIDID)
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf(errEmptyDIDID))
+	}
+
+	patches, err := toPatches(request.ID, request.Patches)
+	if err != nil {
+		logutil.LogDebug(logger, CommandName, UpdateDIDCommandMethod, "invalid patches: "+err.Error())
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("invalid patches: %w", err))
+	}
+
+	didDoc, err := o.ctx.VDRIRegistry().Update(request.ID, patches...)
+	if err != nil {
+		logutil.LogError(logger, CommandName, UpdateDIDCommandMethod, "update did doc: "+err.Error(),
+			logutil.CreateKeyValueString(didID, request.ID))
+
+		return command.NewExecuteError(UpdateDIDErrorCode, fmt.Errorf("update did doc: %w", err))
+	}
+
+	docBytes, err := didDoc.JSONBytes()
+	if err != nil {
+		logutil.LogError(logger, CommandName, UpdateDIDCommandMethod, "unmarshal did doc: "+err.Error(),
+			logutil.CreateKeyValueString(didID, request.ID))
+
+		return command.NewExecuteError(UpdateDIDErrorCode, fmt.Errorf("unmarshal did doc: %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &Document{
+		DID: json.RawMessage(docBytes),
+	}, logger)
+
+	logutil.LogDebug(logger, CommandName, UpdateDIDCommandMethod, "success",
+		logutil.CreateKeyValueString(didID, request.ID))
+
+	return nil
+}
+
+// DeactivateDID deactivates did.
+func (o *Command) DeactivateDID(rw io.Writer, req io.Reader) command.Error {
+	var request IDArg
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, DeactivateDIDCommandMethod, err.Error())
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.ID == "" {
+		logutil.LogDebug(logger, CommandName, DeactivateDIDCommandMethod, errEmptyDIDID)
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf(errEmptyDIDID))
+	}
+
+	err = o.ctx.VDRIRegistry().Deactivate(request.ID)
+	if err != nil {
+		logutil.LogError(logger, CommandName, DeactivateDIDCommandMethod, "deactivate did: "+err.Error(),
+			logutil.CreateKeyValueString(didID, request.ID))
+
+		return command.NewExecuteError(DeactivateDIDErrorCode, fmt.Errorf("deactivate did: %w", err))
+	}
+
+	command.WriteNillableResponse(rw, nil, logger)
+
+	logutil.LogDebug(logger, CommandName, DeactivateDIDCommandMethod, "success",
+		logutil.CreateKeyValueString(didID, request.ID))
+
+	return nil
+}
+
 // SaveDID saves the did doc to the store.
 func (o *Command) SaveDID(rw io.Writer, req io.Reader) command.Error {
 	request := &DIDArgs{}
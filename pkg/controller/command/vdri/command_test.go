@@ -51,7 +51,7 @@ func TestNew(t *testing.T) {
 		require.NoError(t, err)
 
 		handlers := cmd.GetHandlers()
-		require.Equal(t, 4, len(handlers))
+		require.Equal(t, 6, len(handlers))
 	})
 
 	t.Run("test new command - did store error", func(t *testing.T) {
@@ -345,3 +345,161 @@ func TestGetDIDRecords(t *testing.T) {
 		require.Equal(t, 1, len(response.Result))
 	})
 }
+
+func TestUpdateDID(t *testing.T) {
+	const didID = "did:peer:21tDAKCERh95uGgKbJNHYp"
+
+	newCmd := func(t *testing.T, registry *mockvdri.MockVDRIRegistry) *Command {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    registry,
+		})
+		require.NoError(t, err)
+
+		return cmd
+	}
+
+	t.Run("test update did - success", func(t *testing.T) {
+		didDoc, err := did.ParseDocument([]byte(doc))
+		require.NoError(t, err)
+
+		cmd := newCmd(t, &mockvdri.MockVDRIRegistry{ResolveValue: didDoc})
+
+		request := `{"id": "` + didID + `", "patches": [
+			{"action": "add-public-keys", "relationships": ["authentication"], "document": {"publicKey": [{
+				"id": "` + didID + `#key-3",
+				"type": "Ed25519VerificationKey2018",
+				"controller": "` + didID + `",
+				"publicKeyBase58": "H3C2AVvLMv6gmMNam3uVAjZpfkcJCwDwnZn6z3wXmqPV"
+			}]}},
+			{"action": "remove-public-keys", "ids": ["did:peer:123456789abcdefghw#key2"]},
+			{"action": "add-services", "document": {"service": [{
+				"id": "#did-communication",
+				"type": "did-communication",
+				"serviceEndpoint": "https://agent.example.com/"
+			}]}},
+			{"action": "remove-services", "ids": ["#did-communication"]}
+		]}`
+
+		var rw bytes.Buffer
+		cmdErr := cmd.UpdateDID(&rw, bytes.NewBufferString(request))
+		require.NoError(t, cmdErr)
+
+		response := Document{}
+		require.NoError(t, json.NewDecoder(&rw).Decode(&response))
+
+		updated, err := did.ParseDocument(response.DID)
+		require.NoError(t, err)
+		require.Len(t, updated.PublicKey, 2)
+		require.Equal(t, didID+"#key-3", updated.PublicKey[1].ID)
+		require.Len(t, updated.Authentication, 1)
+		require.Empty(t, updated.Service)
+	})
+
+	t.Run("test update did - replace", func(t *testing.T) {
+		didDoc, err := did.ParseDocument([]byte(doc))
+		require.NoError(t, err)
+
+		cmd := newCmd(t, &mockvdri.MockVDRIRegistry{ResolveValue: didDoc})
+
+		request := `{"id": "` + didID + `", "patches": [{"action": "replace", "document": {"service": [{
+			"id": "#did-communication",
+			"type": "did-communication",
+			"serviceEndpoint": "https://agent.example.com/"
+		}]}}]}`
+
+		var rw bytes.Buffer
+		require.NoError(t, cmd.UpdateDID(&rw, bytes.NewBufferString(request)))
+
+		response := Document{}
+		require.NoError(t, json.NewDecoder(&rw).Decode(&response))
+
+		updated, err := did.ParseDocument(response.DID)
+		require.NoError(t, err)
+		require.Empty(t, updated.PublicKey)
+		require.Len(t, updated.Service, 1)
+	})
+
+	t.Run("test update did - invalid request", func(t *testing.T) {
+		cmd := newCmd(t, &mockvdri.MockVDRIRegistry{})
+
+		tests := []struct {
+			request string
+			err     string
+		}{
+			{request: "--", err: "request decode"},
+			{request: `{}`, err: errEmptyDIDID},
+			{request: `{"id": "did:peer:123"}`, err: "at least one patch is required"},
+			{request: `{"id": "did:peer:123", "patches": [{"action": "unknown"}]}`, err: "unsupported action"},
+			{request: `{"id": "did:peer:123", "patches": [{"action": "add-services"}]}`, err: "document is mandatory"},
+			{request: `{"id": "did:peer:123", "patches": [{"action": "replace", "document": "invalid"}]}`,
+				err: "unmarshal document"},
+			{request: `{"id": "did:peer:123", "patches": [{"action": "add-public-keys", "document": {"id": 1}}]}`,
+				err: "parse document"},
+			{request: `{"id": "did:peer:123", "patches": [{"action": "add-public-keys", "document": {},
+				"relationships": ["unknown"]}]}`, err: "unsupported verification relationship: unknown"},
+		}
+
+		for _, test := range tests {
+			var rw bytes.Buffer
+			cmdErr := cmd.UpdateDID(&rw, bytes.NewBufferString(test.request))
+			require.Error(t, cmdErr)
+			require.Contains(t, cmdErr.Error(), test.err)
+			require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+		}
+	})
+
+	t.Run("test update did - update error", func(t *testing.T) {
+		cmd := newCmd(t, &mockvdri.MockVDRIRegistry{ResolveErr: fmt.Errorf("resolve error")})
+
+		var rw bytes.Buffer
+		cmdErr := cmd.UpdateDID(&rw, bytes.NewBufferString(`{"id": "did:peer:123", "patches": [
+			{"action": "remove-services", "ids": ["#svc"]}]}`))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), "resolve error")
+		require.Equal(t, UpdateDIDErrorCode, cmdErr.Code())
+	})
+}
+
+func TestDeactivateDID(t *testing.T) {
+	t.Run("test deactivate did - success", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{},
+		})
+		require.NoError(t, err)
+
+		var rw bytes.Buffer
+		require.NoError(t, cmd.DeactivateDID(&rw, bytes.NewBufferString(`{"id": "did:peer:123"}`)))
+	})
+
+	t.Run("test deactivate did - invalid request", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+
+		var rw bytes.Buffer
+		cmdErr := cmd.DeactivateDID(&rw, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), "request decode")
+
+		cmdErr = cmd.DeactivateDID(&rw, bytes.NewBufferString("{}"))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), errEmptyDIDID)
+	})
+
+	t.Run("test deactivate did - error", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{DeactivateErr: fmt.Errorf("deactivate error")},
+		})
+		require.NoError(t, err)
+
+		var rw bytes.Buffer
+		cmdErr := cmd.DeactivateDID(&rw, bytes.NewBufferString(`{"id": "did:peer:123"}`))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), "deactivate error")
+		require.Equal(t, DeactivateDIDErrorCode, cmdErr.Code())
+	})
+}
@@ -46,3 +46,44 @@ type NameArg struct {
 	// Name
 	Name string `json:"name"`
 }
+
+// Patch actions.
+const (
+	AddPublicKeysAction    = "add-public-keys"
+	RemovePublicKeysAction = "remove-public-keys"
+	AddServicesAction      = "add-services"
+	RemoveServicesAction   = "remove-services"
+	ReplaceAction          = "replace"
+)
+
+// PatchArg model
+//
+// This is used to describe single change of the did document.
+//
+type PatchArg struct {
+	// Action is one of add-public-keys, remove-public-keys, add-services, remove-services and replace.
+	Action string `json:"action"`
+
+	// Document holds public keys or services to add, or the replacement did document.
+	// Context and id of the document are optional.
+	Document json.RawMessage `json:"document,omitempty"`
+
+	// IDs of public keys or services to remove.
+	IDs []string `json:"ids,omitempty"`
+
+	// Relationships of the added public keys (authentication, assertionMethod, capabilityDelegation,
+	// capabilityInvocation or keyAgreement).
+	Relationships []string `json:"relationships,omitempty"`
+}
+
+// UpdateDIDArgs model
+//
+// This is used to update the did document.
+//
+type UpdateDIDArgs struct {
+	// DidID
+	ID string `json:"id"`
+
+	// Patches to apply
+	Patches []PatchArg `json:"patches"`
+}
@@ -0,0 +1,122 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const defaultDIDContext = "https://w3id.org/did/v1"
+
+// nolint: gochecknoglobals
+var relationships = map[string]did.VerificationRelationship{
+	"authentication":       did.Authentication,
+	"assertionMethod":      did.AssertionMethod,
+	"capabilityDelegation": did.CapabilityDelegation,
+	"capabilityInvocation": did.CapabilityInvocation,
+	"keyAgreement":         did.KeyAgreement,
+}
+
+func toPatches(didID string, args []PatchArg) ([]vdriapi.Patch, error) {
+	if len(args) == 0 {
+		return nil, errors.New("at least one patch is required")
+	}
+
+	patches := make([]vdriapi.Patch, len(args))
+
+	for i, arg := range args {
+		patch, err := toPatch(didID, &arg) // nolint: scopelint
+		if err != nil {
+			return nil, fmt.Errorf("patch %d: %w", i, err)
+		}
+
+		patches[i] = patch
+	}
+
+	return patches, nil
+}
+
+func toPatch(didID string, arg *PatchArg) (vdriapi.Patch, error) {
+	switch arg.Action {
+	case AddPublicKeysAction:
+		doc, err := parsePartialDoc(didID, arg.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		var rels []did.VerificationRelationship
+
+		for _, name := range arg.Relationships {
+			r, ok := relationships[name]
+			if !ok {
+				return nil, fmt.Errorf("unsupported verification relationship: %s", name)
+			}
+
+			rels = append(rels, r)
+		}
+
+		return &vdriapi.AddPublicKeysPatch{PublicKeys: doc.PublicKey, Relationships: rels}, nil
+	case RemovePublicKeysAction:
+		return &vdriapi.RemovePublicKeysPatch{IDs: arg.IDs}, nil
+	case AddServicesAction:
+		doc, err := parsePartialDoc(didID, arg.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		return &vdriapi.AddServicesPatch{Services: doc.Service}, nil
+	case RemoveServicesAction:
+		return &vdriapi.RemoveServicesPatch{IDs: arg.IDs}, nil
+	case ReplaceAction:
+		doc, err := parsePartialDoc(didID, arg.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		return &vdriapi.ReplacePatch{Document: doc}, nil
+	default:
+		return nil, fmt.Errorf("unsupported action: %s", arg.Action)
+	}
+}
+
+// parsePartialDoc parses the did document which may omit the context and the id.
+func parsePartialDoc(didID string, data json.RawMessage) (*did.Doc, error) {
+	if len(data) == 0 {
+		return nil, errors.New("document is mandatory")
+	}
+
+	var raw map[string]interface{}
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal document: %w", err)
+	}
+
+	if _, ok := raw["@context"]; !ok {
+		raw["@context"] = []string{defaultDIDContext}
+	}
+
+	if _, ok := raw["id"]; !ok {
+		raw["id"] = didID
+	}
+
+	docBytes, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("marshal document: %w", err)
+	}
+
+	doc, err := did.ParseDocument(docBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse document: %w", err)
+	}
+
+	return doc, nil
+}
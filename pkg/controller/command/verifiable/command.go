@@ -12,16 +12,20 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/common/log"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/internal/cmdutil"
 	ariescrypto "github.com/hyperledger/aries-framework-go/pkg/crypto"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	verifiablesigner "github.com/hyperledger/aries-framework-go/pkg/doc/signature/signer"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ecdsasecp256r1signature2019"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2020"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/jsonwebsignature2020"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
@@ -29,6 +33,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
 	"github.com/hyperledger/aries-framework-go/pkg/storage"
 	didstore "github.com/hyperledger/aries-framework-go/pkg/store/did"
+	"github.com/hyperledger/aries-framework-go/pkg/store/trustregistry"
 	verifiablestore "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 )
 
@@ -77,6 +82,33 @@ const (
 
 	// RemovePresentationByNameErrorCode for remove vp by name errors.
 	RemovePresentationByNameErrorCode
+
+	// GeneratePresentationByDefinitionErrorCode for generate vp by presentation definition error.
+	GeneratePresentationByDefinitionErrorCode
+
+	// VerifyCredentialErrorCode for verify vc error.
+	VerifyCredentialErrorCode
+
+	// VerifyPresentationErrorCode for verify vp error.
+	VerifyPresentationErrorCode
+
+	// QueryCredentialsErrorCode for query credential records error.
+	QueryCredentialsErrorCode
+
+	// GetExpiringCredentialsErrorCode for get expiring credential records error.
+	GetExpiringCredentialsErrorCode
+
+	// RefreshCredentialErrorCode for refresh vc error.
+	RefreshCredentialErrorCode
+
+	// IssueRefreshedCredentialErrorCode for refresh service error.
+	IssueRefreshedCredentialErrorCode
+
+	// AddTrustListErrorCode for add trust list error.
+	AddTrustListErrorCode
+
+	// RemoveTrustListErrorCode for remove trust list error.
+	RemoveTrustListErrorCode
 )
 
 // constants for the Verifiable protocol
@@ -84,19 +116,29 @@ const (
 	CommandName = "verifiable"
 
 	// command methods
-	ValidateCredentialCommandMethod       = "ValidateCredential"
-	SaveCredentialCommandMethod           = "SaveCredential"
-	GetCredentialCommandMethod            = "GetCredential"
-	GetCredentialByNameCommandMethod      = "GetCredentialByName"
-	GetCredentialsCommandMethod           = "GetCredentials"
-	SignCredentialCommandMethod           = "SignCredential"
-	SavePresentationCommandMethod         = "SavePresentation"
-	GetPresentationCommandMethod          = "GetPresentation"
-	GetPresentationsCommandMethod         = "GetPresentations"
-	GeneratePresentationCommandMethod     = "GeneratePresentation"
-	GeneratePresentationByIDCommandMethod = "GeneratePresentationByID"
-	RemoveCredentialByNameCommandMethod   = "RemoveCredentialByName"
-	RemovePresentationByNameCommandMethod = "RemovePresentationByName"
+	ValidateCredentialCommandMethod               = "ValidateCredential"
+	SaveCredentialCommandMethod                   = "SaveCredential"
+	GetCredentialCommandMethod                    = "GetCredential"
+	GetCredentialByNameCommandMethod              = "GetCredentialByName"
+	GetCredentialsCommandMethod                   = "GetCredentials"
+	SignCredentialCommandMethod                   = "SignCredential"
+	SavePresentationCommandMethod                 = "SavePresentation"
+	GetPresentationCommandMethod                  = "GetPresentation"
+	GetPresentationsCommandMethod                 = "GetPresentations"
+	GeneratePresentationCommandMethod             = "GeneratePresentation"
+	GeneratePresentationByIDCommandMethod         = "GeneratePresentationByID"
+	RemoveCredentialByNameCommandMethod           = "RemoveCredentialByName"
+	RemovePresentationByNameCommandMethod         = "RemovePresentationByName"
+	GeneratePresentationByDefinitionCommandMethod = "GeneratePresentationByDefinition"
+	VerifyCredentialCommandMethod                 = "VerifyCredential"
+	VerifyPresentationCommandMethod               = "VerifyPresentation"
+	QueryCredentialsCommandMethod                 = "QueryCredentials"
+	GetExpiringCredentialsCommandMethod           = "GetExpiringCredentials"
+	RefreshCredentialCommandMethod                = "RefreshCredential"
+	IssueRefreshedCredentialCommandMethod         = "IssueRefreshedCredential"
+	AddTrustListCommandMethod                     = "AddTrustList"
+	RemoveTrustListCommandMethod                  = "RemoveTrustList"
+	GetTrustListsCommandMethod                    = "GetTrustLists"
 
 	// error messages
 	errEmptyCredentialName   = "credential name is mandatory"
@@ -104,6 +146,7 @@ const (
 	errEmptyCredentialID     = "credential id is mandatory"
 	errEmptyPresentationID   = "presentation id is mandatory"
 	errEmptyDID              = "did is mandatory"
+	errEmptyDefinition       = "presentation definition is mandatory"
 
 	// log constants
 	vcID   = "vcID"
@@ -116,6 +159,10 @@ const (
 	Ed25519Signature2018 = "Ed25519Signature2018"
 	// JSONWebSignature2020 json web signature suite.
 	JSONWebSignature2020 = "JsonWebSignature2020"
+	// Ed25519Signature2020 ed25519 signature suite with multibase proof value.
+	Ed25519Signature2020 = "Ed25519Signature2020"
+	// EcdsaSecp256r1Signature2019 ECDSA P-256 signature suite.
+	EcdsaSecp256r1Signature2019 = "EcdsaSecp256r1Signature2019"
 
 	// Ed25519KeyType ed25519 key type.
 	Ed25519KeyType = "Ed25519"
@@ -170,6 +217,7 @@ type provider interface {
 	VDRIRegistry() vdri.Registry
 	KMS() kms.KeyManager
 	Crypto() ariescrypto.Crypto
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
 }
 
 // Command contains command operations provided by verifiable credential controller.
@@ -178,10 +226,66 @@ type Command struct {
 	didStore        *didstore.Store
 	kResolver       keyResolver
 	ctx             provider
+	documentLoader  *jsonld.DocumentLoader
+	schemaLoader    *verifiable.CredentialSchemaLoader
+	statusVerifier  *verifiable.StatusListVerifier
+	refreshClient   *verifiable.RefreshClient
+	trustRegistry   *trustregistry.Registry
+	expiryNotice    time.Duration
+	expiryTracker   *verifiablestore.ExpiryTracker
+	refreshStore    storage.Store
+}
+
+type options struct {
+	notifier     command.Notifier
+	expiryNotice time.Duration
+	trustAnchors []string
+	schemaLoader *verifiable.CredentialSchemaLoader
+}
+
+// Option configures the verifiable credential controller command.
+type Option func(opts *options)
+
+// WithNotifier enables tracking of the expiring credentials, expiry events are sent to the ExpiryTopic
+// using given notifier.
+func WithNotifier(notifier command.Notifier) Option {
+	return func(opts *options) {
+		opts.notifier = notifier
+	}
+}
+
+// WithExpiryNotice sets how long before the expiration the expiring credential event is sent
+// (30 days by default).
+func WithExpiryNotice(notice time.Duration) Option {
+	return func(opts *options) {
+		opts.expiryNotice = notice
+	}
+}
+
+// WithTrustAnchors restricts the issuers of the trust lists added to the issuer trust registry
+// to the given DIDs (e.g. governance authorities). If not set, no trust lists are accepted.
+func WithTrustAnchors(dids ...string) Option {
+	return func(opts *options) {
+		opts.trustAnchors = dids
+	}
+}
+
+// WithCredentialSchemaLoader sets the loader of the custom JSON schemas of the validated credentials
+// (e.g. aries.Context().CredentialSchemaLoader()). If not set, the schemas are downloaded without caching.
+func WithCredentialSchemaLoader(loader *verifiable.CredentialSchemaLoader) Option {
+	return func(opts *options) {
+		opts.schemaLoader = loader
+	}
 }
 
 // New returns new verifiable credential controller command instance.
-func New(p provider) (*Command, error) {
+func New(p provider, opts ...Option) (*Command, error) {
+	cmdOpts := &options{expiryNotice: verifiablestore.DefaultExpiryNotice}
+
+	for _, opt := range opts {
+		opt(cmdOpts)
+	}
+
 	verifiableStore, err := verifiablestore.New(p)
 	if err != nil {
 		return nil, fmt.Errorf("new vc store : %w", err)
@@ -192,14 +296,77 @@ func New(p provider) (*Command, error) {
 		return nil, fmt.Errorf("new did store : %w", err)
 	}
 
+	refreshStore, err := p.StorageProvider().OpenStore(refreshStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("open refresh store : %w", err)
+	}
+
+	documentLoader := p.JSONLDDocumentLoader()
+	if documentLoader == nil {
+		documentLoader = jsonld.DefaultDocumentLoader()
+	}
+
+	kResolver := verifiable.NewDIDKeyResolver(p.VDRIRegistry())
+
+	trustRegistry, err := trustregistry.New(p,
+		trustregistry.WithTrustAnchors(cmdOpts.trustAnchors...),
+		trustregistry.WithTrustListCredentialOpts(
+			verifiable.WithPublicKeyFetcher(kResolver.PublicKeyFetcher()),
+			verifiable.WithJSONLDDocumentLoader(documentLoader)))
+	if err != nil {
+		return nil, fmt.Errorf("new trust registry : %w", err)
+	}
+
+	var expiryTracker *verifiablestore.ExpiryTracker
+
+	if cmdOpts.notifier != nil {
+		expiryTracker, err = startExpiryTracker(p, cmdOpts.notifier, cmdOpts.expiryNotice)
+		if err != nil {
+			return nil, fmt.Errorf("start expiry tracker : %w", err)
+		}
+	}
+
 	return &Command{
 		verifiableStore: verifiableStore,
 		didStore:        didStore,
-		kResolver:       verifiable.NewDIDKeyResolver(p.VDRIRegistry()),
+		kResolver:       kResolver,
 		ctx:             p,
+		documentLoader:  documentLoader,
+		schemaLoader:    cmdOpts.schemaLoader,
+		statusVerifier: verifiable.NewStatusListVerifier(verifiable.WithStatusListCredentialOpts(
+			verifiable.WithPublicKeyFetcher(kResolver.PublicKeyFetcher()),
+			verifiable.WithJSONLDDocumentLoader(documentLoader))),
+		refreshClient: verifiable.NewRefreshClient(verifiable.WithRefreshCredentialOpts(
+			verifiable.WithPublicKeyFetcher(kResolver.PublicKeyFetcher()),
+			verifiable.WithJSONLDDocumentLoader(documentLoader))),
+		trustRegistry: trustRegistry,
+		expiryNotice:  cmdOpts.expiryNotice,
+		expiryTracker: expiryTracker,
+		refreshStore:  refreshStore,
 	}, nil
 }
 
+// credentialOpts returns the options of credential parsing with the JSON-LD document loader and the credential
+// schema loader of the command followed by the given options.
+func (o *Command) credentialOpts(opts ...verifiable.CredentialOpt) []verifiable.CredentialOpt {
+	credOpts := []verifiable.CredentialOpt{verifiable.WithJSONLDDocumentLoader(o.documentLoader)}
+
+	if o.schemaLoader != nil {
+		credOpts = append(credOpts, verifiable.WithCredentialSchemaLoader(o.schemaLoader))
+	}
+
+	return append(credOpts, opts...)
+}
+
+// Close stops tracking of the expiring credentials enabled by WithNotifier option.
+func (o *Command) Close() error {
+	if o.expiryTracker != nil {
+		o.expiryTracker.Stop()
+	}
+
+	return nil
+}
+
 // GetHandlers returns list of all commands supported by this controller command.
 func (o *Command) GetHandlers() []command.Handler {
 	return []command.Handler{
@@ -216,6 +383,17 @@ func (o *Command) GetHandlers() []command.Handler {
 		cmdutil.NewCommandHandler(CommandName, GetPresentationsCommandMethod, o.GetPresentations),
 		cmdutil.NewCommandHandler(CommandName, RemoveCredentialByNameCommandMethod, o.RemoveCredentialByName),
 		cmdutil.NewCommandHandler(CommandName, RemovePresentationByNameCommandMethod, o.RemovePresentationByName),
+		cmdutil.NewCommandHandler(CommandName, GeneratePresentationByDefinitionCommandMethod,
+			o.GeneratePresentationByDefinition),
+		cmdutil.NewCommandHandler(CommandName, VerifyCredentialCommandMethod, o.VerifyCredential),
+		cmdutil.NewCommandHandler(CommandName, VerifyPresentationCommandMethod, o.VerifyPresentation),
+		cmdutil.NewCommandHandler(CommandName, QueryCredentialsCommandMethod, o.QueryCredentials),
+		cmdutil.NewCommandHandler(CommandName, GetExpiringCredentialsCommandMethod, o.GetExpiringCredentials),
+		cmdutil.NewCommandHandler(CommandName, RefreshCredentialCommandMethod, o.RefreshCredential),
+		cmdutil.NewCommandHandler(CommandName, IssueRefreshedCredentialCommandMethod, o.IssueRefreshedCredential),
+		cmdutil.NewCommandHandler(CommandName, AddTrustListCommandMethod, o.AddTrustList),
+		cmdutil.NewCommandHandler(CommandName, RemoveTrustListCommandMethod, o.RemoveTrustList),
+		cmdutil.NewCommandHandler(CommandName, GetTrustListsCommandMethod, o.GetTrustLists),
 	}
 }
 
@@ -233,7 +411,7 @@ func (o *Command) ValidateCredential(rw io.Writer, req io.Reader) command.Error
 	// we are only validating the VerifiableCredential here, hence ignoring other return values
 	// TODO https://github.com/hyperledger/aries-framework-go/issues/1316 VC Validate Command - Add keys for proof
 	//  verification as options to the function.
-	_, err = verifiable.ParseCredential([]byte(request.VerifiableCredential))
+	_, err = verifiable.ParseCredential([]byte(request.VerifiableCredential), o.credentialOpts()...)
 	if err != nil {
 		logutil.LogInfo(logger, CommandName, ValidateCredentialCommandMethod, "validate vc : "+err.Error())
 
@@ -263,7 +441,8 @@ func (o *Command) SaveCredential(rw io.Writer, req io.Reader) command.Error {
 		return command.NewValidationError(SaveCredentialErrorCode, fmt.Errorf(errEmptyCredentialName))
 	}
 
-	vc, err := verifiable.ParseUnverifiedCredential([]byte(request.VerifiableCredential))
+	vc, err := verifiable.ParseUnverifiedCredential([]byte(request.VerifiableCredential),
+		verifiable.WithJSONLDDocumentLoader(o.documentLoader))
 	if err != nil {
 		logutil.LogError(logger, CommandName, SaveCredentialCommandMethod, "parse vc : "+err.Error())
 
@@ -301,7 +480,7 @@ func (o *Command) SavePresentation(rw io.Writer, req io.Reader) command.Error {
 	}
 
 	vp, err := verifiable.ParsePresentation([]byte(request.VerifiablePresentation),
-		verifiable.WithPresDisabledProofCheck())
+		verifiable.WithPresDisabledProofCheck(), verifiable.WithPresJSONLDDocumentLoader(o.documentLoader))
 	if err != nil {
 		logutil.LogError(logger, CommandName, SavePresentationCommandMethod, "parse vp : "+err.Error())
 
@@ -363,7 +542,8 @@ func (o *Command) GetCredential(rw io.Writer, req io.Reader) command.Error {
 	return nil
 }
 
-// SignCredential adds proof to given verifiable credential.
+// SignCredential adds proof to given verifiable credential. The signed credential which has ID and the refresh
+// service is saved to be refreshed by IssueRefreshedCredential.
 func (o *Command) SignCredential(rw io.Writer, req io.Reader) command.Error {
 	request := &SignCredentialRequest{}
 
@@ -387,7 +567,8 @@ func (o *Command) SignCredential(rw io.Writer, req io.Reader) command.Error {
 		}
 	}
 
-	vc, err := verifiable.ParseUnverifiedCredential(request.Credential)
+	vc, err := verifiable.ParseUnverifiedCredential(request.Credential,
+		verifiable.WithJSONLDDocumentLoader(o.documentLoader))
 	if err != nil {
 		logutil.LogError(logger, CommandName, SignCredentialCommandMethod, "parse credential : "+err.Error())
 
@@ -401,6 +582,13 @@ func (o *Command) SignCredential(rw io.Writer, req io.Reader) command.Error {
 		return command.NewValidationError(SignCredentialErrorCode, fmt.Errorf("sign credential : %w", err))
 	}
 
+	err = o.saveIssuedCredential(vc)
+	if err != nil {
+		logutil.LogError(logger, CommandName, SignCredentialCommandMethod, "save issued credential : "+err.Error())
+
+		return command.NewValidationError(SignCredentialErrorCode, fmt.Errorf("save issued credential : %w", err))
+	}
+
 	vcBytes, err := vc.MarshalJSON()
 	if err != nil {
 		logutil.LogError(logger, CommandName, SignCredentialCommandMethod, "marshal credential : "+err.Error())
@@ -510,6 +698,34 @@ func (o *Command) GetCredentials(rw io.Writer, req io.Reader) command.Error {
 	return nil
 }
 
+// QueryCredentials retrieves a page of the verifiable credential records matching the query.
+func (o *Command) QueryCredentials(rw io.Writer, req io.Reader) command.Error {
+	request := &QueryCredentialsRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, QueryCredentialsCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	result, err := o.verifiableStore.QueryCredentials(&request.CredentialQuery)
+	if err != nil {
+		logutil.LogError(logger, CommandName, QueryCredentialsCommandMethod, "query credential records : "+err.Error())
+
+		return command.NewValidationError(QueryCredentialsErrorCode, fmt.Errorf("query credential records : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &QueryCredentialsResponse{
+		Result: result.Records,
+		Total:  result.Total,
+	}, logger)
+
+	logutil.LogDebug(logger, CommandName, QueryCredentialsCommandMethod, "success")
+
+	return nil
+}
+
 // GetPresentations retrieves the verifiable presentation records containing name and fields of interest.
 func (o *Command) GetPresentations(rw io.Writer, req io.Reader) command.Error {
 	vpRecords, err := o.verifiableStore.GetPresentations()
@@ -605,6 +821,83 @@ func (o *Command) GeneratePresentationByID(rw io.Writer, req io.Reader) command.
 	return o.generatePresentationByID(rw, vc, doc, request.SignatureType)
 }
 
+// GeneratePresentationByDefinition generates verifiable presentation satisfying the presentation definition
+// from the stored verifiable credentials. Credentials matching each of the input descriptors are selected
+// automatically unless chosen by the caller, and presentation_submission is added to the presentation.
+func (o *Command) GeneratePresentationByDefinition(rw io.Writer, req io.Reader) command.Error {
+	request := &PresentationByDefinitionRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, GeneratePresentationByDefinitionCommandMethod,
+			"request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.PresentationDefinition == nil {
+		logutil.LogDebug(logger, CommandName, GeneratePresentationByDefinitionCommandMethod, errEmptyDefinition)
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf(errEmptyDefinition))
+	}
+
+	if request.DID == "" {
+		logutil.LogDebug(logger, CommandName, GeneratePresentationByDefinitionCommandMethod, errEmptyDID)
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf(errEmptyDID))
+	}
+
+	if request.ProofOptions == nil || request.SignatureType == "" {
+		logutil.LogDebug(logger, CommandName, GeneratePresentationByDefinitionCommandMethod, "signature type empty")
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("invalid request, signature type empty"))
+	}
+
+	didDoc, err := o.ctx.VDRIRegistry().Resolve(request.DID)
+	//  if did not found in VDRI, look through in local storage
+	if err != nil {
+		didDoc, err = o.didStore.GetDID(request.DID)
+		if err != nil {
+			logutil.LogError(logger, CommandName, GeneratePresentationByDefinitionCommandMethod,
+				"failed to get did doc from store or vdri: "+err.Error())
+
+			return command.NewValidationError(GeneratePresentationByDefinitionErrorCode,
+				fmt.Errorf("generate vp - failed to get did doc from store or vdri : %w", err))
+		}
+	}
+
+	vp, err := o.createPresentationByDefinition(request)
+	if err != nil {
+		logutil.LogError(logger, CommandName, GeneratePresentationByDefinitionCommandMethod,
+			"create vp by definition: "+err.Error())
+
+		return command.NewValidationError(GeneratePresentationByDefinitionErrorCode,
+			fmt.Errorf("create vp by definition: %w", err))
+	}
+
+	opts, err := prepareOpts(request.ProofOptions, didDoc, did.Authentication)
+	if err != nil {
+		logutil.LogError(logger, CommandName, GeneratePresentationByDefinitionCommandMethod,
+			"failed to prepare proof options: "+err.Error())
+
+		return command.NewValidationError(GeneratePresentationByDefinitionErrorCode,
+			fmt.Errorf("failed to prepare proof options: %w", err))
+	}
+
+	vpBytes, err := o.createAndSignPresentation(nil, vp, didDoc.ID, opts)
+	if err != nil {
+		logutil.LogError(logger, CommandName, GeneratePresentationByDefinitionCommandMethod,
+			"create and sign vp: "+err.Error())
+
+		return command.NewValidationError(GeneratePresentationByDefinitionErrorCode, fmt.Errorf("prepare vp: %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &Presentation{
+		VerifiablePresentation: vpBytes,
+	}, logger)
+
+	logutil.LogDebug(logger, CommandName, GeneratePresentationByDefinitionCommandMethod, "success")
+
+	return nil
+}
+
 // RemoveCredentialByName will remove a VC that matches the specified name from the verifiable store
 // nolint: dupl
 func (o *Command) RemoveCredentialByName(rw io.Writer, req io.Reader) command.Error {
@@ -733,6 +1026,39 @@ func (o *Command) createAndSignPresentation(credentials []interface{}, vp *verif
 	return vp.MarshalJSON()
 }
 
+func (o *Command) createPresentationByDefinition(
+	request *PresentationByDefinitionRequest) (*verifiable.Presentation, error) {
+	records, err := o.verifiableStore.GetCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("get credential records: %w", err)
+	}
+
+	vcs := make([]*verifiable.Credential, len(records))
+
+	for i, record := range records {
+		vcs[i], err = o.verifiableStore.GetCredential(record.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get vc by id %s: %w", record.ID, err)
+		}
+	}
+
+	return request.PresentationDefinition.CreateVP(vcs, presexch.WithCredentialSelector(
+		func(descriptorID string, candidates []*verifiable.Credential) (*verifiable.Credential, error) {
+			id, ok := request.Selection[descriptorID]
+			if !ok {
+				return candidates[0], nil
+			}
+
+			for _, vc := range candidates {
+				if vc.ID == id {
+					return vc, nil
+				}
+			}
+
+			return nil, fmt.Errorf("selected credential %s does not match input descriptor", id)
+		}))
+}
+
 func (o *Command) createAndSignPresentationByID(vc *verifiable.Credential,
 	didDoc *did.Doc, signatureType string) ([]byte, error) {
 	// pk is verification method
@@ -762,27 +1088,34 @@ func (o *Command) addLinkedDataProof(p provable, opts *ProofOptions) error {
 
 	var signatureSuite verifiablesigner.SignatureSuite
 
+	signatureRepresentation := verifiable.SignatureJWS
+
 	switch opts.SignatureType {
 	case Ed25519Signature2018:
 		signatureSuite = ed25519signature2018.New(suite.WithSigner(s))
 	case JSONWebSignature2020:
 		signatureSuite = jsonwebsignature2020.New(suite.WithSigner(s))
+	case Ed25519Signature2020:
+		signatureSuite = ed25519signature2020.New(suite.WithSigner(s))
+		signatureRepresentation = verifiable.SignatureProofValue
+	case EcdsaSecp256r1Signature2019:
+		signatureSuite = ecdsasecp256r1signature2019.New(suite.WithSigner(s))
 	default:
 		return fmt.Errorf("signature type unsupported %s", opts.SignatureType)
 	}
 
 	signingCtx := &verifiable.LinkedDataProofContext{
 		VerificationMethod:      opts.VerificationMethod,
-		SignatureRepresentation: verifiable.SignatureJWS,
+		SignatureRepresentation: signatureRepresentation,
 		SignatureType:           opts.SignatureType,
 		Suite:                   signatureSuite,
 		Created:                 opts.Created,
 		Domain:                  opts.Domain,
 		Challenge:               opts.Challenge,
-		Purpose:                 opts.proofPurpose,
+		Purpose:                 opts.ProofPurpose,
 	}
 
-	err = p.AddLinkedDataProof(signingCtx)
+	err = p.AddLinkedDataProof(signingCtx, jsonld.WithDocumentLoader(o.documentLoader))
 	if err != nil {
 		return fmt.Errorf("failed to add linked data proof: %w", err)
 	}
@@ -795,7 +1128,7 @@ func (o *Command) parseVerifiableCredentials(request *PresentationRequest,
 	var vcs []interface{}
 
 	for _, vcRaw := range request.VerifiableCredentials {
-		var credOpts []verifiable.CredentialOpt
+		credOpts := o.credentialOpts()
 		if request.SkipVerify {
 			credOpts = append(credOpts, verifiable.WithDisabledProofCheck())
 		} else {
@@ -826,7 +1159,8 @@ func (o *Command) parseVerifiableCredentials(request *PresentationRequest,
 
 func (o *Command) parsePresentation(request *PresentationRequest,
 	didDoc *did.Doc) ([]interface{}, *verifiable.Presentation, *ProofOptions, error) {
-	presentation, err := verifiable.ParseUnverifiedPresentation(request.Presentation)
+	presentation, err := verifiable.ParseUnverifiedPresentation(request.Presentation,
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader))
 	if err != nil {
 		logutil.LogError(logger, CommandName, GeneratePresentationCommandMethod,
 			"failed to parse presentation from request: "+err.Error())
@@ -867,9 +1201,16 @@ func prepareOpts(opts *ProofOptions, didDoc *did.Doc, method did.VerificationRel
 
 	var err error
 
-	opts.proofPurpose, err = getProofPurpose(method)
-	if err != nil {
-		return nil, err
+	if opts.ProofPurpose != "" {
+		method, err = verifiable.VerificationRelationship(opts.ProofPurpose)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		opts.ProofPurpose, err = getProofPurpose(method)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	vMs := didDoc.VerificationMethods(method)[method]
@@ -893,13 +1234,13 @@ func prepareOpts(opts *ProofOptions, didDoc *did.Doc, method did.VerificationRel
 	}
 
 	if !vmMatched {
-		return nil, fmt.Errorf("unable to find matching '%s' key IDs for given verification method", opts.proofPurpose)
+		return nil, fmt.Errorf("unable to find matching '%s' key IDs for given verification method", opts.ProofPurpose)
 	}
 
 	// this is the fallback logic kept for DIDs not having authentication method
 	// TODO to be removed [Issue #1693]
 	if opts.VerificationMethod == "" {
-		logger.Warnf("Could not find matching verification method for '%s' proof purpose", opts.proofPurpose)
+		logger.Warnf("Could not find matching verification method for '%s' proof purpose", opts.ProofPurpose)
 
 		defaultVM, err := getDefaultVerificationMethod(didDoc)
 		if err != nil {
@@ -959,13 +1300,16 @@ func isDID(str string) bool {
 }
 
 func getProofPurpose(method did.VerificationRelationship) (string, error) {
-	if method != did.Authentication && method != did.AssertionMethod {
-		return "", fmt.Errorf("unsupported proof purpose, only authentication or assertionMethod are supported")
-	}
-
-	if method == did.Authentication {
-		return "authentication", nil
+	switch method {
+	case did.Authentication:
+		return verifiable.AuthenticationProofPurpose, nil
+	case did.AssertionMethod:
+		return verifiable.AssertionMethodProofPurpose, nil
+	case did.CapabilityInvocation:
+		return verifiable.CapabilityInvocationProofPurpose, nil
+	case did.CapabilityDelegation:
+		return verifiable.CapabilityDelegationProofPurpose, nil
+	default:
+		return "", fmt.Errorf("unsupported proof purpose for verification relationship %d", method)
 	}
-
-	return "assertionMethod", nil
 }
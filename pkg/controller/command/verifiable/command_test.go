@@ -12,12 +12,14 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	cryptomock "github.com/hyperledger/aries-framework-go/pkg/mock/crypto"
@@ -317,7 +319,7 @@ func TestNew(t *testing.T) {
 		require.NoError(t, err)
 
 		handlers := cmd.GetHandlers()
-		require.Equal(t, 13, len(handlers))
+		require.Equal(t, 23, len(handlers))
 	})
 
 	t.Run("test new command - vc store error", func(t *testing.T) {
@@ -350,6 +352,40 @@ func TestValidateVC(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("test register - custom schema of credential schema loader", func(t *testing.T) {
+		const schemaURL = "https://example.com/schemas/reference-number.json"
+
+		schemaCache, err := verifiable.NewStoreSchemaCache(mockstore.NewMockStoreProvider(), time.Hour)
+		require.NoError(t, err)
+
+		schemaCache.Put(schemaURL, []byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "required": ["referenceNumber"]
+}`))
+
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		}, WithCredentialSchemaLoader(verifiable.NewCredentialSchemaLoaderBuilder().SetCache(schemaCache).Build()))
+		require.NotNil(t, cmd)
+		require.NoError(t, err)
+
+		raw := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(vc), &raw))
+
+		raw["credentialSchema"] = map[string]string{"id": schemaURL, "type": "JsonSchema2020"}
+
+		vcBytes, err := json.Marshal(raw)
+		require.NoError(t, err)
+
+		vcReqBytes, err := json.Marshal(Credential{VerifiableCredential: string(vcBytes)})
+		require.NoError(t, err)
+
+		var b bytes.Buffer
+		err = cmd.ValidateCredential(&b, bytes.NewBuffer(vcReqBytes))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "missing properties: 'referenceNumber'")
+	})
+
 	t.Run("test register - invalid request", func(t *testing.T) {
 		cmd, err := New(&mockprovider.Provider{
 			StorageProviderValue: mockstore.NewMockStoreProvider(),
@@ -658,6 +694,66 @@ func TestGetCredentials(t *testing.T) {
 	})
 }
 
+func TestQueryCredentials(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	})
+	require.NotNil(t, cmd)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		vcReqBytes, e := json.Marshal(CredentialExt{
+			Credential: Credential{VerifiableCredential: strings.Replace(vc, sampleVCID, sampleVCID+strconv.Itoa(i), 1)},
+			Name:       sampleCredentialName + strconv.Itoa(i),
+		})
+		require.NoError(t, e)
+
+		var b bytes.Buffer
+		require.NoError(t, cmd.SaveCredential(&b, bytes.NewBuffer(vcReqBytes)))
+	}
+
+	t.Run("test query credentials", func(t *testing.T) {
+		var rw bytes.Buffer
+		cmdErr := cmd.QueryCredentials(&rw, bytes.NewBufferString(
+			`{"issuer":"did:example:09s12ec712ebc6f1c671ebfeb1f","sortBy":"name","descending":true,"limit":2}`))
+		require.NoError(t, cmdErr)
+
+		var response QueryCredentialsResponse
+		require.NoError(t, json.NewDecoder(&rw).Decode(&response))
+
+		require.Equal(t, 3, response.Total)
+		require.Len(t, response.Result, 2)
+		require.Equal(t, sampleCredentialName+"2", response.Result[0].Name)
+		require.Equal(t, sampleCredentialName+"1", response.Result[1].Name)
+		require.Equal(t, "did:example:09s12ec712ebc6f1c671ebfeb1f", response.Result[0].Issuer)
+		require.NotNil(t, response.Result[0].IssuanceDate)
+	})
+
+	t.Run("test query credentials - no match", func(t *testing.T) {
+		var rw bytes.Buffer
+		cmdErr := cmd.QueryCredentials(&rw, bytes.NewBufferString(`{"type":"UniversityDegreeCredential"}`))
+		require.NoError(t, cmdErr)
+
+		var response QueryCredentialsResponse
+		require.NoError(t, json.NewDecoder(&rw).Decode(&response))
+
+		require.Equal(t, 0, response.Total)
+		require.Empty(t, response.Result)
+	})
+
+	t.Run("test query credentials - invalid request", func(t *testing.T) {
+		var rw bytes.Buffer
+		cmdErr := cmd.QueryCredentials(&rw, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = cmd.QueryCredentials(&rw, bytes.NewBufferString(`{"sortBy":"unknown"}`))
+		require.Error(t, cmdErr)
+		require.Equal(t, QueryCredentialsErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), "unsupported sort field: unknown")
+	})
+}
+
 func TestGeneratePresentation(t *testing.T) {
 	s := make(map[string][]byte)
 	cmd, cmdErr := New(&mockprovider.Provider{
@@ -1175,6 +1271,181 @@ func TestGeneratePresentationByID(t *testing.T) {
 	})
 }
 
+func TestGeneratePresentationByDefinition(t *testing.T) {
+	storeProvider := mockstore.NewMockStoreProvider()
+
+	cmd, cmdErr := New(&mockprovider.Provider{
+		StorageProviderValue: storeProvider,
+		VDRIRegistryValue: &mockvdri.MockVDRIRegistry{
+			ResolveFunc: func(didID string, opts ...vdri.ResolveOpts) (*did.Doc, error) {
+				if didID == invalidDID {
+					return nil, errors.New("invalid")
+				}
+
+				return did.ParseDocument([]byte(doc))
+			},
+		},
+		KMSValue:    &kmsmock.KeyManager{},
+		CryptoValue: &cryptomock.Crypto{},
+	})
+	require.NotNil(t, cmd)
+	require.NoError(t, cmdErr)
+
+	vStore, err := verifiablestore.New(&mockprovider.Provider{StorageProviderValue: storeProvider})
+	require.NoError(t, err)
+
+	storedVC, err := verifiable.ParseUnverifiedCredential([]byte(vc))
+	require.NoError(t, err)
+	require.NoError(t, vStore.SaveCredential(sampleCredentialName, storedVC))
+
+	definition := &presexch.PresentationDefinitions{
+		InputDescriptors: []*presexch.InputDescriptor{{
+			ID: "university",
+			Constraints: &presexch.Constraints{Fields: []*presexch.Field{{
+				Path: []string{"$.issuer.id", "$.issuer"},
+				Filter: map[string]interface{}{
+					"type":  "string",
+					"const": "did:example:09s12ec712ebc6f1c671ebfeb1f",
+				},
+			}}},
+		}},
+	}
+
+	generate := func(request *PresentationByDefinitionRequest) (*Presentation, error) {
+		reqBytes, err := json.Marshal(request)
+		require.NoError(t, err)
+
+		var b bytes.Buffer
+
+		if cmdErr := cmd.GeneratePresentationByDefinition(&b, bytes.NewBuffer(reqBytes)); cmdErr != nil {
+			return nil, cmdErr
+		}
+
+		var response Presentation
+		require.NoError(t, json.NewDecoder(&b).Decode(&response))
+
+		return &response, nil
+	}
+
+	t.Run("test generate presentation by definition - success", func(t *testing.T) {
+		response, err := generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    "did:peer:123456789abcdefghi#inbox",
+			Selection:              map[string]string{"university": sampleVCID},
+			ProofOptions:           &ProofOptions{SignatureType: Ed25519Signature2018},
+		})
+		require.NoError(t, err)
+
+		vp, err := verifiable.ParsePresentation(response.VerifiablePresentation,
+			verifiable.WithPresDisabledProofCheck())
+		require.NoError(t, err)
+		require.Len(t, vp.Proofs, 1)
+		require.Equal(t, "authentication", vp.Proofs[0]["proofPurpose"])
+		require.Contains(t, vp.Type, presexch.PresentationSubmissionJSONLDType)
+		require.Len(t, vp.Credentials(), 1)
+	})
+
+	t.Run("test generate presentation by definition - no matching credentials", func(t *testing.T) {
+		_, err := generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: &presexch.PresentationDefinitions{
+				InputDescriptors: []*presexch.InputDescriptor{{
+					ID:     "unknown",
+					Schema: &presexch.Schema{URI: "https://example.com/unknown/v1"},
+				}},
+			},
+			DID:          "did:peer:123456789abcdefghi#inbox",
+			ProofOptions: &ProofOptions{SignatureType: Ed25519Signature2018},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credentials do not satisfy presentation definition")
+	})
+
+	t.Run("test generate presentation by definition - selected credential does not match", func(t *testing.T) {
+		_, err := generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    "did:peer:123456789abcdefghi#inbox",
+			Selection:              map[string]string{"university": "http://example.edu/credentials/unknown"},
+			ProofOptions:           &ProofOptions{SignatureType: Ed25519Signature2018},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not match input descriptor")
+	})
+
+	t.Run("test generate presentation by definition - failed to resolve did", func(t *testing.T) {
+		_, err := generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    invalidDID,
+			ProofOptions:           &ProofOptions{SignatureType: Ed25519Signature2018},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to get did doc from store or vdri")
+	})
+
+	t.Run("test generate presentation by definition - invalid verification method", func(t *testing.T) {
+		_, err := generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    "did:peer:123456789abcdefghi#inbox",
+			ProofOptions: &ProofOptions{
+				SignatureType:      Ed25519Signature2018,
+				VerificationMethod: "did:peer:123456789abcdefghi#keys-unknown",
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to prepare proof options")
+	})
+
+	t.Run("test generate presentation by definition - invalid request", func(t *testing.T) {
+		var b bytes.Buffer
+		cmdErr := cmd.GeneratePresentationByDefinition(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Contains(t, cmdErr.Error(), "request decode")
+
+		_, err := generate(&PresentationByDefinitionRequest{DID: "did:peer:123456789abcdefghi#inbox"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errEmptyDefinition)
+
+		_, err = generate(&PresentationByDefinitionRequest{PresentationDefinition: definition})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), errEmptyDID)
+
+		_, err = generate(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    "did:peer:123456789abcdefghi#inbox",
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "signature type empty")
+	})
+
+	t.Run("test generate presentation by definition - store error", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{
+				Store: &mockstore.MockStore{
+					Store:  map[string][]byte{"vcname_" + sampleCredentialName: []byte(`{"id":"` + sampleVCID + `"}`)},
+					ErrGet: fmt.Errorf("get error"),
+				},
+			},
+			VDRIRegistryValue: &mockvdri.MockVDRIRegistry{
+				ResolveFunc: func(didID string, opts ...vdri.ResolveOpts) (*did.Doc, error) {
+					return did.ParseDocument([]byte(doc))
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		reqBytes, err := json.Marshal(&PresentationByDefinitionRequest{
+			PresentationDefinition: definition,
+			DID:                    "did:peer:123456789abcdefghi#inbox",
+			ProofOptions:           &ProofOptions{SignatureType: Ed25519Signature2018},
+		})
+		require.NoError(t, err)
+
+		var b bytes.Buffer
+		err = cmd.GeneratePresentationByDefinition(&b, bytes.NewBuffer(reqBytes))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get vc by id")
+	})
+}
+
 func TestGeneratePresentationHelperFunctions(t *testing.T) {
 	s := make(map[string][]byte)
 	cmd, cmdErr := New(&mockprovider.Provider{
@@ -1650,7 +1921,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					requestOpts: nil,
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:peer:123456789abcdefghi#keys-1",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1661,7 +1932,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1672,7 +1943,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#XiRjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1683,7 +1954,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1694,7 +1965,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:trustbloc:testnet.trustbloc.local:EiAzdTbGPXhvC0ESOcnlR7nCWkN1m1XUJ04uEG9ayhRbPg#bG9jYWwtbG9jazovL2RlZmF1bHQvbWFzdGVyL2tleS96cThTc3JJZ0JVTHhveU9XU2tLZ2drQWJhcjJhVDVHTmlXbERuY244VlYwPQ",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1711,7 +1982,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					requestOpts: &ProofOptions{},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:trustbloc:testnet.trustbloc.local:EiAzdTbGPXhvC0ESOcnlR7nCWkN1m1XUJ04uEG9ayhRbPg1#bG9jYWwtbG9jazovL2RlZmF1bHQvbWFzdGVyL2tleS96cThTc3JJZ0JVTHhveU9XU2tLZ2drQWJhcjJhVDVHTmlXbERuY244VlYwPQ",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1736,7 +2007,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#XiRjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1747,7 +2018,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 					},
 				},
 				{
@@ -1758,6 +2029,35 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					err: "unable to find matching 'authentication' key IDs for given verification method",
 				},
+				{
+					name:       "custom proof purpose",
+					requestDID: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+					requestOpts: &ProofOptions{
+						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+						ProofPurpose:       "capabilityInvocation",
+					},
+					responseOpts: &ProofOptions{
+						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+						ProofPurpose:       "capabilityInvocation",
+					},
+				},
+				{
+					name:       "custom proof purpose with verification method not under relationship",
+					requestDID: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+					requestOpts: &ProofOptions{
+						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#XiRjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+						ProofPurpose:       "capabilityInvocation",
+					},
+					err: "unable to find matching 'capabilityInvocation' key IDs for given verification method",
+				},
+				{
+					name:       "unsupported custom proof purpose",
+					requestDID: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
+					requestOpts: &ProofOptions{
+						ProofPurpose: "unknown",
+					},
+					err: "unsupported proof purpose: unknown",
+				},
 				{
 					name:       "all opts given",
 					requestDID: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
@@ -1769,7 +2069,7 @@ func TestGeneratePresentation_prepareOpts(t *testing.T) {
 					},
 					responseOpts: &ProofOptions{
 						VerificationMethod: "did:key:z6MkjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd#XiRjRagNiMu91DduvCvgEsqLZDVzrJzFrwahc4tXLt9DoHd",
-						proofPurpose:       "authentication",
+						ProofPurpose:       "authentication",
 						Domain:             "sample.domain.example.com",
 						Challenge:          "sample-challenge",
 						SignatureType:      JSONWebSignature2020,
@@ -1829,7 +2129,7 @@ func TestCommand_SignCredential(t *testing.T) {
 			},
 		},
 		KMSValue:    &kmsmock.KeyManager{},
-		CryptoValue: &cryptomock.Crypto{},
+		CryptoValue: &cryptomock.Crypto{SignValue: []byte("signature")},
 	})
 
 	require.NotNil(t, cmd)
@@ -1998,6 +2298,44 @@ func TestCommand_SignCredential(t *testing.T) {
 		require.Contains(t, vc.Proofs[0]["type"], "JsonWebSignature2020")
 	})
 
+	t.Run("test sign credential with proof options - success (Ed25519Signature2020, EcdsaSecp256r1Signature2019)",
+		func(t *testing.T) {
+			for _, signatureType := range []string{Ed25519Signature2020, EcdsaSecp256r1Signature2019} {
+				req := SignCredentialRequest{
+					Credential: []byte(vc),
+					DID:        "did:peer:123456789abcdefghi#inbox",
+					ProofOptions: &ProofOptions{
+						VerificationMethod: "did:peer:123456789abcdefghi#keys-1",
+						SignatureType:      signatureType,
+					},
+				}
+
+				reqBytes, err := json.Marshal(req)
+				require.NoError(t, err)
+
+				var b bytes.Buffer
+				err = cmd.SignCredential(&b, bytes.NewBuffer(reqBytes))
+				require.NoError(t, err)
+
+				var response SignCredentialResponse
+				err = json.NewDecoder(&b).Decode(&response)
+				require.NoError(t, err)
+
+				signedVC, err := verifiable.ParseCredential(response.VerifiableCredential,
+					verifiable.WithDisabledProofCheck())
+				require.NoError(t, err)
+				require.Len(t, signedVC.Proofs, 1)
+				require.Equal(t, signatureType, signedVC.Proofs[0]["type"])
+
+				if signatureType == Ed25519Signature2020 {
+					require.Contains(t, signedVC.Proofs[0], "proofValue")
+					require.True(t, strings.HasPrefix(signedVC.Proofs[0]["proofValue"].(string), "z"))
+				} else {
+					require.Contains(t, signedVC.Proofs[0], "jws")
+				}
+			}
+		})
+
 	t.Run("test sign credential with proof options - success (ed25519 jsonwebsignature)", func(t *testing.T) {
 		createdTime := time.Now().AddDate(-1, 0, 0)
 		req := SignCredentialRequest{
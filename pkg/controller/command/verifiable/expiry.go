@@ -0,0 +1,102 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/logutil"
+	verifiablestore "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
+)
+
+const (
+	// ExpiryTopic is the notifier topic of the expiring credential events.
+	ExpiryTopic = CommandName + "_expiry"
+
+	day = 24 * time.Hour
+
+	errNegativeDays = "days must not be negative"
+)
+
+// startExpiryTracker starts tracking of the expiring credentials, expiry events are sent to the ExpiryTopic
+// using the notifier.
+func startExpiryTracker(p provider, notifier command.Notifier,
+	notice time.Duration) (*verifiablestore.ExpiryTracker, error) {
+	tracker, err := verifiablestore.NewExpiryTracker(p, func(event *verifiablestore.ExpiryEvent) {
+		msg, err := json.Marshal(event)
+		if err != nil {
+			logger.Errorf("marshal expiry event: %s", err)
+			return
+		}
+
+		if err := notifier.Notify(ExpiryTopic, msg); err != nil {
+			logger.Errorf("notify expiry event: %s", err)
+		}
+	}, verifiablestore.WithExpiryNotice(notice))
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.Start()
+
+	return tracker, nil
+}
+
+// GetExpiringCredentials retrieves the credential records expiring within the given number of days
+// (the expiry notice period by default) sorted by expiration date.
+func (o *Command) GetExpiringCredentials(rw io.Writer, req io.Reader) command.Error {
+	request := &ExpiringCredentialsRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, GetExpiringCredentialsCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.Days < 0 {
+		logutil.LogDebug(logger, CommandName, GetExpiringCredentialsCommandMethod, errNegativeDays)
+
+		return command.NewValidationError(GetExpiringCredentialsErrorCode, fmt.Errorf(errNegativeDays))
+	}
+
+	within := o.expiryNotice
+	if request.Days > 0 {
+		within = time.Duration(request.Days) * day
+	}
+
+	now := time.Now()
+	deadline := now.Add(within)
+
+	query := &verifiablestore.CredentialQuery{
+		ExpiresBefore: &deadline,
+		SortBy:        verifiablestore.SortByExpirationDate,
+	}
+
+	if !request.IncludeExpired {
+		query.ExpiresAfter = &now
+	}
+
+	result, err := o.verifiableStore.QueryCredentials(query)
+	if err != nil {
+		logutil.LogError(logger, CommandName, GetExpiringCredentialsCommandMethod,
+			"get expiring credentials : "+err.Error())
+
+		return command.NewValidationError(GetExpiringCredentialsErrorCode,
+			fmt.Errorf("get expiring credentials : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &ExpiringCredentialsResponse{Result: result.Records}, logger)
+
+	logutil.LogDebug(logger, CommandName, GetExpiringCredentialsCommandMethod, "success")
+
+	return nil
+}
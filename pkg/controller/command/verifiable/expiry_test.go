@@ -0,0 +1,170 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	mocksstore "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/store/verifiable"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	verifiablestore "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
+)
+
+type topicMessage struct {
+	topic   string
+	message []byte
+}
+
+type mockNotifier struct {
+	messages chan topicMessage
+}
+
+func (n *mockNotifier) Notify(topic string, message []byte) error {
+	n.messages <- topicMessage{topic: topic, message: message}
+
+	return nil
+}
+
+func vcExpiringIn(t *testing.T, id string, expires time.Duration) string {
+	t.Helper()
+
+	return strings.Replace(strings.Replace(vc, sampleVCID, id, 1),
+		`"issuanceDate":"2020-01-01T10:54:01Z",`,
+		`"issuanceDate":"2020-01-01T10:54:01Z", "expirationDate":"`+
+			time.Now().Add(expires).UTC().Format(time.RFC3339)+`",`, 1)
+}
+
+func saveCredential(t *testing.T, cmd *Command, name, vcJSON string) {
+	t.Helper()
+
+	vcReqBytes, err := json.Marshal(CredentialExt{Credential: Credential{VerifiableCredential: vcJSON}, Name: name})
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, cmd.SaveCredential(&b, bytes.NewBuffer(vcReqBytes)))
+}
+
+func TestNew_ExpiryNotifications(t *testing.T) {
+	t.Run("expiring credential is notified", func(t *testing.T) {
+		provider := &mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()}
+
+		cmd, err := New(provider)
+		require.NoError(t, err)
+
+		saveCredential(t, cmd, "expiring", vcExpiringIn(t, sampleVCID, 3*day))
+
+		notifier := &mockNotifier{messages: make(chan topicMessage, 1)}
+
+		trackingCmd, err := New(provider, WithNotifier(notifier), WithExpiryNotice(7*day))
+		require.NoError(t, err)
+		require.NotNil(t, trackingCmd.expiryTracker)
+
+		defer func() {
+			require.NoError(t, trackingCmd.Close())
+			// closing twice is no-op
+			require.NoError(t, trackingCmd.Close())
+		}()
+
+		select {
+		case msg := <-notifier.messages:
+			require.Equal(t, ExpiryTopic, msg.topic)
+
+			event := &verifiablestore.ExpiryEvent{}
+			require.NoError(t, json.Unmarshal(msg.message, event))
+			require.Equal(t, verifiablestore.CredentialExpiring, event.Type)
+			require.Equal(t, "expiring", event.Record.Name)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for the expiry notification")
+		}
+	})
+}
+
+func TestCommand_Close(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()})
+	require.NoError(t, err)
+	require.Nil(t, cmd.expiryTracker)
+	require.NoError(t, cmd.Close())
+}
+
+func TestGetExpiringCredentials(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	}, WithExpiryNotice(7*day))
+	require.NotNil(t, cmd)
+	require.NoError(t, err)
+
+	saveCredential(t, cmd, "expired", vcExpiringIn(t, sampleVCID+"1", -day))
+	saveCredential(t, cmd, "in 5 days", vcExpiringIn(t, sampleVCID+"2", 5*day))
+	saveCredential(t, cmd, "in 2 days", vcExpiringIn(t, sampleVCID+"3", 2*day))
+	saveCredential(t, cmd, "in 20 days", vcExpiringIn(t, sampleVCID+"4", 20*day))
+	saveCredential(t, cmd, "no expiration", strings.Replace(vc, sampleVCID, sampleVCID+"5", 1))
+
+	getExpiring := func(request string) []string {
+		var rw bytes.Buffer
+		cmdErr := cmd.GetExpiringCredentials(&rw, bytes.NewBufferString(request))
+		require.NoError(t, cmdErr)
+
+		var response ExpiringCredentialsResponse
+		require.NoError(t, json.NewDecoder(&rw).Decode(&response))
+
+		names := make([]string, len(response.Result))
+		for i, r := range response.Result {
+			names[i] = r.Name
+		}
+
+		return names
+	}
+
+	t.Run("within expiry notice period", func(t *testing.T) {
+		require.Equal(t, []string{"in 2 days", "in 5 days"}, getExpiring(`{}`))
+	})
+
+	t.Run("within given number of days", func(t *testing.T) {
+		require.Equal(t, []string{"in 2 days", "in 5 days", "in 20 days"}, getExpiring(`{"days":30}`))
+	})
+
+	t.Run("including expired", func(t *testing.T) {
+		require.Equal(t, []string{"expired", "in 2 days"}, getExpiring(`{"days":3,"includeExpired":true}`))
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		var rw bytes.Buffer
+		cmdErr := cmd.GetExpiringCredentials(&rw, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = cmd.GetExpiringCredentials(&rw, bytes.NewBufferString(`{"days":-1}`))
+		require.Error(t, cmdErr)
+		require.Equal(t, GetExpiringCredentialsErrorCode, cmdErr.Code())
+		require.EqualError(t, cmdErr, errNegativeDays)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		store := mocksstore.NewMockStore(ctrl)
+		store.EXPECT().QueryCredentials(gomock.Any()).Return(nil, errors.New("query error"))
+
+		cmd.verifiableStore = store
+
+		var rw bytes.Buffer
+		cmdErr := cmd.GetExpiringCredentials(&rw, bytes.NewBufferString(`{}`))
+		require.Error(t, cmdErr)
+		require.Equal(t, GetExpiringCredentialsErrorCode, cmdErr.Code())
+		require.EqualError(t, cmdErr, "get expiring credentials : query error")
+	})
+}
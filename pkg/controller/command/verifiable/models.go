@@ -10,6 +10,8 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/store/trustregistry"
 	"github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 )
 
@@ -28,6 +30,17 @@ type PresentationRequest struct {
 	SkipVerify bool `json:"skipVerify,omitempty"`
 }
 
+// PresentationByDefinitionRequest is model for generating verifiable presentation satisfying
+// the presentation definition from the stored credentials.
+type PresentationByDefinitionRequest struct {
+	PresentationDefinition *presexch.PresentationDefinitions `json:"presentationDefinition,omitempty"`
+	DID                    string                            `json:"did,omitempty"`
+	// Selection maps input descriptor ID to ID of the stored credential to be submitted for it.
+	// The first matching credential is submitted for input descriptors which are not in the selection.
+	Selection map[string]string `json:"selection,omitempty"`
+	*ProofOptions
+}
+
 // IDArg model
 //
 // This is used for querying/removing by ID from input json.
@@ -49,8 +62,10 @@ type ProofOptions struct {
 	Challenge string `json:"challenge,omitempty"`
 	// SignatureType signature type used for signing
 	SignatureType string `json:"signatureType,omitempty"`
-	// proofPurpose is purpose of the proof.
-	proofPurpose string
+	// ProofPurpose is purpose of the proof (e.g. "assertionMethod", "authentication", "capabilityInvocation").
+	// If omitted "assertionMethod" is used for credentials and "authentication" for presentations.
+	// Verification method must be listed under the corresponding verification relationship of the DID.
+	ProofPurpose string `json:"proofPurpose,omitempty"`
 }
 
 // CredentialExt is model for verifiable credential with fields related to command features.
@@ -107,6 +122,51 @@ type RecordResult struct {
 	Result []*verifiable.Record `json:"result,omitempty"`
 }
 
+// QueryCredentialsRequest is request model for querying credential records.
+type QueryCredentialsRequest struct {
+	verifiable.CredentialQuery
+}
+
+// QueryCredentialsResponse holds a page of the credential records matching the query.
+type QueryCredentialsResponse struct {
+	// Result is a page of the records
+	Result []*verifiable.Record `json:"result"`
+
+	// Total is the number of all the records matching the query
+	Total int `json:"total"`
+}
+
+// ExpiringCredentialsRequest is request model for getting expiring credential records.
+type ExpiringCredentialsRequest struct {
+	// Days is the number of days from now within which the credentials expire,
+	// the expiry notice period is used if not set
+	Days int `json:"days,omitempty"`
+
+	// IncludeExpired also returns already expired credentials
+	IncludeExpired bool `json:"includeExpired,omitempty"`
+}
+
+// ExpiringCredentialsResponse holds the expiring credential records sorted by expiration date.
+type ExpiringCredentialsResponse struct {
+	Result []*verifiable.Record `json:"result"`
+}
+
+// RefreshCredentialRequest is request model for refreshing the stored credential using its refresh service.
+type RefreshCredentialRequest struct {
+	// Name of the stored credential
+	Name string `json:"name,omitempty"`
+
+	// DID of the holder signing the presentation sent to the refresh service, credential subject ID by default
+	DID string `json:"did,omitempty"`
+
+	*ProofOptions
+}
+
+// RefreshCredentialResponse is model for refresh credential response.
+type RefreshCredentialResponse struct {
+	VerifiableCredential json.RawMessage `json:"verifiableCredential,omitempty"`
+}
+
 // Presentation is model for verifiable presentation.
 type Presentation struct {
 	VerifiablePresentation json.RawMessage `json:"verifiablePresentation,omitempty"`
@@ -119,3 +179,109 @@ type RemoveCredentialByNameResponse struct{}
 // RemovePresentationByNameResponse is a response model for removing a vp by name
 // from the verifiable store.
 type RemovePresentationByNameResponse struct{}
+
+// VerificationPolicy defines the checks which are made when verifying a credential or presentation.
+// The proof, proof purpose, expiry and status checks are enabled if not defined, so they must be explicitly
+// set to false to be disabled.
+type VerificationPolicy struct {
+	// Proof enables the check of the embedded linked data proof or JWS signature.
+	Proof *bool `json:"proof,omitempty"`
+
+	// ProofPurpose enables the check that the verification method of the linked data proof is listed
+	// under the verification relationship of the signer's DID which corresponds to the proof purpose.
+	// As the signature is verified along with the purpose, the check is made only if the proof check is enabled.
+	ProofPurpose *bool `json:"proofPurpose,omitempty"`
+
+	// Expiry enables the check that the credential is not expired and is already valid.
+	Expiry *bool `json:"expiry,omitempty"`
+
+	// Status enables the check of the credential status (e.g. revocation) using the status list credential.
+	Status *bool `json:"status,omitempty"`
+
+	// TrustedIssuers is a list of trusted issuer IDs. If not empty, the credential issuer must be one of them.
+	TrustedIssuers []string `json:"trustedIssuers,omitempty"`
+
+	// TrustRegistry enables the check that the credential issuer is trusted to issue credentials
+	// of its types and contexts by the trust lists of the issuer trust registry.
+	TrustRegistry bool `json:"trustRegistry,omitempty"`
+
+	// Challenge which is expected in the linked data proof of the presentation.
+	Challenge string `json:"challenge,omitempty"`
+
+	// Domain which is expected in the linked data proof of the presentation.
+	Domain string `json:"domain,omitempty"`
+}
+
+// VerifyCredentialRequest is request model for verifying a credential.
+type VerifyCredentialRequest struct {
+	// Credential to verify (JSON-LD or JWT)
+	Credential json.RawMessage `json:"credential,omitempty"`
+
+	// Policy defines the checks to be made. If not defined, all the checks are made
+	// except issuer trust check.
+	Policy *VerificationPolicy `json:"policy,omitempty"`
+}
+
+// VerifyPresentationRequest is request model for verifying a presentation.
+type VerifyPresentationRequest struct {
+	// Presentation to verify (JSON-LD or JWT)
+	Presentation json.RawMessage `json:"presentation,omitempty"`
+
+	// Policy defines the checks to be made for the presentation and each of its credentials.
+	// If not defined, all the checks are made except issuer trust check.
+	Policy *VerificationPolicy `json:"policy,omitempty"`
+}
+
+// VerificationCheck is a result of a single check.
+type VerificationCheck struct {
+	// Check name (e.g. "proof", "expiry")
+	Check string `json:"check"`
+
+	// Passed is true if the check has passed.
+	Passed bool `json:"passed"`
+
+	// Error explains why the check has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// VerifyCredentialResponse is response model for verifying a credential.
+type VerifyCredentialResponse struct {
+	// Verified is true if all the checks have passed.
+	Verified bool `json:"verified"`
+
+	// Checks is a report of the checks made.
+	Checks []VerificationCheck `json:"checks"`
+}
+
+// VerifyPresentationResponse is response model for verifying a presentation.
+type VerifyPresentationResponse struct {
+	// Verified is true if all the checks of the presentation and its credentials have passed.
+	Verified bool `json:"verified"`
+
+	// Checks is a report of the checks made for the presentation.
+	Checks []VerificationCheck `json:"checks"`
+
+	// Credentials holds a report for each credential of the presentation.
+	Credentials []*VerifyCredentialResponse `json:"credentials,omitempty"`
+}
+
+// AddTrustListRequest is request model for adding a trust list to the issuer trust registry.
+type AddTrustListRequest struct {
+	// TrustList is the signed trust list credential.
+	TrustList json.RawMessage `json:"trustList,omitempty"`
+}
+
+// AddTrustListResponse is response model for adding a trust list to the issuer trust registry.
+type AddTrustListResponse struct {
+	TrustList *trustregistry.TrustList `json:"trustList"`
+}
+
+// RemoveTrustListRequest is request model for removing a trust list from the issuer trust registry.
+type RemoveTrustListRequest struct {
+	ID string `json:"id,omitempty"`
+}
+
+// GetTrustListsResponse is response model for getting the trust lists of the issuer trust registry.
+type GetTrustListsResponse struct {
+	TrustLists []*trustregistry.TrustList `json:"trustLists"`
+}
@@ -0,0 +1,455 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/logutil"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	errEmptySignatureType = "signature type is mandatory"
+
+	// refreshStoreName is the store of the refresh service: credentials issued by this agent
+	// which can be refreshed and the challenges of the presentation requests.
+	refreshStoreName = "verifiable_refresh"
+
+	issuedCredentialKeyPattern  = "issuedvc_%s"
+	refreshChallengeKeyPattern  = "refreshchallenge_%s"
+	refreshChallengeValidPeriod = 10 * time.Minute
+)
+
+// RefreshCredential refreshes the stored credential using its refresh service. The presentation of the credential
+// signed by the holder (credential subject by default) is sent to the refresh service, the returned credential
+// is verified and replaces the stored one.
+func (o *Command) RefreshCredential(rw io.Writer, req io.Reader) command.Error {
+	request := &RefreshCredentialRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, RefreshCredentialCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.Name == "" {
+		logutil.LogDebug(logger, CommandName, RefreshCredentialCommandMethod, errEmptyCredentialName)
+
+		return command.NewValidationError(RefreshCredentialErrorCode, fmt.Errorf(errEmptyCredentialName))
+	}
+
+	if request.ProofOptions == nil || request.SignatureType == "" {
+		logutil.LogDebug(logger, CommandName, RefreshCredentialCommandMethod, errEmptySignatureType)
+
+		return command.NewValidationError(RefreshCredentialErrorCode, fmt.Errorf(errEmptySignatureType))
+	}
+
+	refreshed, err := o.refreshCredential(request)
+	if err != nil {
+		logutil.LogError(logger, CommandName, RefreshCredentialCommandMethod, "refresh credential : "+err.Error(),
+			logutil.CreateKeyValueString(vcName, request.Name))
+
+		return command.NewValidationError(RefreshCredentialErrorCode, fmt.Errorf("refresh credential : %w", err))
+	}
+
+	vcBytes, err := refreshed.MarshalJSON()
+	if err != nil {
+		logutil.LogError(logger, CommandName, RefreshCredentialCommandMethod, "marshal credential : "+err.Error())
+
+		return command.NewValidationError(RefreshCredentialErrorCode, fmt.Errorf("marshal credential : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &RefreshCredentialResponse{VerifiableCredential: vcBytes}, logger)
+
+	logutil.LogDebug(logger, CommandName, RefreshCredentialCommandMethod, "success",
+		logutil.CreateKeyValueString(vcName, request.Name))
+
+	return nil
+}
+
+// IssueRefreshedCredential is the refresh service of the issuer. The request without a presentation is answered
+// with the presentation request containing a challenge. The presentation of the credential issued by this agent
+// must be signed by the credential subject with the challenge and the refresh service URL of the credential
+// as a domain. The credential re-issued with the new issuance and expiration dates is returned.
+func (o *Command) IssueRefreshedCredential(rw io.Writer, req io.Reader) command.Error {
+	vpBytes, err := ioutil.ReadAll(req)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, IssueRefreshedCredentialCommandMethod, "request read : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request read : %w", err))
+	}
+
+	if len(bytes.TrimSpace(vpBytes)) == 0 {
+		return o.issueRefreshPresentationRequest(rw)
+	}
+
+	var vpRaw json.RawMessage
+
+	err = json.Unmarshal(vpBytes, &vpRaw)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, IssueRefreshedCredentialCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if string(vpRaw) == "null" {
+		logutil.LogDebug(logger, CommandName, IssueRefreshedCredentialCommandMethod, errEmptyPresentation)
+
+		return command.NewValidationError(IssueRefreshedCredentialErrorCode, fmt.Errorf(errEmptyPresentation))
+	}
+
+	refreshed, err := o.issueRefreshedCredential(vpRaw)
+	if err != nil {
+		logutil.LogError(logger, CommandName, IssueRefreshedCredentialCommandMethod,
+			"issue refreshed credential : "+err.Error())
+
+		return command.NewValidationError(IssueRefreshedCredentialErrorCode,
+			fmt.Errorf("issue refreshed credential : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, refreshed, logger)
+
+	logutil.LogDebug(logger, CommandName, IssueRefreshedCredentialCommandMethod, "success",
+		logutil.CreateKeyValueString(vcID, refreshed.ID))
+
+	return nil
+}
+
+// issueRefreshPresentationRequest saves a new challenge and writes the presentation request containing it.
+func (o *Command) issueRefreshPresentationRequest(rw io.Writer) command.Error {
+	challenge := uuid.New().String()
+	validUntil := time.Now().Add(refreshChallengeValidPeriod).UTC().Format(time.RFC3339Nano)
+
+	err := o.refreshStore.Put(fmt.Sprintf(refreshChallengeKeyPattern, challenge), []byte(validUntil))
+	if err != nil {
+		logutil.LogError(logger, CommandName, IssueRefreshedCredentialCommandMethod,
+			"save challenge : "+err.Error())
+
+		return command.NewExecuteError(IssueRefreshedCredentialErrorCode, fmt.Errorf("save challenge : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &verifiable.RefreshPresentationRequest{
+		Query:     []map[string]interface{}{{"type": "DIDAuthentication"}},
+		Challenge: challenge,
+	}, logger)
+
+	logutil.LogDebug(logger, CommandName, IssueRefreshedCredentialCommandMethod, "presentation request issued")
+
+	return nil
+}
+
+func (o *Command) refreshCredential(request *RefreshCredentialRequest) (*verifiable.Credential, error) {
+	id, err := o.verifiableStore.GetCredentialIDByName(request.Name)
+	if err != nil {
+		return nil, fmt.Errorf("get credential id using name : %w", err)
+	}
+
+	vc, err := o.verifiableStore.GetCredential(id)
+	if err != nil {
+		return nil, fmt.Errorf("get credential : %w", err)
+	}
+
+	holder := request.DID
+	if holder == "" {
+		holder, err = verifiable.SubjectID(vc.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("holder DID is not defined and credential subject has no ID : %w", err)
+		}
+	}
+
+	didDoc, err := o.resolveDID(holder)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := prepareOpts(request.ProofOptions, didDoc, did.Authentication)
+	if err != nil {
+		return nil, fmt.Errorf("prepare proof options : %w", err)
+	}
+
+	refreshed, err := o.refreshClient.Refresh(vc, func(challenge, domain string) ([]byte, error) {
+		opts.Challenge = challenge
+		opts.Domain = domain
+
+		vpBytes, e := o.createAndSignPresentation([]interface{}{vc}, nil, holder, opts)
+		if e != nil {
+			return nil, fmt.Errorf("create and sign vp : %w", e)
+		}
+
+		return vpBytes, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = o.verifiableStore.UpdateCredential(request.Name, refreshed)
+	if err != nil {
+		return nil, fmt.Errorf("update credential : %w", err)
+	}
+
+	return refreshed, nil
+}
+
+func (o *Command) issueRefreshedCredential(vpBytes []byte) (*verifiable.Credential, error) {
+	vp, err := verifiable.ParsePresentation(vpBytes, verifiable.WithPresDisabledProofCheck(),
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader))
+	if err != nil {
+		return nil, fmt.Errorf("parse presentation : %w", err)
+	}
+
+	if err = checkSignedByHolder(vp); err != nil {
+		return nil, err
+	}
+
+	issued, err := o.getPresentedIssuedCredential(vp)
+	if err != nil {
+		return nil, err
+	}
+
+	// the presentation must be made for this refresh service
+	domain, err := verifiable.RefreshServiceURL(issued)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := proofEntry(vp.Proofs[0], "challenge")
+
+	_, err = verifiable.ParsePresentation(vpBytes,
+		verifiable.WithPresPublicKeyFetcher(o.kResolver.PublicKeyFetcher()),
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader),
+		verifiable.WithPresChallenge(challenge),
+		verifiable.WithPresDomain(domain),
+		verifiable.WithPresProofPurposeCheck(o.ctx.VDRIRegistry()))
+	if err != nil {
+		return nil, fmt.Errorf("verify presentation : %w", err)
+	}
+
+	subjectID, err := verifiable.SubjectID(issued.Subject)
+	if err != nil || subjectID != vp.Holder {
+		return nil, fmt.Errorf("presentation holder %s is not the credential subject", vp.Holder)
+	}
+
+	// the challenge is consumed by a verified presentation only, so it can't be burnt by an invalid one
+	if err = o.useRefreshChallenge(challenge); err != nil {
+		return nil, err
+	}
+
+	verificationMethod := proofEntry(issued.Proofs[0], "verificationMethod")
+
+	if err = o.checkIssuerKey(issued.Issuer.ID, verificationMethod); err != nil {
+		return nil, err
+	}
+
+	refreshed := reissue(issued)
+
+	err = o.addLinkedDataProof(refreshed, &ProofOptions{
+		VerificationMethod: verificationMethod,
+		SignatureType:      proofEntry(issued.Proofs[0], "type"),
+		ProofPurpose:       proofEntry(issued.Proofs[0], "proofPurpose"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sign refreshed credential : %w", err)
+	}
+
+	err = o.saveIssuedCredential(refreshed)
+	if err != nil {
+		return nil, fmt.Errorf("update issued credential : %w", err)
+	}
+
+	return refreshed, nil
+}
+
+// getPresentedIssuedCredential returns the credential issued by this agent which is presented
+// by the presentation.
+func (o *Command) getPresentedIssuedCredential(vp *verifiable.Presentation) (*verifiable.Credential, error) {
+	credentials, err := vp.MarshalledCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("get presentation credentials : %w", err)
+	}
+
+	if len(credentials) != 1 {
+		return nil, errors.New("presentation must contain exactly one credential")
+	}
+
+	presented, err := verifiable.ParseUnverifiedCredential(unquote(credentials[0]),
+		verifiable.WithJSONLDDocumentLoader(o.documentLoader))
+	if err != nil {
+		return nil, fmt.Errorf("parse presented credential : %w", err)
+	}
+
+	if presented.ID == "" {
+		return nil, errors.New("presented credential has no ID")
+	}
+
+	vcBytes, err := o.refreshStore.Get(fmt.Sprintf(issuedCredentialKeyPattern, presented.ID))
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return nil, fmt.Errorf("credential %s is not issued by this agent", presented.ID)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get issued credential : %w", err)
+	}
+
+	issued, err := verifiable.ParseUnverifiedCredential(vcBytes, verifiable.WithJSONLDDocumentLoader(o.documentLoader))
+	if err != nil {
+		return nil, fmt.Errorf("parse issued credential : %w", err)
+	}
+
+	if len(issued.Proofs) == 0 {
+		return nil, fmt.Errorf("issued credential %s has no proof", issued.ID)
+	}
+
+	return issued, nil
+}
+
+// saveIssuedCredential saves the credential signed by this agent to the index of the refresh service
+// if the credential can be refreshed (i.e. it has ID and the refresh service).
+func (o *Command) saveIssuedCredential(vc *verifiable.Credential) error {
+	if _, err := verifiable.RefreshServiceURL(vc); err != nil || vc.ID == "" {
+		return nil
+	}
+
+	vcBytes, err := vc.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal credential : %w", err)
+	}
+
+	return o.refreshStore.Put(fmt.Sprintf(issuedCredentialKeyPattern, vc.ID), vcBytes)
+}
+
+// useRefreshChallenge checks that the challenge was issued by this refresh service and is still valid.
+// The challenge can be used only once.
+func (o *Command) useRefreshChallenge(challenge string) error {
+	if challenge == "" {
+		return errors.New("presentation has no challenge")
+	}
+
+	key := fmt.Sprintf(refreshChallengeKeyPattern, challenge)
+
+	validUntil, err := o.refreshStore.Get(key)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return fmt.Errorf("challenge %s is not issued by this refresh service", challenge)
+	}
+
+	if err != nil {
+		return fmt.Errorf("get challenge : %w", err)
+	}
+
+	if err = o.refreshStore.Delete(key); err != nil {
+		return fmt.Errorf("delete challenge : %w", err)
+	}
+
+	expires, err := time.Parse(time.RFC3339Nano, string(validUntil))
+	if err != nil || time.Now().After(expires) {
+		return fmt.Errorf("challenge %s has expired", challenge)
+	}
+
+	return nil
+}
+
+// checkIssuerKey checks that the verification method belongs to the issuer DID and its key is managed
+// by this agent.
+func (o *Command) checkIssuerKey(issuer, verificationMethod string) error {
+	parts := strings.Split(verificationMethod, "#")
+	if len(parts) != creatorParts || parts[0] != issuer {
+		return fmt.Errorf("verification method %s does not belong to issuer %s", verificationMethod, issuer)
+	}
+
+	didDoc, err := o.resolveDID(issuer)
+	if err != nil {
+		return err
+	}
+
+	kmsKey, err := o.ctx.KMS().ExportPubKeyBytes(parts[1])
+	if err != nil {
+		return fmt.Errorf("issuer %s is not controlled by this agent : %w", issuer, err)
+	}
+
+	for _, pk := range didDoc.PublicKey {
+		if (pk.ID == verificationMethod || pk.ID == "#"+parts[1]) && bytes.Equal(pk.Value, kmsKey) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("issuer %s is not controlled by this agent", issuer)
+}
+
+func (o *Command) resolveDID(didID string) (*did.Doc, error) {
+	didDoc, err := o.ctx.VDRIRegistry().Resolve(didID)
+	//  if did not found in VDRI, look through in local storage
+	if err != nil {
+		didDoc, err = o.didStore.GetDID(didID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get did doc from store or vdri : %w", err)
+		}
+	}
+
+	return didDoc, nil
+}
+
+// checkSignedByHolder checks that every proof of the presentation is made with the key of the holder.
+func checkSignedByHolder(vp *verifiable.Presentation) error {
+	if vp.Holder == "" {
+		return errors.New("presentation holder is not defined")
+	}
+
+	if len(vp.Proofs) == 0 {
+		return errors.New("presentation is not signed")
+	}
+
+	for _, proof := range vp.Proofs {
+		vm := proofEntry(proof, "verificationMethod")
+		if vm == "" {
+			vm = proofEntry(proof, "creator")
+		}
+
+		if !strings.HasPrefix(vm, vp.Holder+"#") {
+			return fmt.Errorf("presentation is not signed by the holder %s", vp.Holder)
+		}
+	}
+
+	return nil
+}
+
+// reissue returns a copy of the credential without proofs, issued now and valid for the same period of time.
+func reissue(vc *verifiable.Credential) *verifiable.Credential {
+	refreshed := *vc
+	refreshed.Proofs = nil
+
+	now := time.Now().UTC()
+
+	if vc.Expired != nil && vc.Issued != nil {
+		refreshed.Expired = util.NewTime(now.Add(vc.Expired.Time.Sub(vc.Issued.Time)))
+	}
+
+	refreshed.Issued = util.NewTime(now)
+
+	return &refreshed
+}
+
+func proofEntry(proof verifiable.Proof, key string) string {
+	if value, ok := proof[key].(string); ok {
+		return value
+	}
+
+	return ""
+}
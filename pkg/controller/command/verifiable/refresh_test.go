@@ -0,0 +1,451 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/crypto/tinkcrypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
+	mockkms "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/secretlock/noop"
+)
+
+const (
+	refreshIssuerDID = "did:example:refresh-issuer"
+	refreshHolderDID = "did:example:refresh-holder"
+	refreshOtherDID  = "did:example:refresh-other"
+
+	refreshTestVC = `{
+  "@context": [
+    "https://www.w3.org/2018/credentials/v1",
+    "https://www.w3.org/2018/credentials/examples/v1",
+    "https://w3id.org/vc-refresh-service/v1"
+  ],
+  "id": "http://example.edu/credentials/%s",
+  "type": ["VerifiableCredential", "UniversityDegreeCredential"],
+  "issuer": "did:example:refresh-issuer",
+  "issuanceDate": "2020-01-01T10:54:01Z",
+  "expirationDate": "2021-01-01T10:54:01Z",
+  "credentialSubject": {
+    "id": "did:example:refresh-holder",
+    "degree": {
+      "type": "BachelorDegree",
+      "name": "Bachelor of Science and Arts"
+    }
+  },
+  "refreshService": {
+    "id": "%s",
+    "type": "VerifiableCredentialRefreshService2021"
+  }
+}`
+)
+
+type refreshTestAgents struct {
+	issuer *Command
+	holder *Command
+	server *httptest.Server
+}
+
+func newRefreshTestAgents(t *testing.T) *refreshTestAgents {
+	t.Helper()
+
+	didDocs := map[string]*did.Doc{}
+
+	registry := &mockvdri.MockVDRIRegistry{
+		ResolveFunc: func(didID string, opts ...vdri.ResolveOpts) (*did.Doc, error) {
+			doc, ok := didDocs[didID]
+			if !ok {
+				return nil, fmt.Errorf("DID %s not found", didID)
+			}
+
+			return doc, nil
+		},
+	}
+
+	newAgent := func(dids ...string) *Command {
+		keyManager, err := localkms.New("local-lock://test/key/uri",
+			mockkms.NewProviderForKMS(mockstore.NewMockStoreProvider(), &noop.NoLock{}))
+		require.NoError(t, err)
+
+		crypto, err := tinkcrypto.New()
+		require.NoError(t, err)
+
+		for _, didID := range dids {
+			kid, _, err := keyManager.Create(kms.ED25519Type)
+			require.NoError(t, err)
+
+			pubKey, err := keyManager.ExportPubKeyBytes(kid)
+			require.NoError(t, err)
+
+			pk := &did.PublicKey{
+				ID:         didID + "#" + kid,
+				Type:       "Ed25519VerificationKey2018",
+				Controller: didID,
+				Value:      pubKey,
+			}
+
+			didDocs[didID] = &did.Doc{
+				ID:        didID,
+				PublicKey: []did.PublicKey{*pk},
+				AssertionMethod: []did.VerificationMethod{
+					*did.NewReferencedVerificationMethod(pk, did.AssertionMethod, false),
+				},
+				Authentication: []did.VerificationMethod{
+					*did.NewReferencedVerificationMethod(pk, did.Authentication, false),
+				},
+			}
+		}
+
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    registry,
+			KMSValue:             keyManager,
+			CryptoValue:          crypto,
+		}, WithTrustAnchors(refreshIssuerDID))
+		require.NoError(t, err)
+
+		return cmd
+	}
+
+	agents := &refreshTestAgents{
+		issuer: newAgent(refreshIssuerDID),
+		holder: newAgent(refreshHolderDID, refreshOtherDID),
+	}
+
+	agents.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var b bytes.Buffer
+
+		if err := agents.issuer.IssueRefreshedCredential(&b, r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		_, err := w.Write(b.Bytes())
+		require.NoError(t, err)
+	}))
+
+	return agents
+}
+
+// issue signs the credential by the issuer and saves it to the holder store.
+func (a *refreshTestAgents) issue(t *testing.T, name, refreshURL string) {
+	t.Helper()
+
+	var b bytes.Buffer
+
+	reqBytes, err := json.Marshal(&SignCredentialRequest{
+		Credential:   []byte(fmt.Sprintf(refreshTestVC, name, refreshURL)),
+		DID:          refreshIssuerDID,
+		ProofOptions: &ProofOptions{SignatureType: Ed25519Signature2018},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, a.issuer.SignCredential(&b, bytes.NewBuffer(reqBytes)))
+
+	var signed SignCredentialResponse
+	require.NoError(t, json.Unmarshal(b.Bytes(), &signed))
+
+	saveCredential(t, a.holder, name, string(signed.VerifiableCredential))
+}
+
+// presentation returns the presentation of the stored credential signed by the holder.
+func (a *refreshTestAgents) presentation(t *testing.T, name, challenge, domain string) []byte {
+	t.Helper()
+
+	id, err := a.holder.verifiableStore.GetCredentialIDByName(name)
+	require.NoError(t, err)
+
+	vc, err := a.holder.verifiableStore.GetCredential(id)
+	require.NoError(t, err)
+
+	didDoc, err := a.holder.resolveDID(refreshHolderDID)
+	require.NoError(t, err)
+
+	opts, err := prepareOpts(&ProofOptions{
+		SignatureType: Ed25519Signature2018,
+		Challenge:     challenge,
+		Domain:        domain,
+	}, didDoc, did.Authentication)
+	require.NoError(t, err)
+
+	vpBytes, err := a.holder.createAndSignPresentation([]interface{}{vc}, nil, refreshHolderDID, opts)
+	require.NoError(t, err)
+
+	return vpBytes
+}
+
+// challenge requests the presentation request from the refresh service and returns its challenge.
+func (a *refreshTestAgents) challenge(t *testing.T) string {
+	t.Helper()
+
+	var b bytes.Buffer
+
+	require.NoError(t, a.issuer.IssueRefreshedCredential(&b, bytes.NewBuffer(nil)))
+
+	var request verifiable.RefreshPresentationRequest
+	require.NoError(t, json.Unmarshal(b.Bytes(), &request))
+	require.NotEmpty(t, request.Challenge)
+
+	return request.Challenge
+}
+
+func (a *refreshTestAgents) refresh(request *RefreshCredentialRequest) (*RefreshCredentialResponse, error) {
+	reqBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+
+	if cmdErr := a.holder.RefreshCredential(&b, bytes.NewBuffer(reqBytes)); cmdErr != nil {
+		return nil, cmdErr
+	}
+
+	response := &RefreshCredentialResponse{}
+
+	return response, json.Unmarshal(b.Bytes(), response)
+}
+
+func TestRefreshCredential(t *testing.T) {
+	agents := newRefreshTestAgents(t)
+	defer agents.server.Close()
+
+	ed25519Opts := &ProofOptions{SignatureType: Ed25519Signature2018}
+
+	t.Run("credential is refreshed", func(t *testing.T) {
+		agents.issue(t, "refreshed", agents.server.URL)
+
+		response, err := agents.refresh(&RefreshCredentialRequest{Name: "refreshed", ProofOptions: ed25519Opts})
+		require.NoError(t, err)
+
+		refreshed, err := verifiable.ParseCredential(response.VerifiableCredential,
+			verifiable.WithPublicKeyFetcher(agents.holder.kResolver.PublicKeyFetcher()))
+		require.NoError(t, err)
+		require.Equal(t, "http://example.edu/credentials/refreshed", refreshed.ID)
+		require.WithinDuration(t, time.Now(), refreshed.Issued.Time, time.Minute)
+		require.Equal(t, 366*24*time.Hour, refreshed.Expired.Time.Sub(refreshed.Issued.Time))
+
+		stored, err := agents.holder.verifiableStore.GetCredential(refreshed.ID)
+		require.NoError(t, err)
+		require.Equal(t, refreshed.Issued.Unix(), stored.Issued.Unix())
+
+		vp, err := verifiable.ParsePresentation(agents.presentation(t, "refreshed", "", ""),
+			verifiable.WithPresDisabledProofCheck())
+		require.NoError(t, err)
+
+		issued, err := agents.issuer.getPresentedIssuedCredential(vp)
+		require.NoError(t, err)
+		require.Equal(t, refreshed.Issued.Unix(), issued.Issued.Unix())
+	})
+
+	t.Run("presentation is not signed by the credential subject", func(t *testing.T) {
+		agents.issue(t, "other-holder", agents.server.URL)
+
+		_, err := agents.refresh(&RefreshCredentialRequest{
+			Name:         "other-holder",
+			DID:          refreshOtherDID,
+			ProofOptions: ed25519Opts,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "presentation holder "+refreshOtherDID+" is not the credential subject")
+	})
+
+	t.Run("credential is not issued by the refresh service", func(t *testing.T) {
+		agents.issue(t, "unknown", agents.server.URL)
+		require.NoError(t, agents.issuer.refreshStore.Delete(
+			fmt.Sprintf(issuedCredentialKeyPattern, "http://example.edu/credentials/unknown")))
+
+		_, err := agents.refresh(&RefreshCredentialRequest{Name: "unknown", ProofOptions: ed25519Opts})
+		require.Error(t, err)
+		require.Contains(t, err.Error(),
+			"credential http://example.edu/credentials/unknown is not issued by this agent")
+	})
+
+	t.Run("credential has no refresh service", func(t *testing.T) {
+		saveCredential(t, agents.holder, "no-refresh-service", vc)
+
+		_, err := agents.refresh(&RefreshCredentialRequest{
+			Name:         "no-refresh-service",
+			DID:          refreshHolderDID,
+			ProofOptions: ed25519Opts,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), verifiable.ErrNoRefreshService.Error())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := agents.holder.RefreshCredential(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		_, err := agents.refresh(&RefreshCredentialRequest{ProofOptions: ed25519Opts})
+		require.EqualError(t, err, errEmptyCredentialName)
+
+		_, err = agents.refresh(&RefreshCredentialRequest{Name: "refreshed"})
+		require.EqualError(t, err, errEmptySignatureType)
+
+		_, err = agents.refresh(&RefreshCredentialRequest{Name: "not-found", ProofOptions: ed25519Opts})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get credential id using name")
+
+		_, err = agents.refresh(&RefreshCredentialRequest{
+			Name:         "refreshed",
+			DID:          "did:example:unknown",
+			ProofOptions: ed25519Opts,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to get did doc from store or vdri")
+	})
+}
+
+func TestIssueRefreshedCredential(t *testing.T) {
+	agents := newRefreshTestAgents(t)
+	defer agents.server.Close()
+
+	issue := func(request string) error {
+		var b bytes.Buffer
+
+		if cmdErr := agents.issuer.IssueRefreshedCredential(&b, bytes.NewBufferString(request)); cmdErr != nil {
+			return cmdErr
+		}
+
+		return nil
+	}
+
+	t.Run("invalid request", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := agents.issuer.IssueRefreshedCredential(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		require.EqualError(t, issue("null"), errEmptyPresentation)
+	})
+
+	t.Run("presentation is not signed by the holder", func(t *testing.T) {
+		err := issue(`{"@context": ["https://www.w3.org/2018/credentials/v1"], "type": "VerifiablePresentation"}`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "presentation holder is not defined")
+
+		err = issue(`{"@context": ["https://www.w3.org/2018/credentials/v1"], "type": "VerifiablePresentation",
+			"holder": "did:example:refresh-holder"}`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "presentation is not signed")
+	})
+
+	t.Run("invalid presentation", func(t *testing.T) {
+		err := issue(`{"type": "VerifiablePresentation"}`)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse presentation")
+	})
+
+	t.Run("presentation request", func(t *testing.T) {
+		var b bytes.Buffer
+
+		require.NoError(t, agents.issuer.IssueRefreshedCredential(&b, bytes.NewBufferString(" ")))
+
+		var request verifiable.RefreshPresentationRequest
+		require.NoError(t, json.Unmarshal(b.Bytes(), &request))
+		require.NotEmpty(t, request.Challenge)
+		require.Equal(t, "DIDAuthentication", request.Query[0]["type"])
+	})
+
+	const refreshURL = "https://issuer.example.com/refresh"
+
+	agents.issue(t, "degree", refreshURL)
+
+	t.Run("challenge can be used once", func(t *testing.T) {
+		vp := agents.presentation(t, "degree", agents.challenge(t), refreshURL)
+
+		require.NoError(t, issue(string(vp)))
+
+		err := issue(string(vp))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not issued by this refresh service")
+	})
+
+	t.Run("presentation without challenge", func(t *testing.T) {
+		err := issue(string(agents.presentation(t, "degree", "", refreshURL)))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "presentation has no challenge")
+	})
+
+	t.Run("expired challenge", func(t *testing.T) {
+		challenge := agents.challenge(t)
+
+		require.NoError(t, agents.issuer.refreshStore.Put(fmt.Sprintf(refreshChallengeKeyPattern, challenge),
+			[]byte(time.Now().Add(-time.Minute).UTC().Format(time.RFC3339Nano))))
+
+		err := issue(string(agents.presentation(t, "degree", challenge, refreshURL)))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "challenge "+challenge+" has expired")
+	})
+
+	t.Run("presentation for another domain", func(t *testing.T) {
+		err := issue(string(agents.presentation(t, "degree", agents.challenge(t), "https://other.example.com")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proof domain does not match the expected one")
+	})
+
+	t.Run("challenge is not consumed by invalid presentation", func(t *testing.T) {
+		challenge := agents.challenge(t)
+
+		err := issue(string(agents.presentation(t, "degree", challenge, "https://other.example.com")))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "verify presentation")
+
+		require.NoError(t, issue(string(agents.presentation(t, "degree", challenge, refreshURL))))
+	})
+
+	t.Run("issuer key is not controlled by the agent", func(t *testing.T) {
+		vp, err := verifiable.ParsePresentation(agents.presentation(t, "degree", "", ""),
+			verifiable.WithPresDisabledProofCheck())
+		require.NoError(t, err)
+
+		issued, err := agents.issuer.getPresentedIssuedCredential(vp)
+		require.NoError(t, err)
+
+		vm := proofEntry(issued.Proofs[0], "verificationMethod")
+
+		require.NoError(t, agents.issuer.checkIssuerKey(refreshIssuerDID, vm))
+
+		err = agents.holder.checkIssuerKey(refreshIssuerDID, vm)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "issuer "+refreshIssuerDID+" is not controlled by this agent")
+
+		err = agents.issuer.checkIssuerKey(refreshOtherDID, vm)
+		require.EqualError(t, err, "verification method "+vm+" does not belong to issuer "+refreshOtherDID)
+
+		otherDoc, err := agents.holder.resolveDID(refreshOtherDID)
+		require.NoError(t, err)
+
+		otherKeyID := strings.Split(otherDoc.PublicKey[0].ID, "#")[1]
+
+		err = agents.holder.checkIssuerKey(refreshIssuerDID, refreshIssuerDID+"#"+otherKeyID)
+		require.EqualError(t, err, "issuer "+refreshIssuerDID+" is not controlled by this agent")
+	})
+}
@@ -0,0 +1,98 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/logutil"
+)
+
+const (
+	errEmptyTrustList   = "trust list is mandatory"
+	errEmptyTrustListID = "trust list id is mandatory"
+
+	// log constants
+	trustListID = "trustListID"
+)
+
+// AddTrustList verifies the signed trust list credential and adds it to the issuer trust registry.
+// The trust list with the same ID is replaced.
+func (o *Command) AddTrustList(rw io.Writer, req io.Reader) command.Error {
+	request := &AddTrustListRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, AddTrustListCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if len(request.TrustList) == 0 {
+		logutil.LogDebug(logger, CommandName, AddTrustListCommandMethod, errEmptyTrustList)
+
+		return command.NewValidationError(AddTrustListErrorCode, fmt.Errorf(errEmptyTrustList))
+	}
+
+	list, err := o.trustRegistry.AddTrustList(request.TrustList)
+	if err != nil {
+		logutil.LogError(logger, CommandName, AddTrustListCommandMethod, "add trust list : "+err.Error())
+
+		return command.NewValidationError(AddTrustListErrorCode, fmt.Errorf("add trust list : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, &AddTrustListResponse{TrustList: list}, logger)
+
+	logutil.LogDebug(logger, CommandName, AddTrustListCommandMethod, "success",
+		logutil.CreateKeyValueString(trustListID, list.ID))
+
+	return nil
+}
+
+// RemoveTrustList removes the trust list from the issuer trust registry.
+func (o *Command) RemoveTrustList(rw io.Writer, req io.Reader) command.Error {
+	request := &RemoveTrustListRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, RemoveTrustListCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if request.ID == "" {
+		logutil.LogDebug(logger, CommandName, RemoveTrustListCommandMethod, errEmptyTrustListID)
+
+		return command.NewValidationError(RemoveTrustListErrorCode, fmt.Errorf(errEmptyTrustListID))
+	}
+
+	if err := o.trustRegistry.RemoveTrustList(request.ID); err != nil {
+		logutil.LogError(logger, CommandName, RemoveTrustListCommandMethod, "remove trust list : "+err.Error(),
+			logutil.CreateKeyValueString(trustListID, request.ID))
+
+		return command.NewValidationError(RemoveTrustListErrorCode, fmt.Errorf("remove trust list : %w", err))
+	}
+
+	command.WriteNillableResponse(rw, nil, logger)
+
+	logutil.LogDebug(logger, CommandName, RemoveTrustListCommandMethod, "success",
+		logutil.CreateKeyValueString(trustListID, request.ID))
+
+	return nil
+}
+
+// GetTrustLists retrieves the trust lists of the issuer trust registry.
+func (o *Command) GetTrustLists(rw io.Writer, req io.Reader) command.Error {
+	command.WriteNillableResponse(rw, &GetTrustListsResponse{TrustLists: o.trustRegistry.TrustLists()}, logger)
+
+	logutil.LogDebug(logger, CommandName, GetTrustListsCommandMethod, "success")
+
+	return nil
+}
@@ -0,0 +1,190 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/store/trustregistry"
+)
+
+const testTrustListID = "https://example.com/trust-lists/education"
+
+func TestTrustLists(t *testing.T) {
+	agents := newRefreshTestAgents(t)
+	defer agents.server.Close()
+
+	trustList := signTestCredential(t, agents.issuer, trustListCredential(t, "UniversityDegreeCredential"))
+	degree := signTestCredential(t, agents.issuer, []byte(fmt.Sprintf(refreshTestVC, "trust", "https://example.com")))
+
+	t.Run("add trust list", func(t *testing.T) {
+		var b bytes.Buffer
+
+		reqBytes, err := json.Marshal(&AddTrustListRequest{TrustList: trustList})
+		require.NoError(t, err)
+
+		require.NoError(t, agents.holder.AddTrustList(&b, bytes.NewBuffer(reqBytes)))
+
+		var response AddTrustListResponse
+		require.NoError(t, json.Unmarshal(b.Bytes(), &response))
+		require.Equal(t, testTrustListID, response.TrustList.ID)
+		require.Equal(t, refreshIssuerDID, response.TrustList.Issuer)
+	})
+
+	t.Run("get trust lists", func(t *testing.T) {
+		var b bytes.Buffer
+
+		require.NoError(t, agents.holder.GetTrustLists(&b, nil))
+
+		var response GetTrustListsResponse
+		require.NoError(t, json.Unmarshal(b.Bytes(), &response))
+		require.Len(t, response.TrustLists, 1)
+		require.Equal(t, refreshIssuerDID, response.TrustLists[0].Issuers[0].DID)
+	})
+
+	t.Run("verify credential using trust registry", func(t *testing.T) {
+		response := verifyTestCredential(t, agents.holder, degree, trustRegistryPolicy())
+		require.True(t, response.Verified)
+		require.Equal(t, []VerificationCheck{
+			{Check: FormatCheck, Passed: true},
+			{Check: ProofCheck, Passed: true},
+			{Check: IssuerTrustCheck, Passed: true},
+		}, response.Checks)
+
+		response = verifyTestCredential(t, agents.issuer, degree, trustRegistryPolicy())
+		require.False(t, response.Verified)
+		require.Contains(t, response.Checks[2].Error, trustregistry.ErrUntrustedIssuer.Error())
+
+		response = verifyTestCredential(t, agents.holder, degree, trustRegistryPolicy(refreshOtherDID))
+		require.False(t, response.Verified)
+		require.Equal(t, "issuer did:example:refresh-issuer is not trusted", response.Checks[2].Error)
+	})
+
+	t.Run("remove trust list", func(t *testing.T) {
+		var b bytes.Buffer
+
+		reqBytes, err := json.Marshal(&RemoveTrustListRequest{ID: testTrustListID})
+		require.NoError(t, err)
+
+		require.NoError(t, agents.holder.RemoveTrustList(&b, bytes.NewBuffer(reqBytes)))
+
+		response := verifyTestCredential(t, agents.holder, degree, trustRegistryPolicy())
+		require.False(t, response.Verified)
+
+		cmdErr := agents.holder.RemoveTrustList(&b, bytes.NewBuffer(reqBytes))
+		require.Error(t, cmdErr)
+		require.Equal(t, RemoveTrustListErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), "remove trust list")
+	})
+
+	t.Run("add trust list errors", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := agents.holder.AddTrustList(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = agents.holder.AddTrustList(&b, bytes.NewBufferString("{}"))
+		require.Error(t, cmdErr)
+		require.Equal(t, AddTrustListErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), errEmptyTrustList)
+
+		reqBytes, err := json.Marshal(&AddTrustListRequest{TrustList: trustListCredential(t)})
+		require.NoError(t, err)
+
+		cmdErr = agents.holder.AddTrustList(&b, bytes.NewBuffer(reqBytes))
+		require.Error(t, cmdErr)
+		require.Equal(t, AddTrustListErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), "trust list credential must be signed")
+	})
+
+	t.Run("remove trust list errors", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := agents.holder.RemoveTrustList(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = agents.holder.RemoveTrustList(&b, bytes.NewBufferString("{}"))
+		require.Error(t, cmdErr)
+		require.Equal(t, RemoveTrustListErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), errEmptyTrustListID)
+	})
+}
+
+func trustListCredential(t *testing.T, credentialTypes ...string) []byte {
+	t.Helper()
+
+	vc, err := trustregistry.NewTrustListCredential(refreshIssuerDID, &trustregistry.TrustList{
+		ID: testTrustListID,
+		Issuers: []*trustregistry.TrustedIssuer{{
+			DID:             refreshIssuerDID,
+			CredentialTypes: credentialTypes,
+		}},
+	})
+	require.NoError(t, err)
+
+	vcBytes, err := vc.MarshalJSON()
+	require.NoError(t, err)
+
+	return vcBytes
+}
+
+func signTestCredential(t *testing.T, cmd *Command, vcBytes []byte) json.RawMessage {
+	t.Helper()
+
+	var b bytes.Buffer
+
+	reqBytes, err := json.Marshal(&SignCredentialRequest{
+		Credential:   vcBytes,
+		DID:          refreshIssuerDID,
+		ProofOptions: &ProofOptions{SignatureType: Ed25519Signature2018},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, cmd.SignCredential(&b, bytes.NewBuffer(reqBytes)))
+
+	var signed SignCredentialResponse
+	require.NoError(t, json.Unmarshal(b.Bytes(), &signed))
+
+	return signed.VerifiableCredential
+}
+
+// trustRegistryPolicy enables the proof and issuer trust checks only.
+func trustRegistryPolicy(trustedIssuers ...string) *VerificationPolicy {
+	disabled := false
+
+	return &VerificationPolicy{
+		ProofPurpose:   &disabled,
+		Expiry:         &disabled,
+		Status:         &disabled,
+		TrustedIssuers: trustedIssuers,
+		TrustRegistry:  true,
+	}
+}
+
+func verifyTestCredential(t *testing.T, cmd *Command, vcBytes json.RawMessage,
+	policy *VerificationPolicy) *VerifyCredentialResponse {
+	t.Helper()
+
+	var b bytes.Buffer
+
+	reqBytes, err := json.Marshal(&VerifyCredentialRequest{Credential: vcBytes, Policy: policy})
+	require.NoError(t, err)
+
+	require.NoError(t, cmd.VerifyCredential(&b, bytes.NewBuffer(reqBytes)))
+
+	var response VerifyCredentialResponse
+	require.NoError(t, json.Unmarshal(b.Bytes(), &response))
+
+	return &response
+}
@@ -0,0 +1,329 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/logutil"
+)
+
+// names of the verification checks
+const (
+	FormatCheck       = "format"
+	ProofCheck        = "proof"
+	ProofPurposeCheck = "proofPurpose"
+	ExpiryCheck       = "expiry"
+	StatusCheck       = "status"
+	IssuerTrustCheck  = "issuerTrust"
+
+	errEmptyCredential   = "credential is mandatory"
+	errEmptyPresentation = "presentation is mandatory"
+)
+
+// checkEnabled tells whether the check of the policy is enabled (enabled if not defined).
+func checkEnabled(check *bool) bool {
+	return check == nil || *check
+}
+
+type verificationReport struct {
+	checks []VerificationCheck
+	passed bool
+}
+
+func newVerificationReport() *verificationReport {
+	return &verificationReport{passed: true}
+}
+
+func (r *verificationReport) add(check string, err error) {
+	result := VerificationCheck{Check: check, Passed: err == nil}
+
+	if err != nil {
+		result.Error = err.Error()
+		r.passed = false
+	}
+
+	r.checks = append(r.checks, result)
+}
+
+// VerifyCredential verifies the verifiable credential according to the policy
+// and returns a report of the checks made.
+func (o *Command) VerifyCredential(rw io.Writer, req io.Reader) command.Error {
+	request := &VerifyCredentialRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, VerifyCredentialCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if len(request.Credential) == 0 {
+		logutil.LogDebug(logger, CommandName, VerifyCredentialCommandMethod, errEmptyCredential)
+
+		return command.NewValidationError(VerifyCredentialErrorCode, fmt.Errorf(errEmptyCredential))
+	}
+
+	policy := request.Policy
+	if policy == nil {
+		policy = &VerificationPolicy{}
+	}
+
+	response := o.verifyCredential(unquote(request.Credential), policy)
+
+	command.WriteNillableResponse(rw, response, logger)
+
+	logutil.LogDebug(logger, CommandName, VerifyCredentialCommandMethod, "success")
+
+	return nil
+}
+
+// VerifyPresentation verifies the verifiable presentation and its credentials according to the policy
+// and returns a report of the checks made.
+func (o *Command) VerifyPresentation(rw io.Writer, req io.Reader) command.Error {
+	request := &VerifyPresentationRequest{}
+
+	err := json.NewDecoder(req).Decode(&request)
+	if err != nil {
+		logutil.LogInfo(logger, CommandName, VerifyPresentationCommandMethod, "request decode : "+err.Error())
+
+		return command.NewValidationError(InvalidRequestErrorCode, fmt.Errorf("request decode : %w", err))
+	}
+
+	if len(request.Presentation) == 0 {
+		logutil.LogDebug(logger, CommandName, VerifyPresentationCommandMethod, errEmptyPresentation)
+
+		return command.NewValidationError(VerifyPresentationErrorCode, fmt.Errorf(errEmptyPresentation))
+	}
+
+	policy := request.Policy
+	if policy == nil {
+		policy = &VerificationPolicy{}
+	}
+
+	response := o.verifyPresentation(unquote(request.Presentation), policy)
+
+	command.WriteNillableResponse(rw, response, logger)
+
+	logutil.LogDebug(logger, CommandName, VerifyPresentationCommandMethod, "success")
+
+	return nil
+}
+
+func (o *Command) verifyCredential(vcBytes []byte, policy *VerificationPolicy) *VerifyCredentialResponse {
+	report := newVerificationReport()
+
+	vc, err := verifiable.ParseCredential(vcBytes, o.credentialOpts(verifiable.WithDisabledProofCheck())...)
+	report.add(FormatCheck, err)
+
+	if err != nil {
+		return &VerifyCredentialResponse{Checks: report.checks}
+	}
+
+	proofErr := o.checkCredentialProof(vcBytes, vc, policy)
+
+	if checkEnabled(policy.Proof) {
+		report.add(ProofCheck, proofErr)
+	}
+
+	// the signature is verified along with the proof purpose, so the check is not made if the proof check is disabled
+	if checkEnabled(policy.Proof) && checkEnabled(policy.ProofPurpose) {
+		report.add(ProofPurposeCheck, o.checkCredentialProofPurpose(vcBytes, proofErr))
+	}
+
+	if checkEnabled(policy.Expiry) {
+		report.add(ExpiryCheck, checkExpiry(vc))
+	}
+
+	if checkEnabled(policy.Status) {
+		report.add(StatusCheck, o.checkStatus(vc))
+	}
+
+	if len(policy.TrustedIssuers) > 0 || policy.TrustRegistry {
+		report.add(IssuerTrustCheck, o.checkIssuerTrust(vc, policy))
+	}
+
+	return &VerifyCredentialResponse{Verified: report.passed, Checks: report.checks}
+}
+
+func (o *Command) verifyPresentation(vpBytes []byte, policy *VerificationPolicy) *VerifyPresentationResponse {
+	report := newVerificationReport()
+
+	vp, err := verifiable.ParsePresentation(vpBytes, verifiable.WithPresDisabledProofCheck(),
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader))
+	report.add(FormatCheck, err)
+
+	if err != nil {
+		return &VerifyPresentationResponse{Checks: report.checks}
+	}
+
+	proofErr := o.checkPresentationProof(vpBytes, vp, policy)
+
+	if checkEnabled(policy.Proof) {
+		report.add(ProofCheck, proofErr)
+	}
+
+	if checkEnabled(policy.Proof) && checkEnabled(policy.ProofPurpose) {
+		report.add(ProofPurposeCheck, o.checkPresentationProofPurpose(vpBytes, proofErr))
+	}
+
+	response := &VerifyPresentationResponse{Checks: report.checks}
+
+	credentials, err := vp.MarshalledCredentials()
+	if err != nil {
+		report.add(FormatCheck, err)
+
+		response.Checks = report.checks
+
+		return response
+	}
+
+	verified := report.passed
+
+	for _, vcBytes := range credentials {
+		vcReport := o.verifyCredential(unquote(vcBytes), policy)
+		verified = verified && vcReport.Verified
+
+		response.Credentials = append(response.Credentials, vcReport)
+	}
+
+	response.Verified = verified
+
+	return response
+}
+
+func (o *Command) checkCredentialProof(vcBytes []byte, vc *verifiable.Credential,
+	policy *VerificationPolicy) error {
+	if len(vc.Proofs) == 0 && isJSON(vcBytes) {
+		return errors.New("credential has no proof")
+	}
+
+	if !checkEnabled(policy.Proof) {
+		return nil
+	}
+
+	_, err := verifiable.ParseCredential(vcBytes,
+		o.credentialOpts(verifiable.WithPublicKeyFetcher(o.kResolver.PublicKeyFetcher()))...)
+
+	return err
+}
+
+// checkCredentialProofPurpose checks the purpose of the linked data proof or of the key which signed JWS.
+// As the signature is also checked during this check, it's skipped in case of failed proof check.
+func (o *Command) checkCredentialProofPurpose(vcBytes []byte, proofErr error) error {
+	if proofErr != nil {
+		return errors.New("skipped as proof check has failed")
+	}
+
+	_, err := verifiable.ParseCredential(vcBytes, o.credentialOpts(
+		verifiable.WithPublicKeyFetcher(o.kResolver.PublicKeyFetcher()),
+		verifiable.WithProofPurposeCheck(o.ctx.VDRIRegistry()))...)
+
+	return err
+}
+
+func (o *Command) checkPresentationProof(vpBytes []byte, vp *verifiable.Presentation,
+	policy *VerificationPolicy) error {
+	if len(vp.Proofs) == 0 && isJSON(vpBytes) {
+		return errors.New("presentation has no proof")
+	}
+
+	if !checkEnabled(policy.Proof) {
+		return nil
+	}
+
+	_, err := verifiable.ParsePresentation(vpBytes,
+		verifiable.WithPresPublicKeyFetcher(o.kResolver.PublicKeyFetcher()),
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader),
+		verifiable.WithPresChallenge(policy.Challenge),
+		verifiable.WithPresDomain(policy.Domain))
+
+	return err
+}
+
+// checkPresentationProofPurpose checks the purpose of the linked data proof or of the key which signed JWS.
+// As the signature is also checked during this check, it's skipped in case of failed proof check.
+func (o *Command) checkPresentationProofPurpose(vpBytes []byte, proofErr error) error {
+	if proofErr != nil {
+		return errors.New("skipped as proof check has failed")
+	}
+
+	_, err := verifiable.ParsePresentation(vpBytes,
+		verifiable.WithPresPublicKeyFetcher(o.kResolver.PublicKeyFetcher()),
+		verifiable.WithPresJSONLDDocumentLoader(o.documentLoader),
+		verifiable.WithPresProofPurposeCheck(o.ctx.VDRIRegistry()))
+
+	return err
+}
+
+func (o *Command) checkStatus(vc *verifiable.Credential) error {
+	if vc.Status == nil {
+		return nil
+	}
+
+	return o.statusVerifier.Check(vc)
+}
+
+func checkExpiry(vc *verifiable.Credential) error {
+	now := time.Now()
+
+	if vc.Expired != nil && now.After(vc.Expired.Time) {
+		return fmt.Errorf("credential expired at %s", vc.Expired.Time.Format(time.RFC3339))
+	}
+
+	if vc.Issued != nil && now.Before(vc.Issued.Time) {
+		return fmt.Errorf("credential is not valid before %s", vc.Issued.Time.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func (o *Command) checkIssuerTrust(vc *verifiable.Credential, policy *VerificationPolicy) error {
+	if len(policy.TrustedIssuers) > 0 {
+		if err := checkIssuerTrust(vc, policy.TrustedIssuers); err != nil {
+			return err
+		}
+	}
+
+	if policy.TrustRegistry {
+		return o.trustRegistry.CheckIssuerTrust(vc)
+	}
+
+	return nil
+}
+
+func checkIssuerTrust(vc *verifiable.Credential, trustedIssuers []string) error {
+	for _, issuer := range trustedIssuers {
+		if vc.Issuer.ID == issuer {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("issuer %s is not trusted", vc.Issuer.ID)
+}
+
+// unquote returns the JWT if the raw JSON is a string, otherwise raw JSON is returned as is.
+func unquote(raw []byte) []byte {
+	var jwt string
+
+	if err := json.Unmarshal(raw, &jwt); err == nil {
+		return []byte(jwt)
+	}
+
+	return raw
+}
+
+func isJSON(data []byte) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(data)), "{")
+}
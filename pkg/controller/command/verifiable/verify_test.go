@@ -0,0 +1,414 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util/signature"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	cryptomock "github.com/hyperledger/aries-framework-go/pkg/mock/crypto"
+	kmsmock "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+)
+
+const (
+	verifyTestDID = "did:example:76e12ec712ebc6f1c221ebfeb1f"
+
+	verifyTestVC = `{
+  "@context": [
+    "https://www.w3.org/2018/credentials/v1",
+    "https://www.w3.org/2018/credentials/examples/v1"
+  ],
+  "id": "http://example.edu/credentials/1872",
+  "type": ["VerifiableCredential", "UniversityDegreeCredential"],
+  "issuer": "did:example:76e12ec712ebc6f1c221ebfeb1f",
+  "issuanceDate": "2010-01-01T19:23:24Z",
+  "credentialSubject": {
+    "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+    "degree": {
+      "type": "BachelorDegree",
+      "name": "Bachelor of Science and Arts"
+    }
+  }
+}`
+
+	verifyTestExpiredVC = `{
+  "@context": [
+    "https://www.w3.org/2018/credentials/v1",
+    "https://www.w3.org/2018/credentials/examples/v1"
+  ],
+  "id": "http://example.edu/credentials/1873",
+  "type": ["VerifiableCredential", "UniversityDegreeCredential"],
+  "issuer": "did:example:76e12ec712ebc6f1c221ebfeb1f",
+  "issuanceDate": "2010-01-01T19:23:24Z",
+  "expirationDate": "2011-01-01T19:23:24Z",
+  "credentialSubject": {
+    "id": "did:example:ebfeb1f712ebc6f1c276e12ec21",
+    "degree": {
+      "type": "BachelorDegree",
+      "name": "Bachelor of Science and Arts"
+    }
+  }
+}`
+)
+
+type verifyTestSetup struct {
+	cmd     *Command
+	didDoc  *did.Doc
+	privKey ed25519.PrivateKey
+	pubKey  ed25519.PublicKey
+}
+
+func newVerifyTestSetup(t *testing.T) *verifyTestSetup {
+	t.Helper()
+
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	pk := &did.PublicKey{
+		ID:         verifyTestDID + "#key-1",
+		Type:       "Ed25519VerificationKey2018",
+		Controller: verifyTestDID,
+		Value:      pubKey,
+	}
+
+	didDoc := &did.Doc{
+		ID:        verifyTestDID,
+		PublicKey: []did.PublicKey{*pk},
+		AssertionMethod: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pk, did.AssertionMethod, false),
+		},
+		Authentication: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pk, did.Authentication, false),
+		},
+	}
+
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+		VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{ResolveValue: didDoc},
+		KMSValue:             &kmsmock.KeyManager{},
+		CryptoValue:          &cryptomock.Crypto{},
+	})
+	require.NoError(t, err)
+
+	return &verifyTestSetup{cmd: cmd, didDoc: didDoc, privKey: privKey, pubKey: pubKey}
+}
+
+func (s *verifyTestSetup) ldpContext(purpose, challenge string) *verifiable.LinkedDataProofContext {
+	return &verifiable.LinkedDataProofContext{
+		SignatureType:           Ed25519Signature2018,
+		SignatureRepresentation: verifiable.SignatureJWS,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(signature.GetEd25519Signer(s.privKey, s.pubKey))),
+		VerificationMethod:      verifyTestDID + "#key-1",
+		Purpose:                 purpose,
+		Challenge:               challenge,
+	}
+}
+
+func (s *verifyTestSetup) signedVC(t *testing.T, vcJSON string) *verifiable.Credential {
+	t.Helper()
+
+	vc, err := verifiable.ParseCredential([]byte(vcJSON), verifiable.WithDisabledProofCheck())
+	require.NoError(t, err)
+
+	require.NoError(t, vc.AddLinkedDataProof(s.ldpContext("", "")))
+
+	return vc
+}
+
+func verifyCredential(t *testing.T, cmd *Command, request *VerifyCredentialRequest) *VerifyCredentialResponse {
+	t.Helper()
+
+	reqBytes, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, cmd.VerifyCredential(&b, bytes.NewBuffer(reqBytes)))
+
+	var response VerifyCredentialResponse
+	require.NoError(t, json.NewDecoder(&b).Decode(&response))
+
+	return &response
+}
+
+func verifyPresentation(t *testing.T, cmd *Command, request *VerifyPresentationRequest) *VerifyPresentationResponse {
+	t.Helper()
+
+	reqBytes, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	var b bytes.Buffer
+	require.NoError(t, cmd.VerifyPresentation(&b, bytes.NewBuffer(reqBytes)))
+
+	var response VerifyPresentationResponse
+	require.NoError(t, json.NewDecoder(&b).Decode(&response))
+
+	return &response
+}
+
+func requireCheck(t *testing.T, checks []VerificationCheck, name string, passed bool) {
+	t.Helper()
+
+	for _, c := range checks {
+		if c.Check == name {
+			require.Equal(t, passed, c.Passed, c.Error)
+
+			return
+		}
+	}
+
+	require.Failf(t, "check not found", "check %s is not in the report", name)
+}
+
+func TestCommand_VerifyCredential(t *testing.T) {
+	s := newVerifyTestSetup(t)
+
+	vcBytes, err := json.Marshal(s.signedVC(t, verifyTestVC))
+	require.NoError(t, err)
+
+	t.Run("default policy - success", func(t *testing.T) {
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: vcBytes})
+		require.True(t, response.Verified)
+		require.Len(t, response.Checks, 5)
+
+		for _, check := range []string{FormatCheck, ProofCheck, ProofPurposeCheck, ExpiryCheck, StatusCheck} {
+			requireCheck(t, response.Checks, check, true)
+		}
+	})
+
+	t.Run("trusted issuers", func(t *testing.T) {
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{
+			Credential: vcBytes,
+			Policy:     &VerificationPolicy{TrustedIssuers: []string{verifyTestDID}},
+		})
+		require.True(t, response.Verified)
+		requireCheck(t, response.Checks, IssuerTrustCheck, true)
+
+		response = verifyCredential(t, s.cmd, &VerifyCredentialRequest{
+			Credential: vcBytes,
+			Policy:     &VerificationPolicy{TrustedIssuers: []string{"did:example:other"}},
+		})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, IssuerTrustCheck, false)
+	})
+
+	t.Run("expired credential", func(t *testing.T) {
+		expiredVCBytes, err := json.Marshal(s.signedVC(t, verifyTestExpiredVC))
+		require.NoError(t, err)
+
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: expiredVCBytes})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		requireCheck(t, response.Checks, ExpiryCheck, false)
+	})
+
+	t.Run("credential without proof", func(t *testing.T) {
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: []byte(verifyTestVC)})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, false)
+	})
+
+	t.Run("checks are enabled unless disabled explicitly", func(t *testing.T) {
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{
+			Credential: []byte(verifyTestVC),
+			Policy:     &VerificationPolicy{TrustedIssuers: []string{verifyTestDID}},
+		})
+		require.False(t, response.Verified)
+		require.Len(t, response.Checks, 6)
+		requireCheck(t, response.Checks, ProofCheck, false)
+		requireCheck(t, response.Checks, ProofPurposeCheck, false)
+		requireCheck(t, response.Checks, ExpiryCheck, true)
+		requireCheck(t, response.Checks, StatusCheck, true)
+		requireCheck(t, response.Checks, IssuerTrustCheck, true)
+
+		disabled := false
+
+		response = verifyCredential(t, s.cmd, &VerifyCredentialRequest{
+			Credential: []byte(verifyTestVC),
+			Policy:     &VerificationPolicy{Proof: &disabled, ProofPurpose: &disabled, Status: &disabled},
+		})
+		require.True(t, response.Verified)
+		require.Equal(t, []VerificationCheck{
+			{Check: FormatCheck, Passed: true},
+			{Check: ExpiryCheck, Passed: true},
+		}, response.Checks)
+	})
+
+	t.Run("proof purpose is not checked if proof check is disabled", func(t *testing.T) {
+		tamperedVCBytes := bytes.Replace(vcBytes, []byte("credentials/1872"), []byte("credentials/1873"), 1)
+
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: tamperedVCBytes})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, false)
+		requireCheck(t, response.Checks, ProofPurposeCheck, false)
+
+		disabled := false
+
+		response = verifyCredential(t, s.cmd, &VerifyCredentialRequest{
+			Credential: tamperedVCBytes,
+			Policy:     &VerificationPolicy{Proof: &disabled},
+		})
+		require.True(t, response.Verified)
+		require.Equal(t, []VerificationCheck{
+			{Check: FormatCheck, Passed: true},
+			{Check: ExpiryCheck, Passed: true},
+			{Check: StatusCheck, Passed: true},
+		}, response.Checks)
+	})
+
+	t.Run("JWT credential", func(t *testing.T) {
+		vc, err := verifiable.ParseCredential([]byte(verifyTestVC), verifiable.WithDisabledProofCheck())
+		require.NoError(t, err)
+
+		claims, err := vc.JWTClaims(false)
+		require.NoError(t, err)
+
+		vcJWS, err := claims.MarshalJWS(verifiable.EdDSA, signature.GetEd25519Signer(s.privKey, s.pubKey), "#key-1")
+		require.NoError(t, err)
+
+		jwtBytes, err := json.Marshal(vcJWS)
+		require.NoError(t, err)
+
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: jwtBytes})
+		require.True(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		requireCheck(t, response.Checks, ProofPurposeCheck, true)
+
+		s.didDoc.AssertionMethod = nil
+		defer func() {
+			s.didDoc.AssertionMethod = s.didDoc.Authentication
+		}()
+
+		response = verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: jwtBytes})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		requireCheck(t, response.Checks, ProofPurposeCheck, false)
+	})
+
+	t.Run("proof purpose is not authorized", func(t *testing.T) {
+		s.didDoc.AssertionMethod = nil
+		defer func() {
+			s.didDoc.AssertionMethod = s.didDoc.Authentication
+		}()
+
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: vcBytes})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		requireCheck(t, response.Checks, ProofPurposeCheck, false)
+	})
+
+	t.Run("invalid credential", func(t *testing.T) {
+		response := verifyCredential(t, s.cmd, &VerifyCredentialRequest{Credential: []byte(`{"id":"invalid"}`)})
+		require.False(t, response.Verified)
+		require.Len(t, response.Checks, 1)
+		requireCheck(t, response.Checks, FormatCheck, false)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := s.cmd.VerifyCredential(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = s.cmd.VerifyCredential(&b, bytes.NewBufferString("{}"))
+		require.Error(t, cmdErr)
+		require.Equal(t, VerifyCredentialErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), errEmptyCredential)
+	})
+}
+
+func TestCommand_VerifyPresentation(t *testing.T) {
+	s := newVerifyTestSetup(t)
+
+	vc := s.signedVC(t, verifyTestVC)
+
+	vp, err := vc.Presentation()
+	require.NoError(t, err)
+
+	vp.Holder = verifyTestDID
+
+	require.NoError(t, vp.AddLinkedDataProof(s.ldpContext(verifiable.AuthenticationProofPurpose, "challenge")))
+
+	vpBytes, err := json.Marshal(vp)
+	require.NoError(t, err)
+
+	t.Run("default policy - success", func(t *testing.T) {
+		response := verifyPresentation(t, s.cmd, &VerifyPresentationRequest{Presentation: vpBytes})
+		require.True(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		requireCheck(t, response.Checks, ProofPurposeCheck, true)
+		require.Len(t, response.Credentials, 1)
+		require.True(t, response.Credentials[0].Verified)
+	})
+
+	t.Run("challenge check", func(t *testing.T) {
+		policy := &VerificationPolicy{Challenge: "challenge"}
+
+		response := verifyPresentation(t, s.cmd, &VerifyPresentationRequest{Presentation: vpBytes, Policy: policy})
+		require.True(t, response.Verified)
+
+		policy.Challenge = "other challenge"
+
+		response = verifyPresentation(t, s.cmd, &VerifyPresentationRequest{Presentation: vpBytes, Policy: policy})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, false)
+		requireCheck(t, response.Checks, ProofPurposeCheck, false)
+	})
+
+	t.Run("proof purpose is not checked if proof check is disabled", func(t *testing.T) {
+		disabled := false
+
+		response := verifyPresentation(t, s.cmd, &VerifyPresentationRequest{
+			Presentation: vpBytes,
+			Policy:       &VerificationPolicy{Proof: &disabled, Challenge: "other challenge"},
+		})
+		require.True(t, response.Verified)
+		require.Equal(t, []VerificationCheck{{Check: FormatCheck, Passed: true}}, response.Checks)
+	})
+
+	t.Run("untrusted issuer of the credential", func(t *testing.T) {
+		policy := &VerificationPolicy{TrustedIssuers: []string{"did:example:other"}}
+
+		response := verifyPresentation(t, s.cmd, &VerifyPresentationRequest{Presentation: vpBytes, Policy: policy})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, ProofCheck, true)
+		require.Len(t, response.Credentials, 1)
+		requireCheck(t, response.Credentials[0].Checks, IssuerTrustCheck, false)
+	})
+
+	t.Run("invalid presentation", func(t *testing.T) {
+		response := verifyPresentation(t, s.cmd, &VerifyPresentationRequest{Presentation: []byte(`{"id":"invalid"}`)})
+		require.False(t, response.Verified)
+		requireCheck(t, response.Checks, FormatCheck, false)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		var b bytes.Buffer
+
+		cmdErr := s.cmd.VerifyPresentation(&b, bytes.NewBufferString("--"))
+		require.Error(t, cmdErr)
+		require.Equal(t, InvalidRequestErrorCode, cmdErr.Code())
+
+		cmdErr = s.cmd.VerifyPresentation(&b, bytes.NewBufferString("{}"))
+		require.Error(t, cmdErr)
+		require.Equal(t, VerifyPresentationErrorCode, cmdErr.Code())
+		require.Contains(t, cmdErr.Error(), errEmptyPresentation)
+	})
+}
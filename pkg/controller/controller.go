@@ -7,12 +7,16 @@ SPDX-License-Identifier: Apache-2.0
 package controller
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/controller/command"
 	didexchangecmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/didexchange"
 	introducecmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/introduce"
 	issuecredentialcmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/issuecredential"
+	jsonldcmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/command/kms"
 	routercmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/mediator"
 	messagingcmd "github.com/hyperledger/aries-framework-go/pkg/controller/command/messaging"
@@ -24,6 +28,7 @@ import (
 	didexchangerest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/didexchange"
 	introducerest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/introduce"
 	issuecredentialrest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/issuecredential"
+	jsonldrest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/jsonld"
 	kmsrest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/kms"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/rest/mediator"
 	messagingrest "github.com/hyperledger/aries-framework-go/pkg/controller/rest/messaging"
@@ -41,6 +46,9 @@ type allOpts struct {
 	autoAccept   bool
 	msgHandler   command.MessageHandler
 	notifier     command.Notifier
+	expiryNotice time.Duration
+	closeHandler func(closer io.Closer)
+	trustAnchors []string
 }
 
 const wsPath = "/ws"
@@ -62,6 +70,32 @@ func WithNotifier(notifier command.Notifier) Opt {
 	}
 }
 
+// WithCredentialExpiryNotice is an option for enabling tracking of the expiring credentials and setting how long
+// before the expiration the expiring credential notification is sent. WithCloseHandler option is required
+// to stop the tracking.
+func WithCredentialExpiryNotice(notice time.Duration) Opt {
+	return func(opts *allOpts) {
+		opts.expiryNotice = notice
+	}
+}
+
+// WithCloseHandler is an option for receiving the closers of the controller operations which run background
+// processes (e.g. tracking of the expiring credentials). The closers must be closed once the handlers
+// are no longer used.
+func WithCloseHandler(handler func(closer io.Closer)) Opt {
+	return func(opts *allOpts) {
+		opts.closeHandler = handler
+	}
+}
+
+// WithTrustAnchors is an option for setting the DIDs allowed to issue the trust lists
+// of the issuer trust registry.
+func WithTrustAnchors(dids ...string) Opt {
+	return func(opts *allOpts) {
+		opts.trustAnchors = dids
+	}
+}
+
 // WithDefaultLabel is an option allowing for the defaultLabel to be set.
 func WithDefaultLabel(defaultLabel string) Opt {
 	return func(opts *allOpts) {
@@ -83,6 +117,31 @@ func WithMessageHandler(handler command.MessageHandler) Opt {
 	}
 }
 
+func (o *allOpts) verifiableOpts(ctx *context.Provider, notifier command.Notifier) ([]verifiable.Option, error) {
+	opts := []verifiable.Option{verifiable.WithCredentialSchemaLoader(ctx.CredentialSchemaLoader())}
+
+	if o.expiryNotice > 0 {
+		if o.closeHandler == nil {
+			return nil, errors.New("close handler is required to track expiring credentials")
+		}
+
+		opts = append(opts, verifiable.WithNotifier(notifier), verifiable.WithExpiryNotice(o.expiryNotice))
+	}
+
+	if len(o.trustAnchors) > 0 {
+		opts = append(opts, verifiable.WithTrustAnchors(o.trustAnchors...))
+	}
+
+	return opts, nil
+}
+
+// handleClose passes the closer of the operation which runs background processes to the close handler.
+func (o *allOpts) handleClose(closer io.Closer) {
+	if o.expiryNotice > 0 {
+		o.closeHandler(closer)
+	}
+}
+
 // GetRESTHandlers returns all REST handlers provided by controller.
 func GetRESTHandlers(ctx *context.Provider, opts ...Opt) ([]rest.Handler, error) { // nolint: funlen,gocyclo
 	restAPIOpts := &allOpts{}
@@ -122,11 +181,18 @@ func GetRESTHandlers(ctx *context.Provider, opts ...Opt) ([]rest.Handler, error)
 	}
 
 	// verifiable command operation
-	verifiablecmd, err := verifiablerest.New(ctx)
+	verifiableOpts, err := restAPIOpts.verifiableOpts(ctx, notifier)
+	if err != nil {
+		return nil, fmt.Errorf("create verifiable rest command : %w", err)
+	}
+
+	verifiablecmd, err := verifiablerest.New(ctx, verifiableOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("create verifiable rest command : %w", err)
 	}
 
+	restAPIOpts.handleClose(verifiablecmd)
+
 	// issuecredential REST operation
 	issuecredentialOp, err := issuecredentialrest.New(ctx, notifier)
 	if err != nil {
@@ -154,6 +220,9 @@ func GetRESTHandlers(ctx *context.Provider, opts ...Opt) ([]rest.Handler, error)
 	// kms command operation
 	kmscmd := kmsrest.New(ctx)
 
+	// JSON-LD contexts REST operation
+	jsonldOp := jsonldrest.New(ctx)
+
 	// creat handlers from all operations
 	var allHandlers []rest.Handler
 	allHandlers = append(allHandlers, exchangeOp.GetRESTHandlers()...)
@@ -166,6 +235,7 @@ func GetRESTHandlers(ctx *context.Provider, opts ...Opt) ([]rest.Handler, error)
 	allHandlers = append(allHandlers, introduceOp.GetRESTHandlers()...)
 	allHandlers = append(allHandlers, outofbandOp.GetRESTHandlers()...)
 	allHandlers = append(allHandlers, kmscmd.GetRESTHandlers()...)
+	allHandlers = append(allHandlers, jsonldOp.GetRESTHandlers()...)
 
 	nhp, ok := notifier.(handlerProvider)
 	if ok {
@@ -218,11 +288,18 @@ func GetCommandHandlers(ctx *context.Provider, opts ...Opt) ([]command.Handler,
 	}
 
 	// verifiable command operation
-	verifiablecmd, err := verifiable.New(ctx)
+	verifiableOpts, err := cmdOpts.verifiableOpts(ctx, notifier)
+	if err != nil {
+		return nil, fmt.Errorf("create verifiable command : %w", err)
+	}
+
+	verifiablecmd, err := verifiable.New(ctx, verifiableOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("create verifiable command : %w", err)
 	}
 
+	cmdOpts.handleClose(verifiablecmd)
+
 	// issuecredential command operation
 	issuecredential, err := issuecredentialcmd.New(ctx, notifier)
 	if err != nil {
@@ -250,6 +327,9 @@ func GetCommandHandlers(ctx *context.Provider, opts ...Opt) ([]command.Handler,
 	// kms command operation
 	kmscmd := kms.New(ctx)
 
+	// JSON-LD contexts command operation
+	jsonldcommand := jsonldcmd.New(ctx)
+
 	var allHandlers []command.Handler
 	allHandlers = append(allHandlers, didexcmd.GetHandlers()...)
 	allHandlers = append(allHandlers, vcmd.GetHandlers()...)
@@ -261,6 +341,7 @@ func GetCommandHandlers(ctx *context.Provider, opts ...Opt) ([]command.Handler,
 	allHandlers = append(allHandlers, presentproof.GetHandlers()...)
 	allHandlers = append(allHandlers, introduce.GetHandlers()...)
 	allHandlers = append(allHandlers, outofband.GetHandlers()...)
+	allHandlers = append(allHandlers, jsonldcommand.GetHandlers()...)
 
 	return allHandlers, nil
 }
@@ -7,9 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package controller
 
 import (
+	"io"
 	"io/ioutil"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -122,6 +124,78 @@ func TestGetRESTHandlers_Success(t *testing.T) {
 	})
 }
 
+func TestCredentialExpiryTracking(t *testing.T) {
+	var closers []io.Closer
+
+	closeHandler := WithCloseHandler(func(closer io.Closer) {
+		closers = append(closers, closer)
+	})
+
+	t.Run("tracking is disabled by default", func(t *testing.T) {
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetCommandHandlers(ctx, closeHandler)
+			require.NoError(t, err)
+		})
+
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetRESTHandlers(ctx, closeHandler)
+			require.NoError(t, err)
+		})
+
+		require.Empty(t, closers)
+	})
+
+	t.Run("tracking is stopped by the closers", func(t *testing.T) {
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetCommandHandlers(ctx, WithCredentialExpiryNotice(time.Hour), closeHandler)
+			require.NoError(t, err)
+		})
+
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetRESTHandlers(ctx, WithCredentialExpiryNotice(time.Hour), closeHandler)
+			require.NoError(t, err)
+		})
+
+		require.Len(t, closers, 2)
+
+		for _, closer := range closers {
+			require.NoError(t, closer.Close())
+		}
+	})
+
+	t.Run("close handler is required", func(t *testing.T) {
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetCommandHandlers(ctx, WithCredentialExpiryNotice(time.Hour))
+			require.EqualError(t, err,
+				"create verifiable command : close handler is required to track expiring credentials")
+		})
+
+		withTestContext(t, func(ctx *context.Provider) {
+			_, err := GetRESTHandlers(ctx, WithCredentialExpiryNotice(time.Hour))
+			require.EqualError(t, err,
+				"create verifiable rest command : close handler is required to track expiring credentials")
+		})
+	})
+}
+
+func withTestContext(t *testing.T, run func(ctx *context.Provider)) {
+	t.Helper()
+
+	path, cleanup := generateTempDir(t)
+	defer cleanup()
+
+	framework, err := aries.New(defaults.WithStorePath(path),
+		defaults.WithInboundHTTPAddr(":26508", "", "", ""))
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, framework.Close()) }()
+
+	ctx, err := framework.Context()
+	require.NoError(t, err)
+
+	run(ctx)
+}
+
 func TestWithWebhookNotifierOption(t *testing.T) {
 	controllerOpts := &allOpts{}
 
@@ -0,0 +1,23 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command/jsonld"
+)
+
+// addContextsReq model
+//
+// This is used for adding JSON-LD contexts.
+//
+// swagger:parameters addContextsReq
+type addContextsReq struct { // nolint: unused,deadcode
+	// Params for adding JSON-LD contexts
+	//
+	// in: body
+	jsonld.AddContextsRequest
+}
@@ -0,0 +1,63 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"net/http"
+
+	cmdjsonld "github.com/hyperledger/aries-framework-go/pkg/controller/command/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/internal/cmdutil"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/rest"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+)
+
+// constants for JSON-LD operations
+const (
+	JSONLDOperationID = "/jsonld"
+	AddContextsPath   = JSONLDOperationID + "/context/add"
+)
+
+// provider contains dependencies for the JSON-LD command and is typically created by using aries.Context().
+type provider interface {
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
+}
+
+// Operation contains JSON-LD context operations provided by controller REST API.
+type Operation struct {
+	handlers []rest.Handler
+	command  *cmdjsonld.Command
+}
+
+// New returns new JSON-LD operations rest client instance.
+func New(p provider) *Operation {
+	o := &Operation{command: cmdjsonld.New(p)}
+	o.registerHandler()
+
+	return o
+}
+
+// GetRESTHandlers get all controller API handler available for this service.
+func (o *Operation) GetRESTHandlers() []rest.Handler {
+	return o.handlers
+}
+
+// registerHandler register handlers to be exposed from this protocol service as REST API endpoints.
+func (o *Operation) registerHandler() {
+	o.handlers = []rest.Handler{
+		cmdutil.NewHTTPHandler(AddContextsPath, http.MethodPost, o.AddContexts),
+	}
+}
+
+// AddContexts swagger:route POST /jsonld/context/add jsonld addContextsReq
+//
+// Adds JSON-LD contexts to the agent's document loader.
+//
+// Responses:
+//    default: genericError
+func (o *Operation) AddContexts(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.AddContexts, rw, req.Body)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	cmdjsonld "github.com/hyperledger/aries-framework-go/pkg/controller/command/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/rest"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstorage "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+func TestNew(t *testing.T) {
+	op := New(&mockprovider.Provider{})
+	require.NotNil(t, op)
+	require.Len(t, op.GetRESTHandlers(), 1)
+}
+
+func TestOperation_AddContexts(t *testing.T) {
+	t.Run("add contexts - success", func(t *testing.T) {
+		loader, err := jsonld.NewDocumentLoader(mockstorage.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		op := New(&mockprovider.Provider{JSONLDDocumentLoaderValue: loader})
+
+		request, err := json.Marshal(&cmdjsonld.AddContextsRequest{Documents: []jsonld.ContextDocument{{
+			URL:     "https://example.com/context/v1",
+			Content: json.RawMessage(`{"@context":{"name":"http://schema.org/name"}}`),
+		}}})
+		require.NoError(t, err)
+
+		_, code := sendRequestToHandler(t, lookupHandler(t, op, AddContextsPath), bytes.NewBuffer(request))
+		require.Equal(t, http.StatusOK, code)
+
+		_, err = loader.LoadDocument("https://example.com/context/v1")
+		require.NoError(t, err)
+	})
+
+	t.Run("add contexts - error", func(t *testing.T) {
+		op := New(&mockprovider.Provider{})
+
+		buf, code := sendRequestToHandler(t, lookupHandler(t, op, AddContextsPath), bytes.NewBufferString("--"))
+		require.Equal(t, http.StatusBadRequest, code)
+
+		errResponse := struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}{}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &errResponse))
+		require.EqualValues(t, cmdjsonld.InvalidRequestErrorCode, errResponse.Code)
+	})
+}
+
+func lookupHandler(t *testing.T, op *Operation, path string) rest.Handler {
+	t.Helper()
+
+	for _, h := range op.GetRESTHandlers() {
+		if h.Path() == path {
+			return h
+		}
+	}
+
+	require.Fail(t, "unable to find handler")
+
+	return nil
+}
+
+func sendRequestToHandler(t *testing.T, handler rest.Handler, requestBody io.Reader) (*bytes.Buffer, int) {
+	t.Helper()
+
+	req, err := http.NewRequest(handler.Method(), handler.Path(), requestBody)
+	require.NoError(t, err)
+
+	router := mux.NewRouter()
+	router.HandleFunc(handler.Path(), handler.Handle()).Methods(handler.Method())
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	return rr.Body, rr.Code
+}
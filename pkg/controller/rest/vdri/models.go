@@ -51,6 +51,54 @@ type resolveDIDReq struct { // nolint: unused,deadcode
 	ID string `json:"id"`
 }
 
+// resolveIdentifierReq model
+//
+// This is used to resolve the did with DIF Universal Resolver compatible API.
+//
+// swagger:parameters resolveIdentifierReq
+type resolveIdentifierReq struct { // nolint: unused,deadcode
+	// DID to resolve
+	//
+	// in: path
+	// required: true
+	DID string `json:"did"`
+}
+
+// resolutionRes model
+//
+// This is used for returning the did document or the did resolution result.
+//
+// swagger:response resolutionRes
+type resolutionRes struct { // nolint: unused,deadcode
+
+	// in: body
+	Result json.RawMessage
+}
+
+// updateDIDReq model
+//
+// This is used to update the did document.
+//
+// swagger:parameters updateDIDReq
+type updateDIDReq struct { // nolint: unused,deadcode
+	// Params for updating the did document
+	//
+	// in: body
+	Params vdricommand.UpdateDIDArgs
+}
+
+// deactivateDIDReq model
+//
+// This is used to deactivate the did.
+//
+// swagger:parameters deactivateDIDReq
+type deactivateDIDReq struct { // nolint: unused,deadcode
+	// Params for deactivating the did
+	//
+	// in: body
+	Params vdricommand.IDArg
+}
+
 // documentRes model
 //
 // This is used for returning query connection result for single record search
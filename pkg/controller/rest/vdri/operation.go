@@ -29,6 +29,8 @@ const (
 	GetDIDPath        = vdriDIDPath + "/{id}"
 	ResolveDIDPath    = vdriDIDPath + "/resolve/{id}"
 	GetDIDRecordsPath = vdriDIDPath + "/records"
+	UpdateDIDPath     = vdriDIDPath + "/update"
+	DeactivateDIDPath = vdriDIDPath + "/deactivate"
 )
 
 // provider contains dependencies for the common controller operations
@@ -42,6 +44,7 @@ type provider interface {
 type Operation struct {
 	handlers []rest.Handler
 	command  *vdri.Command
+	resolver *Resolver
 }
 
 // New returns new common operations rest client instance.
@@ -51,7 +54,7 @@ func New(ctx provider) (*Operation, error) {
 		return nil, fmt.Errorf("new vdri : %w", err)
 	}
 
-	o := &Operation{command: cmd}
+	o := &Operation{command: cmd, resolver: NewResolver(ctx.VDRIRegistry())}
 	o.registerHandler()
 
 	return o, nil
@@ -70,7 +73,11 @@ func (o *Operation) registerHandler() {
 		cmdutil.NewHTTPHandler(GetDIDPath, http.MethodGet, o.GetDID),
 		cmdutil.NewHTTPHandler(ResolveDIDPath, http.MethodGet, o.ResolveDID),
 		cmdutil.NewHTTPHandler(GetDIDRecordsPath, http.MethodGet, o.GetDIDRecords),
+		cmdutil.NewHTTPHandler(UpdateDIDPath, http.MethodPost, o.UpdateDID),
+		cmdutil.NewHTTPHandler(DeactivateDIDPath, http.MethodPost, o.DeactivateDID),
 	}
+
+	o.handlers = append(o.handlers, o.resolver.GetRESTHandlers()...)
 }
 
 // SaveDID swagger:route POST /vdri/did vdri saveDIDReq
@@ -135,3 +142,24 @@ func (o *Operation) ResolveDID(rw http.ResponseWriter, req *http.Request) {
 func (o *Operation) GetDIDRecords(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.GetDIDRecords, rw, req.Body)
 }
+
+// UpdateDID swagger:route POST /vdri/did/update vdri updateDIDReq
+//
+// Updates did document by applying the given patches.
+//
+// Responses:
+//    default: genericError
+//        200: documentRes
+func (o *Operation) UpdateDID(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.UpdateDID, rw, req.Body)
+}
+
+// DeactivateDID swagger:route POST /vdri/did/deactivate vdri deactivateDIDReq
+//
+// Deactivates did.
+//
+// Responses:
+//    default: genericError
+func (o *Operation) DeactivateDID(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.DeactivateDID, rw, req.Body)
+}
@@ -58,7 +58,7 @@ func TestNew(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.NotNil(t, cmd)
-		require.Equal(t, 4, len(cmd.GetRESTHandlers()))
+		require.Equal(t, 7, len(cmd.GetRESTHandlers()))
 	})
 
 	t.Run("test new command - error", func(t *testing.T) {
@@ -246,6 +246,90 @@ func TestGetDIDRecords(t *testing.T) {
 	})
 }
 
+func TestUpdateDID(t *testing.T) {
+	t.Run("test update did - success", func(t *testing.T) {
+		didDoc, err := did.ParseDocument([]byte(doc))
+		require.NoError(t, err)
+
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{ResolveValue: didDoc},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd)
+
+		var jsonStr = []byte(`{"id": "did:peer:21tDAKCERh95uGgKbJNHYp", "patches": [
+			{"action": "add-services", "document": {"service": [{
+				"id": "#did-communication",
+				"type": "did-communication",
+				"serviceEndpoint": "https://agent.example.com/"
+			}]}}
+		]}`)
+
+		handler := lookupHandler(t, cmd, UpdateDIDPath, http.MethodPost)
+		buf, err := getSuccessResponseFromHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+		require.NoError(t, err)
+
+		var response documentRes
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &response))
+
+		updated, err := did.ParseDocument(response.DID)
+		require.NoError(t, err)
+		require.Len(t, updated.Service, 1)
+	})
+
+	t.Run("test update did - error", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd)
+
+		handler := lookupHandler(t, cmd, UpdateDIDPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"id": "did:peer:123"}`),
+			handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, vdri.InvalidRequestErrorCode, "at least one patch is required", buf.Bytes())
+	})
+}
+
+func TestDeactivateDID(t *testing.T) {
+	t.Run("test deactivate did - success", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd)
+
+		handler := lookupHandler(t, cmd, DeactivateDIDPath, http.MethodPost)
+		_, err = getSuccessResponseFromHandler(handler, bytes.NewBufferString(`{"id": "did:peer:123"}`),
+			handler.Path())
+		require.NoError(t, err)
+	})
+
+	t.Run("test deactivate did - error", func(t *testing.T) {
+		cmd, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{DeactivateErr: fmt.Errorf("deactivate error")},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, cmd)
+
+		handler := lookupHandler(t, cmd, DeactivateDIDPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"id": "did:peer:123"}`),
+			handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusInternalServerError, code)
+		verifyError(t, vdri.DeactivateDIDErrorCode, "deactivate error", buf.Bytes())
+	})
+}
+
 func lookupHandler(t *testing.T, op *Operation, path, method string) rest.Handler {
 	handlers := op.GetRESTHandlers()
 	require.NotEmpty(t, handlers)
@@ -0,0 +1,218 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/command/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/internal/cmdutil"
+	"github.com/hyperledger/aries-framework-go/pkg/controller/rest"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+var logger = log.New("aries-framework/rest/vdri")
+
+// escapedDIDRegexp matches the DID in the escaped request path, the delimiters of the DID scheme and
+// the method name may be percent-encoded.
+var escapedDIDRegexp = regexp.MustCompile(`^did(?::|%3[aA])([a-z0-9]+)(?::|%3[aA])(.+)$`)
+
+const (
+	// ResolverPath is the path of DIF Universal Resolver compatible DID resolution endpoint.
+	ResolverPath = "/1.0/identifiers/{did}"
+
+	identifiersPath = "/1.0/identifiers/"
+
+	didResolutionProfile = "https://w3id.org/did-resolution"
+)
+
+// Resolver exposes DID resolution of the vdri registry as DIF Universal Resolver compatible REST API
+// (https://github.com/decentralized-identity/universal-resolver).
+type Resolver struct {
+	registry vdriapi.Registry
+	handlers []rest.Handler
+}
+
+// NewResolver returns new DID resolver rest instance.
+func NewResolver(registry vdriapi.Registry) *Resolver {
+	r := &Resolver{registry: registry}
+	r.handlers = []rest.Handler{
+		cmdutil.NewHTTPHandler(ResolverPath, http.MethodGet, r.ResolveIdentifier),
+	}
+
+	return r
+}
+
+// GetRESTHandlers get all controller API handler available for this service.
+func (r *Resolver) GetRESTHandlers() []rest.Handler {
+	return r.handlers
+}
+
+// ResolveIdentifier swagger:route GET /1.0/identifiers/{did} vdri resolveIdentifierReq
+//
+// Resolves did. Depending on the Accept header, the JSON-LD (application/did+ld+json) or plain JSON
+// (application/did+json) did document or the did resolution result
+// (application/ld+json;profile="https://w3id.org/did-resolution") is returned.
+// The did resolution result is returned on errors.
+//
+// Responses:
+//    default: resolutionRes
+//        200: resolutionRes
+func (r *Resolver) ResolveIdentifier(rw http.ResponseWriter, req *http.Request) {
+	didID := didFromPath(req)
+
+	accept := req.Header.Get("Accept")
+
+	contentType, ok := negotiateContentType(accept)
+	if !ok {
+		err := fmt.Errorf("representation not supported: %s", accept)
+
+		writeResolution(rw, http.StatusNotAcceptable,
+			did.NewResolutionError(did.ResolutionErrorRepresentationNotSupported, err))
+
+		return
+	}
+
+	resolution, err := r.registry.ResolveWithMetadata(didID)
+	if err != nil {
+		if resolution == nil || resolution.ResolutionMetadata == nil || resolution.ResolutionMetadata.Error == "" {
+			resolution = did.NewResolutionError(did.ResolutionErrorInternal, err)
+		}
+
+		writeResolution(rw, errorStatus(resolution.ResolutionMetadata.Error), resolution)
+
+		return
+	}
+
+	if resolution.DocumentMetadata != nil && resolution.DocumentMetadata.Deactivated {
+		writeResolution(rw, http.StatusGone, resolution)
+
+		return
+	}
+
+	var docBytes []byte
+
+	switch contentType {
+	case did.ContentTypeDIDResolution:
+		writeResolution(rw, http.StatusOK, resolution)
+
+		return
+	case did.ContentTypeDIDJSON:
+		docBytes, err = resolution.DIDDocument.PlainJSONBytes()
+	default:
+		docBytes, err = resolution.DIDDocument.JSONBytes()
+	}
+
+	if err != nil {
+		writeResolution(rw, http.StatusInternalServerError, did.NewResolutionError(did.ResolutionErrorInternal,
+			fmt.Errorf("marshal did document: %w", err)))
+
+		return
+	}
+
+	writeResponse(rw, http.StatusOK, contentType, docBytes)
+}
+
+// didFromPath returns the DID of the escaped request path. The delimiters of the DID scheme and the method name
+// are decoded (e.g. did%3Aexample%3A123), but the percent-encoded characters of the method-specific ID
+// (e.g. port delimiter %3A of did:web) are not decoded as the router does for path variables.
+func didFromPath(req *http.Request) string {
+	path := req.URL.EscapedPath()
+
+	i := strings.Index(path, identifiersPath)
+	if i < 0 {
+		return mux.Vars(req)["did"]
+	}
+
+	escaped := path[i+len(identifiersPath):]
+
+	if m := escapedDIDRegexp.FindStringSubmatch(escaped); m != nil {
+		return "did:" + m[1] + ":" + m[2]
+	}
+
+	// not a DID, it is rejected by the registry
+	unescaped, err := url.PathUnescape(escaped)
+	if err != nil {
+		return escaped
+	}
+
+	return unescaped
+}
+
+// negotiateContentType returns content type of the first supported media range of the Accept header.
+// The JSON-LD did document is returned by default.
+func negotiateContentType(accept string) (string, bool) {
+	if strings.TrimSpace(accept) == "" {
+		return did.ContentTypeDIDLDJSON, true
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(mediaRange)
+		if err != nil {
+			continue
+		}
+
+		switch mediaType {
+		case "application/ld+json":
+			if params["profile"] == didResolutionProfile {
+				return did.ContentTypeDIDResolution, true
+			}
+
+			return did.ContentTypeDIDLDJSON, true
+		case did.ContentTypeDIDLDJSON, "application/json", "application/*", "*/*":
+			return did.ContentTypeDIDLDJSON, true
+		case did.ContentTypeDIDJSON:
+			return did.ContentTypeDIDJSON, true
+		}
+	}
+
+	return "", false
+}
+
+// errorStatus returns HTTP status of the did resolution error code.
+func errorStatus(code string) int {
+	switch code {
+	case did.ResolutionErrorInvalidDID:
+		return http.StatusBadRequest
+	case did.ResolutionErrorNotFound:
+		return http.StatusNotFound
+	case did.ResolutionErrorMethodNotSupported:
+		return http.StatusNotImplemented
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeResolution(rw http.ResponseWriter, status int, resolution *did.DocResolution) {
+	resBytes, err := resolution.JSONBytes()
+	if err != nil {
+		rest.SendHTTPStatusError(rw, http.StatusInternalServerError, vdri.ResolveDIDErrorCode,
+			fmt.Errorf("marshal did resolution result: %w", err))
+
+		return
+	}
+
+	writeResponse(rw, status, did.ContentTypeDIDResolution, resBytes)
+}
+
+func writeResponse(rw http.ResponseWriter, status int, contentType string, body []byte) {
+	rw.Header().Set("Content-Type", contentType)
+	rw.WriteHeader(status)
+
+	if _, err := rw.Write(body); err != nil {
+		logger.Errorf("Unable to send did resolution response, %s", err)
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+)
+
+const resolverDID = "did:peer:21tDAKCERh95uGgKbJNHYp"
+
+func TestResolver_ResolveIdentifier(t *testing.T) {
+	didDoc, err := did.ParseDocument([]byte(doc))
+	require.NoError(t, err)
+
+	resolver := NewResolver(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc})
+	require.Len(t, resolver.GetRESTHandlers(), 1)
+
+	t.Run("test resolve did document", func(t *testing.T) {
+		for _, accept := range []string{"", "application/did+ld+json", "application/json", "*/*", "application/ld+json",
+			"text/html, application/did+ld+json;q=0.9"} {
+			rr := resolve(t, resolver, resolverDID, accept)
+			require.Equal(t, http.StatusOK, rr.Code, accept)
+			require.Equal(t, did.ContentTypeDIDLDJSON, rr.Header().Get("Content-Type"), accept)
+
+			resolved, err := did.ParseDocument(rr.Body.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, didDoc, resolved)
+		}
+	})
+
+	t.Run("test resolve plain JSON did document", func(t *testing.T) {
+		rr := resolve(t, resolver, resolverDID, did.ContentTypeDIDJSON)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, did.ContentTypeDIDJSON, rr.Header().Get("Content-Type"))
+
+		raw := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &raw))
+		require.NotContains(t, raw, "@context")
+		require.Contains(t, raw, "verificationMethod")
+	})
+
+	t.Run("test resolve did resolution result", func(t *testing.T) {
+		rr := resolve(t, resolver, resolverDID, did.ContentTypeDIDResolution)
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, did.ContentTypeDIDResolution, rr.Header().Get("Content-Type"))
+
+		resolution, err := did.ParseDocumentResolution(rr.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, didDoc.ID, resolution.DIDDocument.ID)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+	})
+
+	t.Run("test representation not supported", func(t *testing.T) {
+		rr := resolve(t, resolver, resolverDID, "text/html")
+		require.Equal(t, http.StatusNotAcceptable, rr.Code)
+
+		resolution, err := did.ParseDocumentResolution(rr.Body.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, did.ResolutionErrorRepresentationNotSupported, resolution.ResolutionMetadata.Error)
+	})
+
+	t.Run("test resolution errors", func(t *testing.T) {
+		tests := map[string]int{
+			did.ResolutionErrorInvalidDID:         http.StatusBadRequest,
+			did.ResolutionErrorNotFound:           http.StatusNotFound,
+			did.ResolutionErrorMethodNotSupported: http.StatusNotImplemented,
+			did.ResolutionErrorInternal:           http.StatusInternalServerError,
+		}
+
+		for code, status := range tests {
+			r := NewResolver(&mockvdri.MockVDRIRegistry{
+				ResolveWithMetadataFunc: func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+					return did.NewResolutionError(code, errors.New("resolve error")), errors.New("resolve error")
+				},
+			})
+
+			rr := resolve(t, r, resolverDID, "")
+			require.Equal(t, status, rr.Code, code)
+			require.Equal(t, did.ContentTypeDIDResolution, rr.Header().Get("Content-Type"))
+
+			resolution, err := did.ParseDocumentResolution(rr.Body.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, code, resolution.ResolutionMetadata.Error)
+			require.Equal(t, "resolve error", resolution.ResolutionMetadata.Message)
+		}
+
+		// no resolution result
+		r := NewResolver(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				return nil, errors.New("resolve error")
+			},
+		})
+
+		rr := resolve(t, r, resolverDID, "")
+		require.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+
+	t.Run("test deactivated did", func(t *testing.T) {
+		r := NewResolver(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				resolution := did.NewDocResolution(didDoc)
+				resolution.DocumentMetadata.Deactivated = true
+
+				return resolution, nil
+			},
+		})
+
+		rr := resolve(t, r, resolverDID, "")
+		require.Equal(t, http.StatusGone, rr.Code)
+
+		resolution, err := did.ParseDocumentResolution(rr.Body.Bytes())
+		require.NoError(t, err)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+	})
+
+	t.Run("test percent-encoded did is not decoded", func(t *testing.T) {
+		const webDID = "did:web:example.com%3A8443"
+
+		var resolved string
+
+		resolver := NewResolver(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(didID string, _ ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				resolved = didID
+
+				return &did.DocResolution{DIDDocument: didDoc}, nil
+			},
+		})
+
+		rr := resolve(t, resolver, webDID, "")
+		require.Equal(t, http.StatusOK, rr.Code)
+		require.Equal(t, webDID, resolved)
+	})
+
+	t.Run("test percent-encoded delimiters of did are decoded", func(t *testing.T) {
+		var resolved string
+
+		resolver := NewResolver(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(didID string, _ ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				resolved = didID
+
+				return &did.DocResolution{DIDDocument: didDoc}, nil
+			},
+		})
+
+		for encoded, expected := range map[string]string{
+			"did%3Aexample%3A123":             "did:example:123",
+			"did%3aexample:123":               "did:example:123",
+			"did%3Aweb%3Aexample.com%3A8443":  "did:web:example.com%3A8443",
+			"did%3Aweb%3Aexample.com:user:42": "did:web:example.com:user:42",
+			"not%20a%20did":                   "not a did",
+		} {
+			rr := resolve(t, resolver, encoded, "")
+			require.Equal(t, http.StatusOK, rr.Code, encoded)
+			require.Equal(t, expected, resolved, encoded)
+		}
+	})
+
+	t.Run("test operation exposes resolver", func(t *testing.T) {
+		op, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+			VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{ResolveValue: didDoc},
+		})
+		require.NoError(t, err)
+
+		handler := lookupHandler(t, op, ResolverPath, http.MethodGet)
+
+		buf, err := getSuccessResponseFromHandler(handler, nil, fmt.Sprintf("/1.0/identifiers/%s", resolverDID))
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), resolverDID)
+
+		buf, err = getSuccessResponseFromHandler(handler, nil,
+			fmt.Sprintf("/1.0/identifiers/%s", strings.ReplaceAll(resolverDID, ":", "%3A")))
+		require.NoError(t, err)
+		require.Contains(t, buf.String(), resolverDID)
+	})
+}
+
+func resolve(t *testing.T, resolver *Resolver, didID, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, "/1.0/identifiers/"+didID, nil)
+	require.NoError(t, err)
+
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	router := mux.NewRouter()
+
+	for _, h := range resolver.GetRESTHandlers() {
+		router.HandleFunc(h.Path(), h.Handle()).Methods(h.Method())
+	}
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, req)
+
+	return rr
+}
@@ -77,7 +77,7 @@ type getCredentialReq struct { // nolint: unused,deadcode
 
 // credentialRes model
 //
-// This is used for returning query connection result for single record search
+// # This is used for returning query connection result for single record search
 //
 // swagger:response credentialRes
 type credentialRes struct { // nolint: unused,deadcode
@@ -181,7 +181,7 @@ type generatePresentationReq struct { // nolint: unused,deadcode
 
 // presentationRes model
 //
-// This is used for returning the verifiable presentation
+// # This is used for returning the verifiable presentation
 //
 // swagger:response presentationRes
 type presentationRes struct {
@@ -190,6 +190,19 @@ type presentationRes struct {
 	VerifiablePresentation json.RawMessage `json:"verifiablePresentation,omitempty"`
 }
 
+// generatePresentationByDefinitionReq model
+//
+// This is used to generate the verifiable presentation satisfying the presentation definition.
+//
+// swagger:parameters generatePresentationByDefinitionReq
+type generatePresentationByDefinitionReq struct { // nolint: unused,deadcode
+	// Params for generating the verifiable presentation (stored credentials to be submitted may be selected
+	// by input descriptor ID)
+	//
+	// in: body
+	Params verifiable.PresentationByDefinitionRequest
+}
+
 // signCredentialReq model
 //
 // This is used to sign a credential.
@@ -204,7 +217,7 @@ type signCredentialReq struct { // nolint: unused,deadcode
 
 // signCredentialRes model
 //
-// This is used for returning the sign credential response
+// # This is used for returning the sign credential response
 //
 // swagger:response signCredentialRes
 type signCredentialRes struct {
@@ -212,3 +225,180 @@ type signCredentialRes struct {
 	// in: body
 	VerifiableCredential json.RawMessage `json:"verifiableCredential,omitempty"`
 }
+
+// verifyCredentialReq model
+//
+// This is used to verify the verifiable credential.
+//
+// swagger:parameters verifyCredentialReq
+type verifyCredentialReq struct { // nolint: unused,deadcode
+	// Params for verifying the verifiable credential
+	//
+	// in: body
+	Params verifiable.VerifyCredentialRequest
+}
+
+// verifyCredentialRes model
+//
+// This is used for returning the report of the credential verification.
+//
+// swagger:response verifyCredentialRes
+type verifyCredentialRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.VerifyCredentialResponse
+}
+
+// verifyPresentationReq model
+//
+// This is used to verify the verifiable presentation.
+//
+// swagger:parameters verifyPresentationReq
+type verifyPresentationReq struct { // nolint: unused,deadcode
+	// Params for verifying the verifiable presentation
+	//
+	// in: body
+	Params verifiable.VerifyPresentationRequest
+}
+
+// verifyPresentationRes model
+//
+// This is used for returning the report of the presentation verification.
+//
+// swagger:response verifyPresentationRes
+type verifyPresentationRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.VerifyPresentationResponse
+}
+
+// queryCredentialsReq model
+//
+// This is used to query the verifiable credential records.
+//
+// swagger:parameters queryCredentialsReq
+type queryCredentialsReq struct { // nolint: unused,deadcode
+	// Params for querying the verifiable credential records
+	//
+	// in: body
+	Params verifiable.QueryCredentialsRequest
+}
+
+// queryCredentialsRes model
+//
+// This is used for returning a page of the credential records matching the query.
+//
+// swagger:response queryCredentialsRes
+type queryCredentialsRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.QueryCredentialsResponse
+}
+
+// expiringCredentialsReq model
+//
+// This is used to get the expiring verifiable credential records.
+//
+// swagger:parameters expiringCredentialsReq
+type expiringCredentialsReq struct { // nolint: unused,deadcode
+	// Params for getting the expiring credential records
+	//
+	// in: body
+	Params verifiable.ExpiringCredentialsRequest
+}
+
+// expiringCredentialsRes model
+//
+// This is used for returning the expiring credential records.
+//
+// swagger:response expiringCredentialsRes
+type expiringCredentialsRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.ExpiringCredentialsResponse
+}
+
+// refreshCredentialReq model
+//
+// This is used to refresh the stored verifiable credential.
+//
+// swagger:parameters refreshCredentialReq
+type refreshCredentialReq struct { // nolint: unused,deadcode
+	// Params for refreshing the credential
+	//
+	// in: body
+	Params verifiable.RefreshCredentialRequest
+}
+
+// refreshCredentialRes model
+//
+// This is used for returning the refreshed credential.
+//
+// swagger:response refreshCredentialRes
+type refreshCredentialRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.RefreshCredentialResponse
+}
+
+// issueRefreshedCredentialReq model
+//
+// This is used to request the refreshed credential from the refresh service.
+//
+// swagger:parameters issueRefreshedCredentialReq
+type issueRefreshedCredentialReq struct { // nolint: unused,deadcode
+	// Verifiable presentation of the credential signed by the credential subject with the challenge
+	// of the presentation request. The presentation request is returned if the body is empty.
+	//
+	// in: body
+	Params json.RawMessage
+}
+
+// issueRefreshedCredentialRes model
+//
+// This is used for returning the refreshed credential or the presentation request.
+//
+// swagger:response issueRefreshedCredentialRes
+type issueRefreshedCredentialRes struct { // nolint: unused,deadcode
+	// in: body
+	Response json.RawMessage
+}
+
+// addTrustListReq model
+//
+// This is used to add the trust list to the issuer trust registry.
+//
+// swagger:parameters addTrustListReq
+type addTrustListReq struct { // nolint: unused,deadcode
+	// Params for adding the trust list
+	//
+	// in: body
+	Params verifiable.AddTrustListRequest
+}
+
+// addTrustListRes model
+//
+// This is used for returning the added trust list.
+//
+// swagger:response addTrustListRes
+type addTrustListRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.AddTrustListResponse
+}
+
+// removeTrustListReq model
+//
+// This is used to remove the trust list from the issuer trust registry.
+//
+// swagger:parameters removeTrustListReq
+type removeTrustListReq struct { // nolint: unused,deadcode
+	// Params for removing the trust list
+	//
+	// in: body
+	Params verifiable.RemoveTrustListRequest
+}
+
+// getTrustListsRes model
+//
+// This is used for returning the trust lists of the issuer trust registry.
+//
+// swagger:response getTrustListsRes
+type getTrustListsRes struct { // nolint: unused,deadcode
+	// in: body
+	Response verifiable.GetTrustListsResponse
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/controller/internal/cmdutil"
 	"github.com/hyperledger/aries-framework-go/pkg/controller/rest"
 	ariescrypto "github.com/hyperledger/aries-framework-go/pkg/crypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
 	"github.com/hyperledger/aries-framework-go/pkg/storage"
@@ -36,16 +37,31 @@ const (
 	GetCredentialPath          = verifiableCredentialPath + "/{id}"
 	GetCredentialByNamePath    = verifiableCredentialPath + "/name" + "/{name}"
 	GetCredentialsPath         = VerifiableOperationID + "/credentials"
+	QueryCredentialsPath       = GetCredentialsPath + "/query"
+	ExpiringCredentialsPath    = GetCredentialsPath + "/expiring"
 	SignCredentialsPath        = VerifiableOperationID + "/signcredential"
 	RemoveCredentialByNamePath = verifiableCredentialPath + "/remove/name" + "/{name}"
+	VerifyCredentialPath       = verifiableCredentialPath + "/verify"
+	RefreshCredentialPath      = verifiableCredentialPath + "/refresh"
+
+	// refresh service path
+	RefreshServicePath = VerifiableOperationID + "/refresh"
+
+	// issuer trust registry paths
+	verifiableTrustListPath = VerifiableOperationID + "/trustlist"
+	AddTrustListPath        = verifiableTrustListPath
+	RemoveTrustListPath     = verifiableTrustListPath + "/remove"
+	GetTrustListsPath       = VerifiableOperationID + "/trustlists"
 
 	// presentation paths
-	GeneratePresentationPath     = verifiablePresentationPath + "/generate"
-	GeneratePresentationByIDPath = verifiablePresentationPath + "/generatebyid"
-	SavePresentationPath         = verifiablePresentationPath
-	GetPresentationPath          = verifiablePresentationPath + "/{id}"
-	GetPresentationsPath         = VerifiableOperationID + "/presentations"
-	RemovePresentationByNamePath = verifiablePresentationPath + "/remove/name" + "/{name}"
+	GeneratePresentationPath             = verifiablePresentationPath + "/generate"
+	GeneratePresentationByIDPath         = verifiablePresentationPath + "/generatebyid"
+	GeneratePresentationByDefinitionPath = verifiablePresentationPath + "/generatebydefinition"
+	SavePresentationPath                 = verifiablePresentationPath
+	GetPresentationPath                  = verifiablePresentationPath + "/{id}"
+	GetPresentationsPath                 = VerifiableOperationID + "/presentations"
+	RemovePresentationByNamePath         = verifiablePresentationPath + "/remove/name" + "/{name}"
+	VerifyPresentationPath               = verifiablePresentationPath + "/verify"
 )
 
 // provider contains dependencies for the verifiable command and is typically created by using aries.Context().
@@ -54,6 +70,7 @@ type provider interface {
 	VDRIRegistry() vdri.Registry
 	KMS() kms.KeyManager
 	Crypto() ariescrypto.Crypto
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
 }
 
 // Operation contains basic common operations provided by controller REST API.
@@ -63,8 +80,8 @@ type Operation struct {
 }
 
 // New returns new common operations rest client instance.
-func New(p provider) (*Operation, error) {
-	cmd, err := verifiable.New(p)
+func New(p provider, opts ...verifiable.Option) (*Operation, error) {
+	cmd, err := verifiable.New(p, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("verfiable new: %w", err)
 	}
@@ -75,6 +92,11 @@ func New(p provider) (*Operation, error) {
 	return o, nil
 }
 
+// Close stops tracking of the expiring credentials enabled by WithNotifier option.
+func (o *Operation) Close() error {
+	return o.command.Close()
+}
+
 // GetRESTHandlers get all controller API handler available for this service.
 func (o *Operation) GetRESTHandlers() []rest.Handler {
 	return o.handlers
@@ -91,11 +113,22 @@ func (o *Operation) registerHandler() {
 		cmdutil.NewHTTPHandler(SignCredentialsPath, http.MethodPost, o.SignCredential),
 		cmdutil.NewHTTPHandler(GeneratePresentationPath, http.MethodPost, o.GeneratePresentation),
 		cmdutil.NewHTTPHandler(GeneratePresentationByIDPath, http.MethodPost, o.GeneratePresentationByID),
+		cmdutil.NewHTTPHandler(GeneratePresentationByDefinitionPath, http.MethodPost,
+			o.GeneratePresentationByDefinition),
 		cmdutil.NewHTTPHandler(SavePresentationPath, http.MethodPost, o.SavePresentation),
 		cmdutil.NewHTTPHandler(GetPresentationPath, http.MethodGet, o.GetPresentation),
 		cmdutil.NewHTTPHandler(GetPresentationsPath, http.MethodGet, o.GetPresentations),
 		cmdutil.NewHTTPHandler(RemoveCredentialByNamePath, http.MethodPost, o.RemoveCredentialByName),
 		cmdutil.NewHTTPHandler(RemovePresentationByNamePath, http.MethodPost, o.RemovePresentationByName),
+		cmdutil.NewHTTPHandler(VerifyCredentialPath, http.MethodPost, o.VerifyCredential),
+		cmdutil.NewHTTPHandler(VerifyPresentationPath, http.MethodPost, o.VerifyPresentation),
+		cmdutil.NewHTTPHandler(QueryCredentialsPath, http.MethodPost, o.QueryCredentials),
+		cmdutil.NewHTTPHandler(ExpiringCredentialsPath, http.MethodPost, o.GetExpiringCredentials),
+		cmdutil.NewHTTPHandler(RefreshCredentialPath, http.MethodPost, o.RefreshCredential),
+		cmdutil.NewHTTPHandler(RefreshServicePath, http.MethodPost, o.IssueRefreshedCredential),
+		cmdutil.NewHTTPHandler(AddTrustListPath, http.MethodPost, o.AddTrustList),
+		cmdutil.NewHTTPHandler(RemoveTrustListPath, http.MethodPost, o.RemoveTrustList),
+		cmdutil.NewHTTPHandler(GetTrustListsPath, http.MethodGet, o.GetTrustLists),
 	}
 }
 
@@ -104,19 +137,46 @@ func (o *Operation) registerHandler() {
 // Validates the verifiable credential.
 //
 // Responses:
-//    default: genericError
-//        200: emptyRes
+//
+//	default: genericError
+//	    200: emptyRes
 func (o *Operation) ValidateCredential(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.ValidateCredential, rw, req.Body)
 }
 
+// VerifyCredential swagger:route POST /verifiable/credential/verify verifiable verifyCredentialReq
+//
+// Verifies the verifiable credential according to the policy and returns a report of the checks made.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: verifyCredentialRes
+func (o *Operation) VerifyCredential(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.VerifyCredential, rw, req.Body)
+}
+
+// VerifyPresentation swagger:route POST /verifiable/presentation/verify verifiable verifyPresentationReq
+//
+// Verifies the verifiable presentation and its credentials according to the policy and returns
+// a report of the checks made.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: verifyPresentationRes
+func (o *Operation) VerifyPresentation(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.VerifyPresentation, rw, req.Body)
+}
+
 // SaveCredential swagger:route POST /verifiable/credential verifiable saveCredentialReq
 //
 // Saves the verifiable credential.
 //
 // Responses:
-//    default: genericError
-//        200: emptyRes
+//
+//	default: genericError
+//	    200: emptyRes
 func (o *Operation) SaveCredential(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.SaveCredential, rw, req.Body)
 }
@@ -126,8 +186,9 @@ func (o *Operation) SaveCredential(rw http.ResponseWriter, req *http.Request) {
 // Saves the verifiable presentation.
 //
 // Responses:
-//    default: genericError
-//        200: emptyRes
+//
+//	default: genericError
+//	    200: emptyRes
 func (o *Operation) SavePresentation(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.SavePresentation, rw, req.Body)
 }
@@ -137,8 +198,9 @@ func (o *Operation) SavePresentation(rw http.ResponseWriter, req *http.Request)
 // Retrieves the verifiable credential.
 //
 // Responses:
-//    default: genericError
-//        200: credentialRes
+//
+//	default: genericError
+//	    200: credentialRes
 func (o *Operation) GetCredential(rw http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 
@@ -158,8 +220,9 @@ func (o *Operation) GetCredential(rw http.ResponseWriter, req *http.Request) {
 // Retrieves the verifiable presentation.
 //
 // Responses:
-//    default: genericError
-//        200: presentationRes
+//
+//	default: genericError
+//	    200: presentationRes
 func (o *Operation) GetPresentation(rw http.ResponseWriter, req *http.Request) {
 	id := mux.Vars(req)["id"]
 
@@ -179,8 +242,9 @@ func (o *Operation) GetPresentation(rw http.ResponseWriter, req *http.Request) {
 // Retrieves the verifiable credential by name.
 //
 // Responses:
-//    default: genericError
-//        200: credentialRecord
+//
+//	default: genericError
+//	    200: credentialRecord
 func (o *Operation) GetCredentialByName(rw http.ResponseWriter, req *http.Request) {
 	name := mux.Vars(req)["name"]
 
@@ -194,19 +258,109 @@ func (o *Operation) GetCredentialByName(rw http.ResponseWriter, req *http.Reques
 // Retrieves the verifiable credentials.
 //
 // Responses:
-//    default: genericError
-//        200: credentialRecordResult
+//
+//	default: genericError
+//	    200: credentialRecordResult
 func (o *Operation) GetCredentials(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.GetCredentials, rw, req.Body)
 }
 
+// QueryCredentials swagger:route POST /verifiable/credentials/query verifiable queryCredentialsReq
+//
+// Retrieves a page of the verifiable credential records matching the query
+// (type, issuer, subject, context, issuance/expiration range and name text).
+//
+// Responses:
+//
+//	default: genericError
+//	    200: queryCredentialsRes
+func (o *Operation) QueryCredentials(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.QueryCredentials, rw, req.Body)
+}
+
+// GetExpiringCredentials swagger:route POST /verifiable/credentials/expiring verifiable expiringCredentialsReq
+//
+// Retrieves the verifiable credential records expiring within the given number of days
+// sorted by expiration date.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: expiringCredentialsRes
+func (o *Operation) GetExpiringCredentials(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.GetExpiringCredentials, rw, req.Body)
+}
+
+// RefreshCredential swagger:route POST /verifiable/credential/refresh verifiable refreshCredentialReq
+//
+// Refreshes the stored verifiable credential using its refresh service.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: refreshCredentialRes
+func (o *Operation) RefreshCredential(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.RefreshCredential, rw, req.Body)
+}
+
+// IssueRefreshedCredential swagger:route POST /verifiable/refresh verifiable issueRefreshedCredentialReq
+//
+// Refresh service of the issuer. The request without a presentation returns the presentation request with
+// a challenge. The presentation of the issued credential signed by the credential subject with the challenge
+// and the refresh service URL as a domain returns the refreshed credential.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: issueRefreshedCredentialRes
+func (o *Operation) IssueRefreshedCredential(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.IssueRefreshedCredential, rw, req.Body)
+}
+
+// AddTrustList swagger:route POST /verifiable/trustlist verifiable addTrustListReq
+//
+// Verifies the signed trust list credential and adds it to the issuer trust registry.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: addTrustListRes
+func (o *Operation) AddTrustList(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.AddTrustList, rw, req.Body)
+}
+
+// RemoveTrustList swagger:route POST /verifiable/trustlist/remove verifiable removeTrustListReq
+//
+// Removes the trust list from the issuer trust registry.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: emptyRes
+func (o *Operation) RemoveTrustList(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.RemoveTrustList, rw, req.Body)
+}
+
+// GetTrustLists swagger:route GET /verifiable/trustlists verifiable getTrustLists
+//
+// Retrieves the trust lists of the issuer trust registry.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: getTrustListsRes
+func (o *Operation) GetTrustLists(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.GetTrustLists, rw, req.Body)
+}
+
 // SignCredential swagger:route POST /verifiable/signcredential verifiable signCredentialReq
 //
 // Signs given credential.
 //
 // Responses:
-//    default: genericError
-//        200: signCredentialRes
+//
+//	default: genericError
+//	    200: signCredentialRes
 func (o *Operation) SignCredential(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.SignCredential, rw, req.Body)
 }
@@ -216,8 +370,9 @@ func (o *Operation) SignCredential(rw http.ResponseWriter, req *http.Request) {
 // Retrieves the verifiable credentials.
 //
 // Responses:
-//    default: genericError
-//        200: presentationRecordResult
+//
+//	default: genericError
+//	    200: presentationRecordResult
 func (o *Operation) GetPresentations(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.GetPresentations, rw, req.Body)
 }
@@ -227,8 +382,9 @@ func (o *Operation) GetPresentations(rw http.ResponseWriter, req *http.Request)
 // Generates the verifiable presentation from a verifiable credential.
 //
 // Responses:
-//    default: genericError
-//        200: presentationRes
+//
+//	default: genericError
+//	    200: presentationRes
 func (o *Operation) GeneratePresentation(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.GeneratePresentation, rw, req.Body)
 }
@@ -239,19 +395,34 @@ func (o *Operation) GeneratePresentation(rw http.ResponseWriter, req *http.Reque
 // Generates the verifiable presentation from a stored verifiable credential.
 //
 // Responses:
-//    default: genericError
-//        200: presentationRes
+//
+//	default: genericError
+//	    200: presentationRes
 func (o *Operation) GeneratePresentationByID(rw http.ResponseWriter, req *http.Request) {
 	rest.Execute(o.command.GeneratePresentationByID, rw, req.Body)
 }
 
+// GeneratePresentationByDefinition swagger:route POST /verifiable/presentation/generatebydefinition
+// verifiable generatePresentationByDefinitionReq
+//
+// Generates the verifiable presentation satisfying the presentation definition from the stored verifiable credentials.
+//
+// Responses:
+//
+//	default: genericError
+//	    200: presentationRes
+func (o *Operation) GeneratePresentationByDefinition(rw http.ResponseWriter, req *http.Request) {
+	rest.Execute(o.command.GeneratePresentationByDefinition, rw, req.Body)
+}
+
 // RemoveCredentialByName swagger:route POST /verifiable/credential/remove/name/{name} verifiable removeCredentialByNameReq
 //
 // Removes a verifiable credential by name.
 //
 // Responses:
-//    default: genericError
-//        200: emptyResponse
+//
+//	default: genericError
+//	    200: emptyResponse
 func (o *Operation) RemoveCredentialByName(rw http.ResponseWriter, req *http.Request) {
 	name := mux.Vars(req)["name"]
 
@@ -265,8 +436,9 @@ func (o *Operation) RemoveCredentialByName(rw http.ResponseWriter, req *http.Req
 // Removes a verifiable presentation by name.
 //
 // Responses:
-//    default: genericError
-//        200: emptyResponse
+//
+//	default: genericError
+//	    200: emptyResponse
 func (o *Operation) RemovePresentationByName(rw http.ResponseWriter, req *http.Request) {
 	name := mux.Vars(req)["name"]
 
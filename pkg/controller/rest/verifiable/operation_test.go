@@ -16,6 +16,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -207,7 +208,8 @@ func TestNew(t *testing.T) {
 		})
 		require.NoError(t, err)
 		require.NotNil(t, cmd)
-		require.Equal(t, 13, len(cmd.GetRESTHandlers()))
+		require.Equal(t, 23, len(cmd.GetRESTHandlers()))
+		require.NoError(t, cmd.Close())
 	})
 
 	t.Run("test new command - error", func(t *testing.T) {
@@ -266,6 +268,255 @@ func TestValidateVC(t *testing.T) {
 	})
 }
 
+func TestVerifyVC(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	t.Run("test verify vc - report", func(t *testing.T) {
+		disabled := false
+
+		jsonStr, err := json.Marshal(verifiable.VerifyCredentialRequest{
+			Credential: []byte(vc),
+			Policy:     &verifiable.VerificationPolicy{ProofPurpose: &disabled, Status: &disabled},
+		})
+		require.NoError(t, err)
+
+		handler := lookupHandler(t, cmd, VerifyCredentialPath, http.MethodPost)
+		buf, err := getSuccessResponseFromHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+		require.NoError(t, err)
+
+		response := verifiable.VerifyCredentialResponse{}
+		err = json.Unmarshal(buf.Bytes(), &response)
+		require.NoError(t, err)
+
+		require.False(t, response.Verified)
+		require.Equal(t, []verifiable.VerificationCheck{
+			{Check: verifiable.FormatCheck, Passed: true},
+			{Check: verifiable.ProofCheck, Passed: false, Error: "credential has no proof"},
+			{Check: verifiable.ExpiryCheck, Passed: true},
+		}, response.Checks)
+	})
+
+	t.Run("test verify vc - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, VerifyCredentialPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString("{}"), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.VerifyCredentialErrorCode, "credential is mandatory", buf.Bytes())
+	})
+}
+
+func TestVerifyVP(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+		VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{ResolveErr: errors.New("resolve error")},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	t.Run("test verify vp - report", func(t *testing.T) {
+		jsonStr, err := json.Marshal(verifiable.VerifyPresentationRequest{
+			Presentation: []byte(udPresentation),
+			Policy:       &verifiable.VerificationPolicy{},
+		})
+		require.NoError(t, err)
+
+		handler := lookupHandler(t, cmd, VerifyPresentationPath, http.MethodPost)
+		buf, err := getSuccessResponseFromHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+		require.NoError(t, err)
+
+		response := verifiable.VerifyPresentationResponse{}
+		err = json.Unmarshal(buf.Bytes(), &response)
+		require.NoError(t, err)
+
+		require.False(t, response.Verified)
+		require.NotEmpty(t, response.Checks)
+		require.Equal(t, verifiable.FormatCheck, response.Checks[0].Check)
+	})
+
+	t.Run("test verify vp - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, VerifyPresentationPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString("{}"), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.VerifyPresentationErrorCode, "presentation is mandatory", buf.Bytes())
+	})
+}
+
+func TestQueryCredentials(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	vcReq := verifiable.CredentialExt{
+		Credential: verifiable.Credential{VerifiableCredential: vc},
+		Name:       sampleCredentialName,
+	}
+	jsonStr, err := json.Marshal(vcReq)
+	require.NoError(t, err)
+
+	handler := lookupHandler(t, cmd, SaveCredentialPath, http.MethodPost)
+	_, err = getSuccessResponseFromHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+	require.NoError(t, err)
+
+	t.Run("test query credentials - success", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, QueryCredentialsPath, http.MethodPost)
+		buf, err := getSuccessResponseFromHandler(handler, bytes.NewBufferString(`{"type":"VerifiableCredential"}`),
+			handler.Path())
+		require.NoError(t, err)
+
+		response := verifiable.QueryCredentialsResponse{}
+		err = json.Unmarshal(buf.Bytes(), &response)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, response.Total)
+		require.Len(t, response.Result, 1)
+		require.Equal(t, sampleCredentialName, response.Result[0].Name)
+	})
+
+	t.Run("test query credentials - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, QueryCredentialsPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"limit":-1}`), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.QueryCredentialsErrorCode, "offset and limit must not be negative", buf.Bytes())
+	})
+}
+
+func TestGetExpiringCredentials(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	expiringVC := strings.Replace(vc, `"issuanceDate":"2020-01-01T10:54:01Z",`,
+		`"issuanceDate":"2020-01-01T10:54:01Z", "expirationDate":"`+
+			time.Now().Add(24*time.Hour).UTC().Format(time.RFC3339)+`",`, 1)
+
+	vcReq := verifiable.CredentialExt{
+		Credential: verifiable.Credential{VerifiableCredential: expiringVC},
+		Name:       sampleCredentialName,
+	}
+	jsonStr, err := json.Marshal(vcReq)
+	require.NoError(t, err)
+
+	handler := lookupHandler(t, cmd, SaveCredentialPath, http.MethodPost)
+	_, err = getSuccessResponseFromHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+	require.NoError(t, err)
+
+	t.Run("test get expiring credentials - success", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, ExpiringCredentialsPath, http.MethodPost)
+		buf, err := getSuccessResponseFromHandler(handler, bytes.NewBufferString(`{"days":2}`), handler.Path())
+		require.NoError(t, err)
+
+		response := verifiable.ExpiringCredentialsResponse{}
+		err = json.Unmarshal(buf.Bytes(), &response)
+		require.NoError(t, err)
+
+		require.Len(t, response.Result, 1)
+		require.Equal(t, sampleCredentialName, response.Result[0].Name)
+	})
+
+	t.Run("test get expiring credentials - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, ExpiringCredentialsPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"days":-1}`), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.GetExpiringCredentialsErrorCode, "days must not be negative", buf.Bytes())
+	})
+}
+
+func TestRefreshCredential(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+		VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	t.Run("test refresh credential - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, RefreshCredentialPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{}`), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.RefreshCredentialErrorCode, "credential name is mandatory", buf.Bytes())
+	})
+
+	t.Run("test issue refreshed credential - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, RefreshServicePath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"type":"VerifiablePresentation"}`),
+			handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.IssueRefreshedCredentialErrorCode, "parse presentation", buf.Bytes())
+	})
+
+	t.Run("test issue refreshed credential - presentation request", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, RefreshServicePath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBuffer(nil), handler.Path())
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, code)
+		require.Contains(t, buf.String(), `"challenge"`)
+	})
+}
+
+func TestTrustLists(t *testing.T) {
+	cmd, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+		VDRIRegistryValue:    &mockvdri.MockVDRIRegistry{},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, cmd)
+
+	t.Run("test add trust list - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, AddTrustListPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{}`), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.AddTrustListErrorCode, "trust list is mandatory", buf.Bytes())
+	})
+
+	t.Run("test remove trust list - error", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, RemoveTrustListPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBufferString(`{"id":"unknown"}`), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.RemoveTrustListErrorCode, "remove trust list", buf.Bytes())
+	})
+
+	t.Run("test get trust lists - success", func(t *testing.T) {
+		handler := lookupHandler(t, cmd, GetTrustListsPath, http.MethodGet)
+		buf, err := getSuccessResponseFromHandler(handler, nil, handler.Path())
+		require.NoError(t, err)
+
+		var response verifiable.GetTrustListsResponse
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &response))
+		require.Empty(t, response.TrustLists)
+	})
+}
+
 func TestSaveVC(t *testing.T) {
 	t.Run("test save vc - success", func(t *testing.T) {
 		cmd, err := New(&mockprovider.Provider{
@@ -721,6 +972,50 @@ func TestGeneratePresentationByID(t *testing.T) {
 	})
 }
 
+func TestGeneratePresentationByDefinition(t *testing.T) {
+	cmd, cmdErr := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+		VDRIRegistryValue: &mockvdri.MockVDRIRegistry{
+			ResolveFunc: func(didID string, opts ...vdri.ResolveOpts) (*did.Doc, error) {
+				return did.ParseDocument([]byte(doc))
+			},
+		},
+		KMSValue:    &kmsmock.KeyManager{},
+		CryptoValue: &cryptomock.Crypto{},
+	})
+	require.NotNil(t, cmd)
+	require.NoError(t, cmdErr)
+
+	t.Run("test generate presentation by definition - no matching credentials", func(t *testing.T) {
+		var jsonStr = []byte(`{
+			"presentationDefinition": {"input_descriptors": [{"id": "passport"}]},
+			"did": "did:peer:123456789abcdefghi#inbox",
+			"signatureType": "Ed25519Signature2018"
+		}`)
+
+		handler := lookupHandler(t, cmd, GeneratePresentationByDefinitionPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.GeneratePresentationByDefinitionErrorCode,
+			"credentials do not satisfy presentation definition", buf.Bytes())
+	})
+
+	t.Run("test generate presentation by definition - missing definition", func(t *testing.T) {
+		var jsonStr = []byte(`{"did": "did:peer:123456789abcdefghi#inbox"}`)
+
+		handler := lookupHandler(t, cmd, GeneratePresentationByDefinitionPath, http.MethodPost)
+		buf, code, err := sendRequestToHandler(handler, bytes.NewBuffer(jsonStr), handler.Path())
+		require.NoError(t, err)
+		require.NotEmpty(t, buf)
+
+		require.Equal(t, http.StatusBadRequest, code)
+		verifyError(t, verifiable.InvalidRequestErrorCode, "presentation definition is mandatory", buf.Bytes())
+	})
+}
+
 func TestSaveVP(t *testing.T) {
 	t.Run("test save vp - success", func(t *testing.T) {
 		cmd, err := New(&mockprovider.Provider{
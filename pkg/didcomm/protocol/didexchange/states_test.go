@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -31,10 +32,14 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/mock/didcomm/protocol"
 	mockroute "github.com/hyperledger/aries-framework-go/pkg/mock/didcomm/protocol/mediator"
 	mockdiddoc "github.com/hyperledger/aries-framework-go/pkg/mock/diddoc"
+	mockkms "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
 	mockstorage "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
 	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/store/connection"
 	"github.com/hyperledger/aries-framework-go/pkg/store/did"
+	vdrireg "github.com/hyperledger/aries-framework-go/pkg/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/peer"
 )
 
 func TestNoopState(t *testing.T) {
@@ -1207,6 +1212,78 @@ func TestGetDIDDocAndConnection(t *testing.T) {
 	})
 }
 
+func TestGetDIDDocAndConnection_PeerDIDNumAlgo(t *testing.T) {
+	newContext := func(t *testing.T, numAlgo int, routerKeys *[]string) *context {
+		pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		peerVDRI, err := peer.New(mockstorage.NewMockStoreProvider(), peer.WithNumAlgo(numAlgo))
+		require.NoError(t, err)
+
+		connectionStore, err := newConnectionStore(&protocol.MockProvider{})
+		require.NoError(t, err)
+
+		return &context{
+			vdriRegistry: vdrireg.New(&mockprovider.Provider{
+				KMSValue: &mockkms.KeyManager{CreateKeyID: "key-1", ExportPubKeyBytesValue: pubKey},
+			},
+				vdrireg.WithVDRI(peerVDRI),
+				vdrireg.WithDefaultServiceType(vdri.DIDCommServiceType),
+				vdrireg.WithDefaultServiceEndpoint("https://agent.example.com")),
+			connectionStore: connectionStore,
+			routeSvc: &mockroute.MockMediatorSvc{
+				RouterEndpoint: "https://router.example.com",
+				RoutingKeys:    []string{"router-key"},
+				AddKeyFunc: func(recKey string) error {
+					*routerKeys = append(*routerKeys, recKey)
+
+					return nil
+				},
+			},
+		}
+	}
+
+	for _, numAlgo := range []int{1, 2} {
+		numAlgo := numAlgo
+
+		t.Run(fmt.Sprintf("numalgo %d DID has DIDComm service of the router", numAlgo), func(t *testing.T) {
+			var routerKeys []string
+
+			ctx := newContext(t, numAlgo, &routerKeys)
+
+			didDoc, conn, err := ctx.getDIDDocAndConnection("")
+			require.NoError(t, err)
+			require.Equal(t, didDoc.ID, conn.DID)
+			require.True(t, strings.HasPrefix(didDoc.ID, fmt.Sprintf("did:peer:%d", numAlgo)))
+
+			svc, ok := diddoc.LookupService(didDoc, didCommServiceType)
+			require.True(t, ok)
+			require.Equal(t, "https://router.example.com", svc.ServiceEndpoint)
+			require.Equal(t, []string{"router-key"}, svc.RoutingKeys)
+			require.Len(t, svc.RecipientKeys, 1)
+			require.Equal(t, svc.RecipientKeys, routerKeys)
+
+			// the keys of the connection are looked up by the DID
+			verKey, err := ctx.connectionStore.GetDID(svc.RecipientKeys[0])
+			require.NoError(t, err)
+			require.Equal(t, didDoc.ID, verKey)
+		})
+	}
+
+	t.Run("numalgo 0 DID is not created as it has no DIDComm service", func(t *testing.T) {
+		var routerKeys []string
+
+		ctx := newContext(t, 0, &routerKeys)
+
+		didDoc, conn, err := ctx.getDIDDocAndConnection("")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "numalgo 0: DID document has no service")
+		require.Nil(t, didDoc)
+		require.Nil(t, conn)
+		require.Empty(t, routerKeys)
+	})
+}
+
 func TestGetVerKey(t *testing.T) {
 	t.Run("returns verkey from explicit oob invitation", func(t *testing.T) {
 		expected := newServiceBlock()
@@ -0,0 +1,80 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package issuecredential
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/cm"
+)
+
+// ErrCredentialManifestNotFound is returned when the offer has no Credential Manifest attachment.
+var ErrCredentialManifestNotFound = errors.New("credential manifest attachment not found")
+
+// AttachCredentialManifest adds Credential Manifest to the offer's attachments. The manifest advertises
+// the credentials the Issuer can issue and what it requires from the Holder.
+func (m *OfferCredential) AttachCredentialManifest(manifest *cm.CredentialManifest) error {
+	if err := manifest.Validate(); err != nil {
+		return fmt.Errorf("attach credential manifest: %w", err)
+	}
+
+	raw, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("marshal credential manifest: %w", err)
+	}
+
+	var data map[string]interface{}
+
+	if err = json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("unmarshal credential manifest: %w", err)
+	}
+
+	attachID := uuid.New().String()
+
+	m.Formats = append(m.Formats, Format{
+		AttachID: attachID,
+		Format:   cm.CredentialManifestAttachmentFormat,
+	})
+
+	m.OffersAttach = append(m.OffersAttach, decorator.Attachment{
+		ID:       attachID,
+		MimeType: "application/json",
+		Data:     decorator.AttachmentData{JSON: data},
+	})
+
+	return nil
+}
+
+// CredentialManifest returns Credential Manifest attached to the offer.
+// ErrCredentialManifestNotFound is returned if the offer has no manifest attachment.
+func (m *OfferCredential) CredentialManifest() (*cm.CredentialManifest, error) {
+	for _, format := range m.Formats {
+		if format.Format != cm.CredentialManifestAttachmentFormat {
+			continue
+		}
+
+		for i := range m.OffersAttach {
+			if m.OffersAttach[i].ID != format.AttachID {
+				continue
+			}
+
+			raw, err := m.OffersAttach[i].Data.Fetch()
+			if err != nil {
+				return nil, fmt.Errorf("fetch credential manifest attachment: %w", err)
+			}
+
+			return cm.ParseCredentialManifest(raw)
+		}
+	}
+
+	return nil, ErrCredentialManifestNotFound
+}
@@ -0,0 +1,77 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package issuecredential
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/cm"
+)
+
+func TestOfferCredential_CredentialManifest(t *testing.T) {
+	manifest := &cm.CredentialManifest{
+		ID:     "manifest",
+		Issuer: cm.Issuer{ID: "did:example:123"},
+		OutputDescriptors: []*cm.OutputDescriptor{{
+			ID:     "degree",
+			Schema: "https://schema.org/EducationalOccupationalCredential",
+		}},
+	}
+
+	t.Run("manifest survives the offer round trip", func(t *testing.T) {
+		offer := &OfferCredential{Type: OfferCredentialMsgType}
+		require.NoError(t, offer.AttachCredentialManifest(manifest))
+		require.Len(t, offer.Formats, 1)
+		require.Equal(t, offer.OffersAttach[0].ID, offer.Formats[0].AttachID)
+
+		raw, err := json.Marshal(offer)
+		require.NoError(t, err)
+
+		received := &OfferCredential{}
+		require.NoError(t, json.Unmarshal(raw, received))
+
+		result, err := received.CredentialManifest()
+		require.NoError(t, err)
+		require.Equal(t, manifest, result)
+	})
+
+	t.Run("error on invalid manifest", func(t *testing.T) {
+		offer := &OfferCredential{}
+		err := offer.AttachCredentialManifest(&cm.CredentialManifest{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "attach credential manifest")
+		require.Empty(t, offer.OffersAttach)
+	})
+
+	t.Run("no manifest attached", func(t *testing.T) {
+		offer := &OfferCredential{
+			Formats:      []Format{{AttachID: "1", Format: "aries/ld-proof-vc-detail@v1.0"}},
+			OffersAttach: []decorator.Attachment{{ID: "1"}},
+		}
+
+		_, err := offer.CredentialManifest()
+		require.Equal(t, ErrCredentialManifestNotFound, err)
+	})
+
+	t.Run("error on invalid attachment", func(t *testing.T) {
+		offer := &OfferCredential{
+			Formats: []Format{{AttachID: "1", Format: cm.CredentialManifestAttachmentFormat}},
+			OffersAttach: []decorator.Attachment{{
+				ID:   "1",
+				Data: decorator.AttachmentData{Base64: "!"},
+			}},
+		}
+
+		_, err := offer.CredentialManifest()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "fetch credential manifest attachment")
+	})
+}
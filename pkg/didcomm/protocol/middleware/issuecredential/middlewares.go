@@ -11,9 +11,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/piprate/json-gold/ld"
 
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	storeverifiable "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
@@ -28,6 +30,7 @@ type Metadata issuecredential.Metadata
 type Provider interface {
 	VerifiableStore() storeverifiable.Store
 	VDRIRegistry() vdri.Registry
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
 }
 
 // SaveCredentials the helper function for the issue credential protocol which saves credentials.
@@ -35,6 +38,11 @@ func SaveCredentials(p Provider) issuecredential.Middleware {
 	registryVDRI := p.VDRIRegistry()
 	store := p.VerifiableStore()
 
+	var documentLoader ld.DocumentLoader = jsonld.DefaultDocumentLoader()
+	if loader := p.JSONLDDocumentLoader(); loader != nil {
+		documentLoader = loader
+	}
+
 	return func(next issuecredential.Handler) issuecredential.Handler {
 		return issuecredential.HandlerFunc(func(metadata issuecredential.Metadata) error {
 			if metadata.StateName() != stateNameCredentialReceived {
@@ -48,7 +56,7 @@ func SaveCredentials(p Provider) issuecredential.Middleware {
 				return fmt.Errorf("decode: %w", err)
 			}
 
-			credentials, err := toVerifiableCredentials(registryVDRI, credential.CredentialsAttach)
+			credentials, err := toVerifiableCredentials(registryVDRI, documentLoader, credential.CredentialsAttach)
 			if err != nil {
 				return fmt.Errorf("to verifiable credentials: %w", err)
 			}
@@ -82,7 +90,8 @@ func SaveCredentials(p Provider) issuecredential.Middleware {
 	}
 }
 
-func toVerifiableCredentials(vReg vdri.Registry, attachments []decorator.Attachment) ([]*verifiable.Credential, error) {
+func toVerifiableCredentials(vReg vdri.Registry, documentLoader ld.DocumentLoader,
+	attachments []decorator.Attachment) ([]*verifiable.Credential, error) {
 	var credentials []*verifiable.Credential
 
 	for i := range attachments {
@@ -93,7 +102,7 @@ func toVerifiableCredentials(vReg vdri.Registry, attachments []decorator.Attachm
 
 		vc, err := verifiable.ParseCredential(rawVC, verifiable.WithPublicKeyFetcher(
 			verifiable.NewDIDKeyResolver(vReg).PublicKeyFetcher(),
-		))
+		), verifiable.WithJSONLDDocumentLoader(documentLoader))
 		if err != nil {
 			return nil, fmt.Errorf("new credential: %w", err)
 		}
@@ -20,6 +20,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	mocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/didcomm/protocol/middleware/issuecredential"
@@ -34,6 +35,7 @@ func TestSaveCredentials(t *testing.T) {
 	provider := mocks.NewMockProvider(ctrl)
 	provider.EXPECT().VDRIRegistry().Return(nil).AnyTimes()
 	provider.EXPECT().VerifiableStore().Return(nil).AnyTimes()
+	provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 	next := issuecredential.HandlerFunc(func(metadata issuecredential.Metadata) error {
 		return nil
@@ -139,6 +141,7 @@ func TestSaveCredentials(t *testing.T) {
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(nil).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 		require.EqualError(t, SaveCredentials(provider)(next).Handle(metadata), "save credential: "+errMsg)
 	})
@@ -186,6 +189,7 @@ func TestSaveCredentials(t *testing.T) {
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(nil).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 		require.NoError(t, SaveCredentials(provider)(next).Handle(metadata))
 		require.Equal(t, props["names"], []string{vcName})
@@ -232,6 +236,7 @@ func TestSaveCredentials(t *testing.T) {
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(nil).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(jsonld.DefaultDocumentLoader()).AnyTimes()
 
 		require.NoError(t, SaveCredentials(provider)(next).Handle(metadata))
 		require.Equal(t, len(props["names"].([]string)), 1)
@@ -295,7 +300,7 @@ func TestSaveCredentials(t *testing.T) {
 		verifiableStore.EXPECT().SaveCredential(gomock.Any(), gomock.Any()).Return(nil)
 
 		registry := mocksvdri.NewMockRegistry(ctrl)
-		registry.EXPECT().Resolve("did:example:123456").Return(&did.Doc{
+		registry.EXPECT().ResolveWithMetadata("did:example:123456").Return(did.NewDocResolution(&did.Doc{
 			PublicKey: []did.PublicKey{{
 				ID: "#key1",
 				Value: []byte{
@@ -303,11 +308,12 @@ func TestSaveCredentials(t *testing.T) {
 					33, 152, 140, 168, 36, 9, 205, 59, 161, 137, 7, 164, 9, 176, 252, 1, 171,
 				},
 			}},
-		}, nil)
+		}), nil)
 
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(registry).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 		require.NoError(t, SaveCredentials(provider)(next).Handle(metadata))
 		require.Equal(t, props["names"], []string{vcName})
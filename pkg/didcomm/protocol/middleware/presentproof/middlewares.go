@@ -11,9 +11,11 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/piprate/json-gold/ld"
 
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	storeverifiable "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
@@ -28,13 +30,39 @@ type Metadata presentproof.Metadata
 type Provider interface {
 	VerifiableStore() storeverifiable.Store
 	VDRIRegistry() vdri.Registry
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
+}
+
+type options struct {
+	issuerTrustChecker verifiable.IssuerTrustChecker
+}
+
+// Opt is the SavePresentation option.
+type Opt func(opts *options)
+
+// WithIssuerTrustCheck option enables the check that issuers of the credentials enclosed into received
+// presentations are trusted (e.g. by the trust registry). Presentations with untrusted credentials are rejected.
+func WithIssuerTrustCheck(checker verifiable.IssuerTrustChecker) Opt {
+	return func(opts *options) {
+		opts.issuerTrustChecker = checker
+	}
 }
 
 // SavePresentation the helper function for the present proof protocol which saves the presentations.
-func SavePresentation(p Provider) presentproof.Middleware {
+func SavePresentation(p Provider, opts ...Opt) presentproof.Middleware {
 	registryVDRI := p.VDRIRegistry()
 	store := p.VerifiableStore()
 
+	var documentLoader ld.DocumentLoader = jsonld.DefaultDocumentLoader()
+	if loader := p.JSONLDDocumentLoader(); loader != nil {
+		documentLoader = loader
+	}
+
+	var mwOpts options
+	for _, opt := range opts {
+		opt(&mwOpts)
+	}
+
 	return func(next presentproof.Handler) presentproof.Handler {
 		return presentproof.HandlerFunc(func(metadata presentproof.Metadata) error {
 			if metadata.StateName() != stateNamePresentationReceived {
@@ -46,7 +74,7 @@ func SavePresentation(p Provider) presentproof.Middleware {
 				return fmt.Errorf("decode: %w", err)
 			}
 
-			presentations, err := toVerifiablePresentation(registryVDRI, presentation.PresentationsAttach)
+			presentations, err := toVerifiablePresentation(registryVDRI, documentLoader, presentation.PresentationsAttach)
 			if err != nil {
 				return fmt.Errorf("to verifiable presentation: %w", err)
 			}
@@ -55,6 +83,12 @@ func SavePresentation(p Provider) presentproof.Middleware {
 				return errors.New("presentations were not provided")
 			}
 
+			if mwOpts.issuerTrustChecker != nil {
+				if err := checkIssuerTrust(registryVDRI, documentLoader, mwOpts.issuerTrustChecker, presentations); err != nil {
+					return fmt.Errorf("check issuer trust: %w", err)
+				}
+			}
+
 			var names []string
 			for i, presentation := range presentations {
 				var name = presentation.ID
@@ -80,7 +114,8 @@ func SavePresentation(p Provider) presentproof.Middleware {
 	}
 }
 
-func toVerifiablePresentation(registry vdri.Registry, data []decorator.Attachment) ([]*verifiable.Presentation, error) {
+func toVerifiablePresentation(registry vdri.Registry, documentLoader ld.DocumentLoader,
+	data []decorator.Attachment) ([]*verifiable.Presentation, error) {
 	var presentations []*verifiable.Presentation
 
 	for i := range data {
@@ -91,7 +126,7 @@ func toVerifiablePresentation(registry vdri.Registry, data []decorator.Attachmen
 
 		presentation, err := verifiable.ParsePresentation(raw, verifiable.WithPresPublicKeyFetcher(
 			verifiable.NewDIDKeyResolver(registry).PublicKeyFetcher(),
-		))
+		), verifiable.WithPresJSONLDDocumentLoader(documentLoader))
 
 		if err != nil {
 			return nil, fmt.Errorf("parse presentation: %w", err)
@@ -102,3 +137,26 @@ func toVerifiablePresentation(registry vdri.Registry, data []decorator.Attachmen
 
 	return presentations, nil
 }
+
+func checkIssuerTrust(registry vdri.Registry, documentLoader ld.DocumentLoader, checker verifiable.IssuerTrustChecker,
+	presentations []*verifiable.Presentation) error {
+	for _, presentation := range presentations {
+		credentials, err := presentation.MarshalledCredentials()
+		if err != nil {
+			return err
+		}
+
+		for _, raw := range credentials {
+			_, err := verifiable.ParseCredential(raw,
+				verifiable.WithPublicKeyFetcher(verifiable.NewDIDKeyResolver(registry).PublicKeyFetcher()),
+				verifiable.WithIssuerTrustCheck(checker),
+				verifiable.WithJSONLDDocumentLoader(documentLoader),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	mocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/didcomm/protocol/middleware/presentproof"
 	mocksvdri "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/framework/aries/api/vdri"
@@ -41,6 +42,7 @@ func TestSavePresentation(t *testing.T) {
 	provider := mocks.NewMockProvider(ctrl)
 	provider.EXPECT().VDRIRegistry().Return(nil).AnyTimes()
 	provider.EXPECT().VerifiableStore().Return(nil).AnyTimes()
+	provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 	next := presentproof.HandlerFunc(func(metadata presentproof.Metadata) error {
 		return nil
@@ -124,13 +126,14 @@ func TestSavePresentation(t *testing.T) {
 		verifiableStore.EXPECT().SavePresentation(gomock.Any(), gomock.Any()).Return(errors.New(errMsg))
 
 		registry := mocksvdri.NewMockRegistry(ctrl)
-		registry.EXPECT().Resolve("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(&did.Doc{
+		registry.EXPECT().ResolveWithMetadata("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(did.NewDocResolution(&did.Doc{
 			PublicKey: []did.PublicKey{pubKey},
-		}, nil)
+		}), nil)
 
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(registry).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 		require.EqualError(t, SavePresentation(provider)(next).Handle(metadata), "save presentation: "+errMsg)
 	})
@@ -155,19 +158,52 @@ func TestSavePresentation(t *testing.T) {
 		verifiableStore.EXPECT().SavePresentation(gomock.Any(), gomock.Any()).Return(nil)
 
 		registry := mocksvdri.NewMockRegistry(ctrl)
-		registry.EXPECT().Resolve("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(&did.Doc{
+		registry.EXPECT().ResolveWithMetadata("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(did.NewDocResolution(&did.Doc{
 			PublicKey: []did.PublicKey{pubKey},
-		}, nil)
+		}), nil)
 
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(registry).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
 
 		require.NoError(t, SavePresentation(provider)(next).Handle(metadata))
 		require.Equal(t, len(props["names"].([]string)), 1)
 		require.NotEmpty(t, props["names"].([]string)[0])
 	})
 
+	t.Run("Untrusted issuer", func(t *testing.T) {
+		vpJWS := "eyJhbGciOiJFZERTQSIsImtpZCI6ImtleS0xIiwidHlwIjoiSldUIn0.eyJpc3MiOiJkaWQ6ZXhhbXBsZTplYmZlYjFmNzEyZWJjNmYxYzI3NmUxMmVjMjEiLCJqdGkiOiJ1cm46dXVpZDozOTc4MzQ0Zi04NTk2LTRjM2EtYTk3OC04ZmNhYmEzOTAzYzUiLCJ2cCI6eyJAY29udGV4dCI6WyJodHRwczovL3d3dy53My5vcmcvMjAxOC9jcmVkZW50aWFscy92MSIsImh0dHBzOi8vd3d3LnczLm9yZy8yMDE4L2NyZWRlbnRpYWxzL2V4YW1wbGVzL3YxIl0sInR5cGUiOlsiVmVyaWZpYWJsZVByZXNlbnRhdGlvbiIsIlVuaXZlcnNpdHlEZWdyZWVDcmVkZW50aWFsIl0sInZlcmlmaWFibGVDcmVkZW50aWFsIjpbeyJAY29udGV4dCI6WyJodHRwczovL3d3dy53My5vcmcvMjAxOC9jcmVkZW50aWFscy92MSIsImh0dHBzOi8vd3d3LnczLm9yZy8yMDE4L2NyZWRlbnRpYWxzL2V4YW1wbGVzL3YxIl0sImNyZWRlbnRpYWxTY2hlbWEiOltdLCJjcmVkZW50aWFsU3ViamVjdCI6eyJkZWdyZWUiOnsidHlwZSI6IkJhY2hlbG9yRGVncmVlIiwidW5pdmVyc2l0eSI6Ik1JVCJ9LCJpZCI6ImRpZDpleGFtcGxlOmViZmViMWY3MTJlYmM2ZjFjMjc2ZTEyZWMyMSIsIm5hbWUiOiJKYXlkZW4gRG9lIiwic3BvdXNlIjoiZGlkOmV4YW1wbGU6YzI3NmUxMmVjMjFlYmZlYjFmNzEyZWJjNmYxIn0sImV4cGlyYXRpb25EYXRlIjoiMjAyMC0wMS0wMVQxOToyMzoyNFoiLCJpZCI6Imh0dHA6Ly9leGFtcGxlLmVkdS9jcmVkZW50aWFscy8xODcyIiwiaXNzdWFuY2VEYXRlIjoiMjAxMC0wMS0wMVQxOToyMzoyNFoiLCJpc3N1ZXIiOnsiaWQiOiJkaWQ6ZXhhbXBsZTo3NmUxMmVjNzEyZWJjNmYxYzIyMWViZmViMWYiLCJuYW1lIjoiRXhhbXBsZSBVbml2ZXJzaXR5In0sInJlZmVyZW5jZU51bWJlciI6OC4zMjk0ODQ3ZSswNywidHlwZSI6WyJWZXJpZmlhYmxlQ3JlZGVudGlhbCIsIlVuaXZlcnNpdHlEZWdyZWVDcmVkZW50aWFsIl19XX19.RlO_1B-7qhQNwo2mmOFUWSa8A6hwaJrtq3q7yJDkKq4k6B-EJ-oyLNM6H_g2_nko2Yg9Im1CiROFm6nK12U_AQ" //nolint:lll
+
+		metadata := mocks.NewMockMetadata(ctrl)
+		metadata.EXPECT().StateName().Return(stateNamePresentationReceived)
+		metadata.EXPECT().Message().Return(service.NewDIDCommMsgMap(presentproof.Presentation{
+			Type: presentproof.PresentationMsgType,
+			PresentationsAttach: []decorator.Attachment{
+				{Data: decorator.AttachmentData{Base64: base64.StdEncoding.EncodeToString([]byte(vpJWS))}},
+			},
+		}))
+
+		registry := mocksvdri.NewMockRegistry(ctrl)
+		registry.EXPECT().ResolveWithMetadata("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(did.NewDocResolution(&did.Doc{
+			PublicKey: []did.PublicKey{pubKey},
+		}), nil)
+
+		provider := mocks.NewMockProvider(ctrl)
+		provider.EXPECT().VDRIRegistry().Return(registry).AnyTimes()
+		provider.EXPECT().VerifiableStore().Return(nil)
+		provider.EXPECT().JSONLDDocumentLoader().Return(nil).AnyTimes()
+
+		checker := issuerTrustCheckerFunc(func(vc *verifiable.Credential) error {
+			require.Equal(t, "did:example:76e12ec712ebc6f1c221ebfeb1f", vc.Issuer.ID)
+
+			return errors.New("untrusted issuer")
+		})
+
+		err := SavePresentation(provider, WithIssuerTrustCheck(checker))(next).Handle(metadata)
+		require.EqualError(t, err, "check issuer trust: check issuer trust: untrusted issuer")
+	})
+
 	t.Run("Success", func(t *testing.T) {
 		const vcName = "vc-name"
 
@@ -190,15 +226,24 @@ func TestSavePresentation(t *testing.T) {
 		verifiableStore.EXPECT().SavePresentation(gomock.Any(), gomock.Any()).Return(nil)
 
 		registry := mocksvdri.NewMockRegistry(ctrl)
-		registry.EXPECT().Resolve("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(&did.Doc{
+		registry.EXPECT().ResolveWithMetadata("did:example:ebfeb1f712ebc6f1c276e12ec21").Return(did.NewDocResolution(&did.Doc{
 			PublicKey: []did.PublicKey{pubKey},
-		}, nil)
+		}), nil)
 
 		provider := mocks.NewMockProvider(ctrl)
 		provider.EXPECT().VDRIRegistry().Return(registry).AnyTimes()
 		provider.EXPECT().VerifiableStore().Return(verifiableStore)
+		provider.EXPECT().JSONLDDocumentLoader().Return(jsonld.DefaultDocumentLoader()).AnyTimes()
 
-		require.NoError(t, SavePresentation(provider)(next).Handle(metadata))
+		trusted := issuerTrustCheckerFunc(func(*verifiable.Credential) error { return nil })
+
+		require.NoError(t, SavePresentation(provider, WithIssuerTrustCheck(trusted))(next).Handle(metadata))
 		require.Equal(t, props["names"], []string{vcName})
 	})
 }
+
+type issuerTrustCheckerFunc func(vc *verifiable.Credential) error
+
+func (f issuerTrustCheckerFunc) CheckIssuerTrust(vc *verifiable.Credential) error {
+	return f(vc)
+}
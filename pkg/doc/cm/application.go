@@ -0,0 +1,124 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+const (
+	// CredentialApplicationJSONLDContext is the JSONLD context of credential applications (embedded into
+	// the default JSON-LD document loader).
+	CredentialApplicationJSONLDContext = "https://identity.foundation/credential-manifest/application/v1"
+	// CredentialApplicationJSONLDType is the JSONLD type of credential applications.
+	CredentialApplicationJSONLDType = "CredentialApplication"
+
+	applicationProperty = "credential_application"
+
+	vpContext = "https://www.w3.org/2018/credentials/v1"
+	vpType    = "VerifiablePresentation"
+)
+
+// CredentialApplication is sent by the holder to apply for the credentials described by Credential Manifest
+// (https://identity.foundation/credential-manifest/#credential-application). It is embedded into the verifiable
+// presentation which submits the credentials required by the manifest's presentation definition.
+type CredentialApplication struct {
+	ID         string `json:"id"`
+	ManifestID string `json:"manifest_id"`
+}
+
+// CreateApplication creates Credential Application for the manifest. The credentials satisfying the manifest's
+// presentation definition are embedded into the application presentation along with the presentation submission.
+// The returned presentation is not signed and has no holder set.
+func (cm *CredentialManifest) CreateApplication(credentials []*verifiable.Credential,
+	options ...presexch.CreateVPOption) (*verifiable.Presentation, error) {
+	vp := &verifiable.Presentation{
+		Context: []string{vpContext},
+		Type:    []string{vpType},
+	}
+
+	if cm.PresentationDefinition != nil {
+		var err error
+
+		vp, err = cm.PresentationDefinition.CreateVP(credentials, options...)
+		if err != nil {
+			return nil, fmt.Errorf("create credential application: %w", err)
+		}
+	}
+
+	application, err := toTypelessMap(&CredentialApplication{
+		ID:         uuid.New().String(),
+		ManifestID: cm.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	vp.Context = append(vp.Context, CredentialApplicationJSONLDContext)
+	vp.Type = append(vp.Type, CredentialApplicationJSONLDType)
+
+	if vp.CustomFields == nil {
+		vp.CustomFields = make(verifiable.CustomFields)
+	}
+
+	vp.CustomFields[applicationProperty] = application
+
+	return vp, nil
+}
+
+// ValidateApplication checks that Credential Application presentation is made for the manifest and that the
+// submitted credentials satisfy the manifest's presentation definition. It returns the submitted credentials
+// matched against the input descriptors ids.
+func (cm *CredentialManifest) ValidateApplication(vp *verifiable.Presentation,
+	options ...presexch.MatchOption) (map[string]*verifiable.Credential, error) {
+	application, err := ApplicationFromPresentation(vp)
+	if err != nil {
+		return nil, err
+	}
+
+	if application.ManifestID != cm.ID {
+		return nil, fmt.Errorf("credential application is made for manifest %s instead of %s",
+			application.ManifestID, cm.ID)
+	}
+
+	if cm.PresentationDefinition == nil {
+		return map[string]*verifiable.Credential{}, nil
+	}
+
+	matched, err := cm.PresentationDefinition.Match(vp, options...)
+	if err != nil {
+		return nil, fmt.Errorf("credential application does not satisfy presentation definition: %w", err)
+	}
+
+	return matched, nil
+}
+
+// ApplicationFromPresentation extracts Credential Application embedded into the presentation.
+func ApplicationFromPresentation(vp *verifiable.Presentation) (*CredentialApplication, error) {
+	if !stringsContain(vp.Type, CredentialApplicationJSONLDType) {
+		return nil, fmt.Errorf("verifiable presentation must have json-ld type %s", CredentialApplicationJSONLDType)
+	}
+
+	application := &CredentialApplication{}
+
+	err := fromTypelessMap(vp.CustomFields[applicationProperty], application)
+	if err != nil {
+		return nil, fmt.Errorf("parse credential application: %w", err)
+	}
+
+	if application.ManifestID == "" {
+		return nil, errors.New("credential application has no manifest ID")
+	}
+
+	return application, nil
+}
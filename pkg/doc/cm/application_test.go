@@ -0,0 +1,127 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestCredentialManifest_CreateApplication(t *testing.T) {
+	uri := randomURI()
+	manifest := newManifest(uri)
+	vc := newVC([]string{uri})
+
+	t.Run("holder applies and issuer validates the application", func(t *testing.T) {
+		vp, err := manifest.CreateApplication([]*verifiable.Credential{newVC(nil), vc})
+		require.NoError(t, err)
+		require.Contains(t, vp.Context, CredentialApplicationJSONLDContext)
+		require.Contains(t, vp.Type, CredentialApplicationJSONLDType)
+
+		// application is sent over the wire
+		received, err := verifiable.ParseUnverifiedPresentation(marshal(t, vp))
+		require.NoError(t, err)
+
+		application, err := ApplicationFromPresentation(received)
+		require.NoError(t, err)
+		require.Equal(t, manifest.ID, application.ManifestID)
+		require.NotEmpty(t, application.ID)
+
+		matched, err := manifest.ValidateApplication(received,
+			presexch.WithJSONLDDocumentLoader(jsonldContextLoader(t, uri)))
+		require.NoError(t, err)
+		require.Len(t, matched, 1)
+		require.Equal(t, vc.ID, matched["id"].ID)
+	})
+
+	t.Run("manifest without presentation definition", func(t *testing.T) {
+		manifest := newManifest(uri)
+		manifest.PresentationDefinition = nil
+
+		vp, err := manifest.CreateApplication(nil)
+		require.NoError(t, err)
+		require.Empty(t, vp.Credentials())
+
+		matched, err := manifest.ValidateApplication(vp)
+		require.NoError(t, err)
+		require.Empty(t, matched)
+	})
+
+	t.Run("application context is loaded by default JSON-LD document loader", func(t *testing.T) {
+		// the credential is matched by the base context to be loaded by default loader as well
+		manifest := newManifest(vpContext)
+
+		vp, err := manifest.CreateApplication([]*verifiable.Credential{newVC(nil)})
+		require.NoError(t, err)
+
+		_, err = verifiable.ParsePresentation(marshal(t, vp),
+			verifiable.WithPresDisabledProofCheck(),
+			verifiable.WithPresStrictValidation())
+		require.NoError(t, err)
+	})
+
+	t.Run("error if credentials do not satisfy presentation definition", func(t *testing.T) {
+		_, err := manifest.CreateApplication([]*verifiable.Credential{newVC(nil)})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "create credential application")
+	})
+}
+
+func TestCredentialManifest_ValidateApplication(t *testing.T) {
+	uri := randomURI()
+	manifest := newManifest(uri)
+
+	t.Run("error if application is made for another manifest", func(t *testing.T) {
+		vp, err := newManifest(uri).CreateApplication([]*verifiable.Credential{newVC([]string{uri})})
+		require.NoError(t, err)
+
+		_, err = manifest.ValidateApplication(vp)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credential application is made for manifest")
+	})
+
+	t.Run("error if submitted credentials do not satisfy presentation definition", func(t *testing.T) {
+		otherManifest := newManifest(uri)
+		otherManifest.ID = manifest.ID
+		otherManifest.PresentationDefinition = nil
+
+		vp, err := otherManifest.CreateApplication(nil)
+		require.NoError(t, err)
+
+		_, err = manifest.ValidateApplication(vp)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credential application does not satisfy presentation definition")
+	})
+
+	t.Run("error if presentation is not an application", func(t *testing.T) {
+		_, err := manifest.ValidateApplication(&verifiable.Presentation{Type: []string{vpType}})
+		require.EqualError(t, err,
+			"verifiable presentation must have json-ld type "+CredentialApplicationJSONLDType)
+	})
+}
+
+func TestApplicationFromPresentation(t *testing.T) {
+	t.Run("error if application is missing", func(t *testing.T) {
+		_, err := ApplicationFromPresentation(&verifiable.Presentation{
+			Type: []string{vpType, CredentialApplicationJSONLDType},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse credential application")
+	})
+
+	t.Run("error if application has no manifest ID", func(t *testing.T) {
+		_, err := ApplicationFromPresentation(&verifiable.Presentation{
+			Type:         []string{vpType, CredentialApplicationJSONLDType},
+			CustomFields: verifiable.CustomFields{applicationProperty: map[string]interface{}{"id": "123"}},
+		})
+		require.EqualError(t, err, "credential application has no manifest ID")
+	})
+}
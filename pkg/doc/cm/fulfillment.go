@@ -0,0 +1,146 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+const (
+	// CredentialFulfillmentJSONLDContext is the JSONLD context of credential fulfillments (embedded into
+	// the default JSON-LD document loader).
+	CredentialFulfillmentJSONLDContext = "https://identity.foundation/credential-manifest/fulfillment/v1"
+	// CredentialFulfillmentJSONLDType is the JSONLD type of credential fulfillments.
+	CredentialFulfillmentJSONLDType = "CredentialFulfillment"
+
+	fulfillmentProperty = "credential_fulfillment"
+)
+
+// CredentialFulfillment is sent by the issuer in response to Credential Application
+// (https://identity.foundation/credential-manifest/#credential-fulfillment). It is embedded into the verifiable
+// presentation holding the issued credentials, its descriptor map refers the output descriptors of the manifest.
+type CredentialFulfillment struct {
+	ID            string                             `json:"id"`
+	ManifestID    string                             `json:"manifest_id"`
+	DescriptorMap []*presexch.InputDescriptorMapping `json:"descriptor_map"`
+}
+
+// CreateFulfillment creates Credential Fulfillment holding the issued credentials (map key is output
+// descriptor ID). The returned presentation is not signed.
+func (cm *CredentialManifest) CreateFulfillment(
+	credentials map[string]*verifiable.Credential) (*verifiable.Presentation, error) {
+	for id := range credentials {
+		if cm.outputDescriptor(id) == nil {
+			return nil, fmt.Errorf("output descriptor %s is not defined in credential manifest", id)
+		}
+	}
+
+	fulfillment := &CredentialFulfillment{
+		ID:         uuid.New().String(),
+		ManifestID: cm.ID,
+	}
+
+	var vcs []interface{}
+
+	// follow the order of output descriptors to make the descriptor map deterministic
+	for _, descriptor := range cm.OutputDescriptors {
+		vc, ok := credentials[descriptor.ID]
+		if !ok {
+			continue
+		}
+
+		fulfillment.DescriptorMap = append(fulfillment.DescriptorMap, &presexch.InputDescriptorMapping{
+			ID:   descriptor.ID,
+			Path: fmt.Sprintf("$.verifiableCredential[%d]", len(vcs)),
+		})
+
+		vcs = append(vcs, vc)
+	}
+
+	fulfillmentMap, err := toTypelessMap(fulfillment)
+	if err != nil {
+		return nil, err
+	}
+
+	vp := &verifiable.Presentation{
+		Context:      []string{vpContext, CredentialFulfillmentJSONLDContext},
+		Type:         []string{vpType, CredentialFulfillmentJSONLDType},
+		CustomFields: verifiable.CustomFields{fulfillmentProperty: fulfillmentMap},
+	}
+
+	if err = vp.SetCredentials(vcs...); err != nil {
+		return nil, fmt.Errorf("failed to set credentials: %w", err)
+	}
+
+	return vp, nil
+}
+
+// FulfillmentFromPresentation extracts Credential Fulfillment embedded into the presentation.
+func FulfillmentFromPresentation(vp *verifiable.Presentation) (*CredentialFulfillment, error) {
+	if !stringsContain(vp.Type, CredentialFulfillmentJSONLDType) {
+		return nil, fmt.Errorf("verifiable presentation must have json-ld type %s", CredentialFulfillmentJSONLDType)
+	}
+
+	fulfillment := &CredentialFulfillment{}
+
+	err := fromTypelessMap(vp.CustomFields[fulfillmentProperty], fulfillment)
+	if err != nil {
+		return nil, fmt.Errorf("parse credential fulfillment: %w", err)
+	}
+
+	if fulfillment.ManifestID == "" {
+		return nil, errors.New("credential fulfillment has no manifest ID")
+	}
+
+	return fulfillment, nil
+}
+
+func toTypelessMap(v interface{}) (map[string]interface{}, error) {
+	bits, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	m := make(map[string]interface{})
+
+	err = json.Unmarshal(bits, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal: %w", err)
+	}
+
+	return m, nil
+}
+
+func fromTypelessMap(m, v interface{}) error {
+	if m == nil {
+		return errors.New("property is missing")
+	}
+
+	bits, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to marshal: %w", err)
+	}
+
+	return json.Unmarshal(bits, v)
+}
+
+func stringsContain(s []string, val string) bool {
+	for i := range s {
+		if s[i] == val {
+			return true
+		}
+	}
+
+	return false
+}
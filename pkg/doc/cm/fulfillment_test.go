@@ -0,0 +1,82 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestCredentialManifest_CreateFulfillment(t *testing.T) {
+	manifest := newManifest(randomURI())
+
+	t.Run("issuer fulfills the application", func(t *testing.T) {
+		degree, transcript := newVC(nil), newVC(nil)
+
+		vp, err := manifest.CreateFulfillment(map[string]*verifiable.Credential{
+			"transcript": transcript,
+			"degree":     degree,
+		})
+		require.NoError(t, err)
+		require.Len(t, vp.Credentials(), 2)
+
+		received, err := verifiable.ParseUnverifiedPresentation(marshal(t, vp))
+		require.NoError(t, err)
+
+		fulfillment, err := FulfillmentFromPresentation(received)
+		require.NoError(t, err)
+		require.Equal(t, manifest.ID, fulfillment.ManifestID)
+		require.NotEmpty(t, fulfillment.ID)
+		require.Equal(t, []*presexch.InputDescriptorMapping{
+			{ID: "degree", Path: "$.verifiableCredential[0]"},
+			{ID: "transcript", Path: "$.verifiableCredential[1]"},
+		}, fulfillment.DescriptorMap)
+	})
+
+	t.Run("fulfillment context is loaded by default JSON-LD document loader", func(t *testing.T) {
+		vp, err := manifest.CreateFulfillment(map[string]*verifiable.Credential{"degree": newVC(nil)})
+		require.NoError(t, err)
+
+		_, err = verifiable.ParsePresentation(marshal(t, vp),
+			verifiable.WithPresDisabledProofCheck(),
+			verifiable.WithPresStrictValidation())
+		require.NoError(t, err)
+	})
+
+	t.Run("error if output descriptor is not defined", func(t *testing.T) {
+		_, err := manifest.CreateFulfillment(map[string]*verifiable.Credential{"passport": newVC(nil)})
+		require.EqualError(t, err, "output descriptor passport is not defined in credential manifest")
+	})
+}
+
+func TestFulfillmentFromPresentation(t *testing.T) {
+	t.Run("error if presentation is not a fulfillment", func(t *testing.T) {
+		_, err := FulfillmentFromPresentation(&verifiable.Presentation{Type: []string{vpType}})
+		require.EqualError(t, err,
+			"verifiable presentation must have json-ld type "+CredentialFulfillmentJSONLDType)
+	})
+
+	t.Run("error if fulfillment is missing", func(t *testing.T) {
+		_, err := FulfillmentFromPresentation(&verifiable.Presentation{
+			Type: []string{vpType, CredentialFulfillmentJSONLDType},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse credential fulfillment")
+	})
+
+	t.Run("error if fulfillment has no manifest ID", func(t *testing.T) {
+		_, err := FulfillmentFromPresentation(&verifiable.Presentation{
+			Type:         []string{vpType, CredentialFulfillmentJSONLDType},
+			CustomFields: verifiable.CustomFields{fulfillmentProperty: map[string]interface{}{"id": "123"}},
+		})
+		require.EqualError(t, err, "credential fulfillment has no manifest ID")
+	})
+}
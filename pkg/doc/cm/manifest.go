@@ -0,0 +1,111 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package cm implements DIF Credential Manifest (https://identity.foundation/credential-manifest/).
+// An issuer uses a Credential Manifest to advertise the credentials it can issue (output descriptors) and what
+// it requires from the holder (presentation definition). The holder replies with a Credential Application and
+// the issuer responds with a Credential Fulfillment.
+package cm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+)
+
+// CredentialManifestAttachmentFormat is the attachment format of Credential Manifest.
+const CredentialManifestAttachmentFormat = "dif/credential-manifest/manifest@v1.0"
+
+// CredentialManifest describes the credentials an issuer can issue and the inputs it requires
+// (https://identity.foundation/credential-manifest/#credential-manifest).
+type CredentialManifest struct {
+	ID                     string                            `json:"id"`
+	Version                string                            `json:"version,omitempty"`
+	Issuer                 Issuer                            `json:"issuer"`
+	OutputDescriptors      []*OutputDescriptor               `json:"output_descriptors"`
+	PresentationDefinition *presexch.PresentationDefinitions `json:"presentation_definition,omitempty"`
+}
+
+// Issuer describes the issuer of the Credential Manifest.
+type Issuer struct {
+	ID     string                 `json:"id"`
+	Name   string                 `json:"name,omitempty"`
+	Styles map[string]interface{} `json:"styles,omitempty"`
+}
+
+// OutputDescriptor describes a credential the issuer will issue.
+type OutputDescriptor struct {
+	ID          string                 `json:"id"`
+	Schema      string                 `json:"schema"`
+	Name        string                 `json:"name,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Styles      map[string]interface{} `json:"styles,omitempty"`
+	Display     map[string]interface{} `json:"display,omitempty"`
+}
+
+// ParseCredentialManifest parses Credential Manifest from JSON and validates it.
+func ParseCredentialManifest(manifestBytes []byte) (*CredentialManifest, error) {
+	manifest := &CredentialManifest{}
+
+	err := json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal credential manifest: %w", err)
+	}
+
+	err = manifest.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Validate checks that the mandatory fields of Credential Manifest are set and output descriptor IDs are unique.
+func (cm *CredentialManifest) Validate() error {
+	if cm.ID == "" {
+		return errors.New("credential manifest ID is missing")
+	}
+
+	if cm.Issuer.ID == "" {
+		return errors.New("credential manifest issuer ID is missing")
+	}
+
+	if len(cm.OutputDescriptors) == 0 {
+		return errors.New("credential manifest has no output descriptors")
+	}
+
+	ids := make(map[string]bool)
+
+	for i, descriptor := range cm.OutputDescriptors {
+		if descriptor.ID == "" {
+			return fmt.Errorf("output descriptor at index %d has no ID", i)
+		}
+
+		if ids[descriptor.ID] {
+			return fmt.Errorf("duplicate output descriptor ID %s", descriptor.ID)
+		}
+
+		ids[descriptor.ID] = true
+
+		if descriptor.Schema == "" {
+			return fmt.Errorf("output descriptor %s has no schema", descriptor.ID)
+		}
+	}
+
+	return nil
+}
+
+func (cm *CredentialManifest) outputDescriptor(id string) *OutputDescriptor {
+	for _, descriptor := range cm.OutputDescriptors {
+		if descriptor.ID == id {
+			return descriptor
+		}
+	}
+
+	return nil
+}
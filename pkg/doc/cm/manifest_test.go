@@ -0,0 +1,179 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package cm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/presexch"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestParseCredentialManifest(t *testing.T) {
+	t.Run("parses valid manifest", func(t *testing.T) {
+		expected := newManifest(randomURI())
+
+		manifest, err := ParseCredentialManifest(marshal(t, expected))
+		require.NoError(t, err)
+		require.Equal(t, expected, manifest)
+	})
+
+	t.Run("error on invalid JSON", func(t *testing.T) {
+		_, err := ParseCredentialManifest([]byte("{"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal credential manifest")
+	})
+
+	t.Run("error on invalid manifest", func(t *testing.T) {
+		_, err := ParseCredentialManifest([]byte(`{"id":"manifest"}`))
+		require.EqualError(t, err, "credential manifest issuer ID is missing")
+	})
+}
+
+func TestCredentialManifest_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(manifest *CredentialManifest)
+		err    string
+	}{
+		{
+			name:   "missing ID",
+			modify: func(manifest *CredentialManifest) { manifest.ID = "" },
+			err:    "credential manifest ID is missing",
+		},
+		{
+			name:   "missing issuer ID",
+			modify: func(manifest *CredentialManifest) { manifest.Issuer.ID = "" },
+			err:    "credential manifest issuer ID is missing",
+		},
+		{
+			name:   "no output descriptors",
+			modify: func(manifest *CredentialManifest) { manifest.OutputDescriptors = nil },
+			err:    "credential manifest has no output descriptors",
+		},
+		{
+			name:   "output descriptor without ID",
+			modify: func(manifest *CredentialManifest) { manifest.OutputDescriptors[0].ID = "" },
+			err:    "output descriptor at index 0 has no ID",
+		},
+		{
+			name: "duplicate output descriptor ID",
+			modify: func(manifest *CredentialManifest) {
+				manifest.OutputDescriptors = append(manifest.OutputDescriptors, manifest.OutputDescriptors[0])
+			},
+			err: "duplicate output descriptor ID degree",
+		},
+		{
+			name:   "output descriptor without schema",
+			modify: func(manifest *CredentialManifest) { manifest.OutputDescriptors[0].Schema = "" },
+			err:    "output descriptor degree has no schema",
+		},
+	}
+
+	require.NoError(t, newManifest(randomURI()).Validate())
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			manifest := newManifest(randomURI())
+			tc.modify(manifest)
+
+			require.EqualError(t, manifest.Validate(), tc.err)
+		})
+	}
+}
+
+func newManifest(uri string) *CredentialManifest {
+	return &CredentialManifest{
+		ID:      uuid.New().String(),
+		Version: "0.1.0",
+		Issuer: Issuer{
+			ID:   "did:example:123?linked-domains=3",
+			Name: "Washington State Government",
+		},
+		OutputDescriptors: []*OutputDescriptor{
+			{
+				ID:     "degree",
+				Schema: "https://schema.org/EducationalOccupationalCredential",
+				Name:   "University Degree",
+			},
+			{
+				ID:     "transcript",
+				Schema: "https://example.edu/transcript",
+			},
+		},
+		PresentationDefinition: &presexch.PresentationDefinitions{
+			InputDescriptors: []*presexch.InputDescriptor{{
+				ID:     "id",
+				Schema: &presexch.Schema{URI: uri},
+			}},
+		},
+	}
+}
+
+func newVC(context []string) *verifiable.Credential {
+	vc := &verifiable.Credential{
+		ID:      "http://test.credential.com/" + uuid.New().String(),
+		Context: []string{"https://www.w3.org/2018/credentials/v1"},
+		Types:   []string{"VerifiableCredential"},
+		Issuer:  verifiable.Issuer{ID: "http://test.issuer.com"},
+		Issued: &util.TimeWithTrailingZeroMsec{
+			Time: time.Now(),
+		},
+		Subject: map[string]interface{}{
+			"id": uuid.New().String(),
+		},
+	}
+
+	if context != nil {
+		vc.Context = append(vc.Context, context...)
+	}
+
+	return vc
+}
+
+func marshal(t *testing.T, v interface{}) []byte {
+	bits, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return bits
+}
+
+func randomURI() string {
+	return fmt.Sprintf("https://my.test.context.jsonld/%s", uuid.New().String())
+}
+
+func jsonldContextLoader(t *testing.T, contextURL string) *ld.CachingDocumentLoader {
+	const jsonLDContext = `{
+    "@context":{
+      "@version":1.1,
+      "@protected":true,
+      "name":"http://schema.org/name",
+      "ex":"https://example.org/examples#",
+      "xsd":"http://www.w3.org/2001/XMLSchema#"
+   }
+}`
+
+	reader, err := ld.DocumentFromReader(strings.NewReader(jsonLDContext))
+	require.NoError(t, err)
+
+	loader := verifiable.CachingJSONLDLoader()
+
+	loader.AddDocument(contextURL, reader)
+
+	return loader
+}
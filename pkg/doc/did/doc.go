@@ -7,12 +7,12 @@ package did
 
 import (
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
 	"strings"
 	"time"
@@ -29,7 +29,10 @@ import (
 
 const (
 	// Context of the DID document.
-	Context             = "https://w3id.org/did/v1"
+	Context = "https://w3id.org/did/v1"
+	// ContextV1 of the DID Core v1.0 document (https://www.w3.org/TR/did-core/).
+	ContextV1 = "https://www.w3.org/ns/did/v1"
+
 	contextV011         = "https://w3id.org/did/v0.11"
 	contextV12019       = "https://www.w3.org/2019/did/v1"
 	jsonldType          = "type"
@@ -51,15 +54,26 @@ const (
 	jsonldProofPurpose   = "proofPurpose"
 
 	// various public key encodings.
-	jsonldPublicKeyBase58 = "publicKeyBase58"
-	jsonldPublicKeyHex    = "publicKeyHex"
-	jsonldPublicKeyPem    = "publicKeyPem"
-	jsonldPublicKeyjwk    = "publicKeyJwk"
+	jsonldPublicKeyBase58    = "publicKeyBase58"
+	jsonldPublicKeyHex       = "publicKeyHex"
+	jsonldPublicKeyPem       = "publicKeyPem"
+	jsonldPublicKeyjwk       = "publicKeyJwk"
+	jsonldPublicKeyMultibase = "publicKeyMultibase"
+
+	// multibase prefix of base58btc encoding.
+	multibaseBase58BTC = "z"
 )
 
-var schemaLoaderV1 = gojsonschema.NewStringLoader(schemaV1)         //nolint:gochecknoglobals
-var schemaLoaderV011 = gojsonschema.NewStringLoader(schemaV011)     //nolint:gochecknoglobals
-var schemaLoaderV12019 = gojsonschema.NewStringLoader(schemaV12019) //nolint:gochecknoglobals
+var schemaLoaderV1 = gojsonschema.NewStringLoader(schemaV1)               //nolint:gochecknoglobals
+var schemaLoaderV011 = gojsonschema.NewStringLoader(schemaV011)           //nolint:gochecknoglobals
+var schemaLoaderV12019 = gojsonschema.NewStringLoader(schemaV12019)       //nolint:gochecknoglobals
+var schemaLoaderV1DIDCore = gojsonschema.NewStringLoader(schemaV1DIDCore) //nolint:gochecknoglobals
+
+// multicodecKeyTypes are the key types with multicodec header in the publicKeyMultibase value.
+var multicodecKeyTypes = map[string]uint64{ //nolint:gochecknoglobals
+	"Ed25519VerificationKey2020": 0xed,
+	"X25519KeyAgreementKey2020":  0xec,
+}
 
 // DID is parsed according to the generic syntax: https://w3c.github.io/did-core/#generic-did-syntax
 type DID struct {
@@ -77,8 +91,9 @@ func (d *DID) String() string {
 // See https://w3c.github.io/did-core/#generic-did-syntax.
 func Parse(did string) (*DID, error) {
 	// I could not find a good ABNF parser :(
-	const idchar = `a-zA-Z0-9-_\.`
-	regex := fmt.Sprintf(`^did:[a-z0-9]+:(:+|[:%s]+)*[%s]+$`, idchar, idchar)
+	// idchar is ALPHA / DIGIT / "." / "-" / "_" / pct-encoded, e.g. port delimiter %3A of did:web
+	const idchar = `(?:[a-zA-Z0-9\-_\.]|%[0-9a-fA-F]{2})`
+	regex := fmt.Sprintf(`^did:[a-z0-9]+:(?:%s*:)*%s+$`, idchar, idchar)
 
 	r, err := regexp.Compile(regex)
 	if err != nil {
@@ -101,9 +116,18 @@ func Parse(did string) (*DID, error) {
 }
 
 // Doc DID Document definition.
+// The document is serialized according to DID Core v1.0 if its first context is ContextV1 or if it has
+// no context (plain JSON), otherwise the legacy representation is used. Both representations are parsed.
 type Doc struct {
-	Context              []string
-	ID                   string
+	Context []string
+	// CustomContext holds the contexts of custom type (e.g. embedded JSON-LD contexts) which follow Context.
+	CustomContext []interface{} `json:",omitempty"`
+	ID            string
+	// Controller and AlsoKnownAs are omitted if empty to keep Go JSON encoding of the legacy documents
+	// (e.g. used to compute peer DIDs).
+	Controller  []string `json:",omitempty"`
+	AlsoKnownAs []string `json:",omitempty"`
+	// PublicKey holds the verification methods (verificationMethod in DID Core, publicKey in legacy documents).
 	PublicKey            []PublicKey
 	Service              []Service
 	Authentication       []VerificationMethod
@@ -127,6 +151,7 @@ type PublicKey struct {
 	Value []byte
 
 	jsonWebKey *jose.JWK
+	multibase  bool
 }
 
 // NewPublicKeyFromBytes creates a new PublicKey based on raw public key bytes.
@@ -224,6 +249,9 @@ func NewReferencedVerificationMethod(pk *PublicKey, r VerificationRelationship,
 type rawDoc struct {
 	Context              interface{}              `json:"@context,omitempty"`
 	ID                   string                   `json:"id,omitempty"`
+	Controller           interface{}              `json:"controller,omitempty"`
+	AlsoKnownAs          []string                 `json:"alsoKnownAs,omitempty"`
+	VerificationMethod   []map[string]interface{} `json:"verificationMethod,omitempty"`
 	PublicKey            []map[string]interface{} `json:"publicKey,omitempty"`
 	Service              []map[string]interface{} `json:"service,omitempty"`
 	Authentication       []interface{}            `json:"authentication,omitempty"`
@@ -257,23 +285,36 @@ func ParseDocument(data []byte) (*Doc, error) {
 	} else if raw == nil {
 		return nil, errors.New("document payload is not provided")
 	}
+
+	context, customContext, err := raw.ParseContext()
+	if err != nil {
+		return nil, err
+	}
+
 	// validate did document
 	err = validate(data, raw.schemaLoader())
 	if err != nil {
 		return nil, err
 	}
 
+	controller, err := parseController(raw.Controller)
+	if err != nil {
+		return nil, err
+	}
+
 	doc := &Doc{
-		ID:      raw.ID,
-		Created: raw.Created,
-		Updated: raw.Updated,
+		Context:       context,
+		CustomContext: customContext,
+		ID:            raw.ID,
+		Controller:    controller,
+		AlsoKnownAs:   raw.AlsoKnownAs,
+		Created:       raw.Created,
+		Updated:       raw.Updated,
 	}
 
-	context := raw.ParseContext()
-	doc.Context = context
 	doc.Service = populateServices(raw.Service)
 
-	publicKeys, err := populatePublicKeys(context[0], raw.PublicKey)
+	publicKeys, err := populatePublicKeys(doc.context(), append(raw.VerificationMethod, raw.PublicKey...))
 	if err != nil {
 		return nil, fmt.Errorf("populate public keys failed: %w", err)
 	}
@@ -285,7 +326,7 @@ func ParseDocument(data []byte) (*Doc, error) {
 		return nil, err
 	}
 
-	proofs, err := populateProofs(context[0], raw.Proof)
+	proofs, err := populateProofs(doc.context(), raw.Proof)
 	if err != nil {
 		return nil, fmt.Errorf("populate proofs failed: %w", err)
 	}
@@ -428,7 +469,7 @@ func getVerificationMethods(doc *Doc, rawVerificationMethod interface{},
 	relationship VerificationRelationship) ([]VerificationMethod, error) {
 	// context, docID string
 	pks := doc.PublicKey
-	context := doc.Context[0]
+	context := doc.context()
 
 	keyID, keyIDExist := rawVerificationMethod.(string)
 	if keyIDExist {
@@ -528,7 +569,7 @@ func populatePublicKeys(context string, rawPKs []map[string]interface{}) ([]Publ
 	return publicKeys, nil
 }
 
-func decodePK(publicKey *PublicKey, rawPK map[string]interface{}) error {
+func decodePK(publicKey *PublicKey, rawPK map[string]interface{}) error { //nolint:gocyclo
 	if stringEntry(rawPK[jsonldPublicKeyBase58]) != "" {
 		publicKey.Value = base58.Decode(stringEntry(rawPK[jsonldPublicKeyBase58]))
 		return nil
@@ -560,9 +601,48 @@ func decodePK(publicKey *PublicKey, rawPK map[string]interface{}) error {
 		return decodePublicKeyJwk(jwkMap, publicKey)
 	}
 
+	if multibaseKey := stringEntry(rawPK[jsonldPublicKeyMultibase]); strings.HasPrefix(multibaseKey, multibaseBase58BTC) {
+		return decodePublicKeyMultibase(multibaseKey, publicKey)
+	}
+
 	return errors.New("public key encoding not supported")
 }
 
+// decodePublicKeyMultibase decodes base58btc multibase key. The multicodec header is stripped
+// from the keys of multicodecKeyTypes.
+func decodePublicKeyMultibase(multibaseKey string, publicKey *PublicKey) error {
+	value := base58.Decode(strings.TrimPrefix(multibaseKey, multibaseBase58BTC))
+	if len(value) == 0 {
+		return fmt.Errorf("decode public key multibase failed: %s", multibaseKey)
+	}
+
+	if code, ok := multicodecKeyTypes[publicKey.Type]; ok {
+		c, n := binary.Uvarint(value)
+		if n <= 0 || c != code {
+			return fmt.Errorf("public key multibase of %s has not supported multicodec header", publicKey.Type)
+		}
+
+		value = value[n:]
+	}
+
+	publicKey.Value = value
+	publicKey.multibase = true
+
+	return nil
+}
+
+func encodePublicKeyMultibase(pk *PublicKey) string {
+	value := pk.Value
+
+	if code, ok := multicodecKeyTypes[pk.Type]; ok {
+		buf := make([]byte, binary.MaxVarintLen64)
+		n := binary.PutUvarint(buf, code)
+		value = append(buf[:n], value...)
+	}
+
+	return multibaseBase58BTC + base58.Encode(value)
+}
+
 func decodePublicKeyJwk(jwkMap map[string]interface{}, publicKey *PublicKey) error {
 	jwkBytes, err := json.Marshal(jwkMap)
 	if err != nil {
@@ -592,35 +672,64 @@ func decodePublicKeyJwk(jwkMap map[string]interface{}, publicKey *PublicKey) err
 	return nil
 }
 
-func (r *rawDoc) ParseContext() []string {
+// ParseContext returns the contexts of the document, the contexts of custom type (e.g. embedded JSON-LD
+// contexts) and the ones which follow them are returned separately. Plain JSON document has no contexts.
+func (r *rawDoc) ParseContext() ([]string, []interface{}, error) {
 	switch ctx := r.Context.(type) {
+	case nil:
+		return nil, nil, nil
+	case string:
+		return []string{ctx}, nil, nil
+	case []string:
+		if len(ctx) == 0 {
+			return nil, nil, errors.New("@context is empty")
+		}
+
+		return ctx, nil, nil
 	case []interface{}:
-		var context []string
-		for _, v := range ctx {
-			context = append(context, v.(string))
+		if len(ctx) == 0 {
+			return nil, nil, errors.New("@context is empty")
 		}
 
-		return context
-	case []string:
-		return ctx
-	case interface{}:
-		return []string{r.Context.(string)}
-	}
+		context := make([]string, 0, len(ctx))
+
+		for i := range ctx {
+			c, ok := ctx[i].(string)
+			if !ok {
+				// the remaining contexts are of custom type
+				return context, ctx[i:], nil
+			}
 
-	return []string{""}
+			context = append(context, c)
+		}
+
+		return context, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("@context of unknown type %T", ctx)
+	}
 }
 
 func (r *rawDoc) schemaLoader() gojsonschema.JSONLoader {
-	context := r.ParseContext()
-	if len(context) == 0 {
+	context, customContext, err := r.ParseContext()
+	if err != nil {
 		return schemaLoaderV1
 	}
 
+	if len(context) == 0 {
+		if len(customContext) > 0 {
+			return schemaLoaderV1
+		}
+
+		return schemaLoaderV1DIDCore
+	}
+
 	switch context[0] {
 	case contextV011:
 		return schemaLoaderV011
 	case contextV12019:
 		return schemaLoaderV12019
+	case ContextV1, "":
+		return schemaLoaderV1DIDCore
 	default:
 		return schemaLoaderV1
 	}
@@ -701,12 +810,44 @@ func mapEntry(entry interface{}) map[string]interface{} {
 	return result
 }
 
-// JSONBytes converts document to json bytes.
+// JSONBytes converts document to JSON-LD bytes (application/did+ld+json).
 func (doc *Doc) JSONBytes() ([]byte, error) {
-	context := Context
+	raw, err := doc.rawDoc(doc.isDIDCore())
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalRawDoc(raw)
+}
+
+// PlainJSONBytes converts document to bytes of DID Core plain JSON representation (application/did+json).
+// The plain JSON document has no @context.
+func (doc *Doc) PlainJSONBytes() ([]byte, error) {
+	raw, err := doc.rawDoc(true)
+	if err != nil {
+		return nil, err
+	}
+
+	raw.Context = nil
+
+	return marshalRawDoc(raw)
+}
 
-	if len(doc.Context) > 0 {
-		context = doc.Context[0]
+func marshalRawDoc(raw *rawDoc) ([]byte, error) {
+	byteDoc, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("JSON unmarshalling of document failed: %w", err)
+	}
+
+	return byteDoc, nil
+}
+
+// rawDoc creates raw document, the verification methods are put into verificationMethod if didCore is true
+// or into publicKey otherwise.
+func (doc *Doc) rawDoc(didCore bool) (*rawDoc, error) {
+	context := doc.context()
+	if context == "" {
+		context = Context
 	}
 
 	publicKeys, err := populateRawPublicKeys(context, doc.PublicKey)
@@ -740,9 +881,10 @@ func (doc *Doc) JSONBytes() ([]byte, error) {
 	}
 
 	raw := &rawDoc{
-		Context:              doc.Context,
+		Context:              doc.rawContext(),
 		ID:                   doc.ID,
-		PublicKey:            publicKeys,
+		Controller:           populateRawController(doc.Controller),
+		AlsoKnownAs:          doc.AlsoKnownAs,
 		Authentication:       auths,
 		AssertionMethod:      assertionMethods,
 		CapabilityDelegation: capabilityDelegations,
@@ -754,12 +896,46 @@ func (doc *Doc) JSONBytes() ([]byte, error) {
 		Updated:              doc.Updated,
 	}
 
-	byteDoc, err := json.Marshal(raw)
-	if err != nil {
-		return nil, fmt.Errorf("JSON unmarshalling of document failed: %w", err)
+	if didCore {
+		raw.VerificationMethod = publicKeys
+	} else {
+		raw.PublicKey = publicKeys
 	}
 
-	return byteDoc, nil
+	return raw, nil
+}
+
+// rawContext returns the contexts of the document, custom contexts follow the string contexts.
+func (doc *Doc) rawContext() interface{} {
+	if len(doc.CustomContext) == 0 {
+		if len(doc.Context) == 0 {
+			return nil
+		}
+
+		return doc.Context
+	}
+
+	context := make([]interface{}, 0, len(doc.Context)+len(doc.CustomContext))
+
+	for _, c := range doc.Context {
+		context = append(context, c)
+	}
+
+	return append(context, doc.CustomContext...)
+}
+
+// context returns the first context of the document, it is empty for plain JSON document.
+func (doc *Doc) context() string {
+	if len(doc.Context) == 0 {
+		return ""
+	}
+
+	return doc.Context[0]
+}
+
+// isDIDCore checks if the document is DID Core v1.0 document (JSON-LD or plain JSON).
+func (doc *Doc) isDIDCore() bool {
+	return doc.context() == ContextV1 || len(doc.Context) == 0 && len(doc.CustomContext) == 0
 }
 
 // VerifyProof verifies document proofs.
@@ -918,14 +1094,19 @@ func populateRawPublicKey(context string, pk *PublicKey) (map[string]interface{}
 		rawPK[jsonldController] = pk.Controller
 	}
 
-	if pk.jsonWebKey != nil {
+	_, multicodecKey := multicodecKeyTypes[pk.Type]
+
+	switch {
+	case pk.jsonWebKey != nil:
 		jwkBytes, err := json.Marshal(pk.jsonWebKey)
 		if err != nil {
 			return nil, err
 		}
 
 		rawPK[jsonldPublicKeyjwk] = json.RawMessage(jwkBytes)
-	} else if pk.Value != nil {
+	case pk.Value != nil && (pk.multibase || multicodecKey):
+		rawPK[jsonldPublicKeyMultibase] = encodePublicKeyMultibase(pk)
+	case pk.Value != nil:
 		rawPK[jsonldPublicKeyBase58] = base58.Encode(pk.Value)
 	}
 
@@ -955,6 +1136,42 @@ func populateRawVerificationMethods(context, didID string, vms []VerificationMet
 	return rawVerificationMethods, nil
 }
 
+func parseController(rawController interface{}) ([]string, error) {
+	switch c := rawController.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []string{c}, nil
+	case []interface{}:
+		controller := make([]string, len(c))
+
+		for i := range c {
+			s, ok := c[i].(string)
+			if !ok {
+				return nil, errors.New("controller is not array of strings")
+			}
+
+			controller[i] = s
+		}
+
+		return controller, nil
+	default:
+		return nil, errors.New("controller is not string or array of strings")
+	}
+}
+
+// populateRawController returns single controller as string and multiple controllers as array.
+func populateRawController(controller []string) interface{} {
+	switch len(controller) {
+	case 0:
+		return nil
+	case 1:
+		return controller[0]
+	default:
+		return controller
+	}
+}
+
 func populateRawProofs(context string, proofs []Proof) []interface{} {
 	rawProofs := make([]interface{}, 0, len(proofs))
 
@@ -1003,6 +1220,20 @@ func WithService(svc []Service) DocOption {
 	}
 }
 
+// WithController DID doc controllers.
+func WithController(controller ...string) DocOption {
+	return func(opts *Doc) {
+		opts.Controller = controller
+	}
+}
+
+// WithAlsoKnownAs DID doc alternative identifiers.
+func WithAlsoKnownAs(alsoKnownAs ...string) DocOption {
+	return func(opts *Doc) {
+		opts.AlsoKnownAs = alsoKnownAs
+	}
+}
+
 // WithCreatedTime DID doc created time.
 func WithCreatedTime(t time.Time) DocOption {
 	return func(opts *Doc) {
@@ -1029,20 +1260,8 @@ func BuildDoc(opts ...DocOption) *Doc {
 	return doc
 }
 
-// CachingJSONLDLoader creates JSON-LD CachingDocumentLoader with preloaded base JSON-LD DID and security contexts.
+// CachingJSONLDLoader returns JSON-LD document loader with embedded base JSON-LD DID and security contexts
+// (jsonld.DefaultDocumentLoader()).
 func CachingJSONLDLoader() ld.DocumentLoader {
-	loader := ld.NewCachingDocumentLoader(ld.NewRFC7324CachingDocumentLoader(&http.Client{}))
-
-	cacheContext := func(source, url string) {
-		reader, _ := ld.DocumentFromReader(strings.NewReader(source)) //nolint:errcheck
-		loader.AddDocument(url, reader)
-	}
-
-	cacheContext(didV011Context, "https://w3id.org/did/v0.11")
-	cacheContext(didV1Context, "https://w3id.org/did/v1")
-	cacheContext(didV1Context, "https://www.w3.org/ns/did/v1")
-	cacheContext(securityV1Context, "https://w3id.org/security/v1")
-	cacheContext(securityV2Context, "https://w3id.org/security/v2")
-
-	return loader
+	return jsonld.DefaultDocumentLoader()
 }
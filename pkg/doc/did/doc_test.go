@@ -447,12 +447,22 @@ func TestValidateDidDocContext(t *testing.T) {
 			raw.Context = nil
 			bytes, err := json.Marshal(raw)
 			require.NoError(t, err)
-			err = validate(bytes, raw.schemaLoader())
+			err = validate(bytes, schemaLoaderV1)
 			require.Error(t, err)
 			require.Contains(t, err.Error(), "@context is required")
 		}
 	})
 
+	t.Run("test plain JSON did doc without context", func(t *testing.T) {
+		raw := &rawDoc{}
+		require.NoError(t, json.Unmarshal([]byte(validDoc), &raw))
+		raw.Context = nil
+		bytes, err := json.Marshal(raw)
+		require.NoError(t, err)
+		err = validate(bytes, raw.schemaLoader())
+		require.NoError(t, err)
+	})
+
 	t.Run("test did doc with invalid context", func(t *testing.T) {
 		raw := &rawDoc{}
 		require.NoError(t, json.Unmarshal([]byte(validDoc), &raw))
@@ -1066,6 +1076,18 @@ func TestParseDID(t *testing.T) {
 		_, err := Parse("invalid:test:abcdefg123")
 		require.Error(t, err)
 	})
+	t.Run("allow pct-encoded characters in method-specific-id", func(t *testing.T) {
+		const id = "example.com%3A8443:user:alice%20b"
+		did, err := Parse("did:web:" + id)
+		require.NoError(t, err)
+		require.Equal(t, id, did.MethodSpecificID)
+	})
+	t.Run("disallow invalid pct-encoding in method-specific-id", func(t *testing.T) {
+		for _, id := range []string{"example.com%3", "example.com%zz", "example%", "abc=="} {
+			_, err := Parse("did:web:" + id)
+			require.Error(t, err, id)
+		}
+	})
 }
 
 func Test_DID_String(t *testing.T) {
@@ -1638,3 +1660,243 @@ const docV011WithVerificationRelationships = `{
 		"publicKeyBase58": "B12NYF8RrR3h41TDCTJojY59usg3mbtbjnFs7Eud1Y6u"
 	}]
 }`
+
+//nolint:lll
+const validDocV1DIDCore = `{
+  "@context": ["https://www.w3.org/ns/did/v1"],
+  "id": "did:example:123456789abcdefghi",
+  "controller": ["did:example:123456789abcdefghi", "did:example:controller"],
+  "alsoKnownAs": ["https://example.com/alice"],
+  "verificationMethod": [
+    {
+      "id": "did:example:123456789abcdefghi#key-1",
+      "type": "Ed25519VerificationKey2020",
+      "controller": "did:example:123456789abcdefghi",
+      "publicKeyMultibase": "z6MkeTGwHmLmuCmgg4ABYhzWVh6ZX7hTwWt8gguAretUfc9c"
+    },
+    {
+      "id": "did:example:123456789abcdefghi#key-2",
+      "type": "Ed25519VerificationKey2018",
+      "controller": "did:example:123456789abcdefghi",
+      "publicKeyBase58": "H3C2AVvLMv6gmMNam3uVAjZpfkcJCwDwnZn6z3wXmqPV"
+    }
+  ],
+  "authentication": ["did:example:123456789abcdefghi#key-1"],
+  "assertionMethod": ["#key-2"],
+  "capabilityInvocation": ["did:example:123456789abcdefghi#key-1"],
+  "capabilityDelegation": ["did:example:123456789abcdefghi#key-1"],
+  "keyAgreement": [
+    {
+      "id": "did:example:123456789abcdefghi#key-3",
+      "type": "X25519KeyAgreementKey2020",
+      "controller": "did:example:123456789abcdefghi",
+      "publicKeyMultibase": "z6LSdqbWoToXafWD7qhVMLz2HCTTMmhAnGnAki2vP11ANRTc"
+    }
+  ],
+  "service": [
+    {
+      "id": "did:example:123456789abcdefghi#agent",
+      "type": "DIDCommMessaging",
+      "serviceEndpoint": "https://agent.example.com/"
+    }
+  ]
+}`
+
+func TestDIDCoreDocument(t *testing.T) {
+	keyBytes := func(offset byte) []byte {
+		b := make([]byte, 32)
+		for i := range b {
+			b[i] = offset + byte(i)
+		}
+
+		return b
+	}
+
+	t.Run("test parse", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(validDocV1DIDCore))
+		require.NoError(t, err)
+
+		require.Equal(t, []string{ContextV1}, doc.Context)
+		require.Equal(t, []string{"did:example:123456789abcdefghi", "did:example:controller"}, doc.Controller)
+		require.Equal(t, []string{"https://example.com/alice"}, doc.AlsoKnownAs)
+
+		require.Len(t, doc.PublicKey, 2)
+		require.Equal(t, "Ed25519VerificationKey2020", doc.PublicKey[0].Type)
+		require.Equal(t, keyBytes(0), doc.PublicKey[0].Value)
+
+		require.Equal(t, doc.PublicKey[0].ID, doc.Authentication[0].PublicKey.ID)
+		require.Equal(t, doc.PublicKey[1].ID, doc.AssertionMethod[0].PublicKey.ID)
+		require.True(t, doc.AssertionMethod[0].RelativeURL)
+		require.Equal(t, doc.PublicKey[0].ID, doc.CapabilityInvocation[0].PublicKey.ID)
+		require.Equal(t, doc.PublicKey[0].ID, doc.CapabilityDelegation[0].PublicKey.ID)
+
+		require.True(t, doc.KeyAgreement[0].Embedded)
+		require.Equal(t, keyBytes(32), doc.KeyAgreement[0].PublicKey.Value)
+	})
+
+	t.Run("test JSON-LD representation", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(validDocV1DIDCore))
+		require.NoError(t, err)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		raw := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(docBytes, &raw))
+		require.Contains(t, raw, "@context")
+		require.Contains(t, raw, "verificationMethod")
+		require.NotContains(t, raw, "publicKey")
+		require.Equal(t, []interface{}{"did:example:123456789abcdefghi", "did:example:controller"}, raw["controller"])
+
+		vm := raw["verificationMethod"].([]interface{})[0].(map[string]interface{})
+		require.Equal(t, "z6MkeTGwHmLmuCmgg4ABYhzWVh6ZX7hTwWt8gguAretUfc9c", vm[jsonldPublicKeyMultibase])
+
+		doc2, err := ParseDocument(docBytes)
+		require.NoError(t, err)
+		require.Equal(t, doc, doc2)
+	})
+
+	t.Run("test plain JSON representation", func(t *testing.T) {
+		for _, d := range []string{validDocV1DIDCore, validDoc} {
+			doc, err := ParseDocument([]byte(d))
+			require.NoError(t, err)
+
+			docBytes, err := doc.PlainJSONBytes()
+			require.NoError(t, err)
+
+			raw := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(docBytes, &raw))
+			require.NotContains(t, raw, "@context")
+			require.Contains(t, raw, "verificationMethod")
+			require.NotContains(t, raw, "publicKey")
+
+			doc2, err := ParseDocument(docBytes)
+			require.NoError(t, err)
+			require.Nil(t, doc2.Context)
+
+			doc2.Context = doc.Context
+			require.Equal(t, doc, doc2)
+		}
+	})
+
+	t.Run("test plain JSON document", func(t *testing.T) {
+		plainDoc, err := ParseDocument([]byte(validDocV1DIDCore))
+		require.NoError(t, err)
+
+		plainBytes, err := plainDoc.PlainJSONBytes()
+		require.NoError(t, err)
+
+		doc, err := ParseDocument(plainBytes)
+		require.NoError(t, err)
+		require.Nil(t, doc.Context)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+		require.JSONEq(t, string(plainBytes), string(docBytes))
+	})
+
+	t.Run("test context of custom type", func(t *testing.T) {
+		raw := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(validDocV1DIDCore), &raw))
+
+		embedded := map[string]interface{}{"@vocab": "https://example.com/vocab#"}
+		raw["@context"] = []interface{}{ContextV1, embedded, "https://example.com/context"}
+
+		rawBytes, err := json.Marshal(raw)
+		require.NoError(t, err)
+
+		doc, err := ParseDocument(rawBytes)
+		require.NoError(t, err)
+		require.Equal(t, []string{ContextV1}, doc.Context)
+		require.Equal(t, []interface{}{embedded, "https://example.com/context"}, doc.CustomContext)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		raw2 := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(docBytes, &raw2))
+		require.Equal(t, raw["@context"], raw2["@context"])
+		require.Contains(t, raw2, "verificationMethod")
+	})
+
+	t.Run("test invalid context", func(t *testing.T) {
+		raw := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(validDocV1DIDCore), &raw))
+
+		for _, context := range []interface{}{[]interface{}{}, 1} {
+			raw["@context"] = context
+
+			rawBytes, err := json.Marshal(raw)
+			require.NoError(t, err)
+
+			doc, err := ParseDocument(rawBytes)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "@context")
+			require.Nil(t, doc)
+		}
+	})
+
+	t.Run("test single controller", func(t *testing.T) {
+		doc := BuildDoc(WithController("did:example:controller"), WithAlsoKnownAs("did:example:other"))
+		doc.Context = []string{ContextV1}
+		doc.ID = "did:example:123"
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+		require.Contains(t, string(docBytes), `"controller":"did:example:controller"`)
+		require.Contains(t, string(docBytes), `"alsoKnownAs":["did:example:other"]`)
+
+		doc2, err := ParseDocument(docBytes)
+		require.NoError(t, err)
+		require.Equal(t, doc.Controller, doc2.Controller)
+		require.Equal(t, doc.AlsoKnownAs, doc2.AlsoKnownAs)
+	})
+
+	t.Run("test legacy document keeps publicKey", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(validDoc))
+		require.NoError(t, err)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		raw := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(docBytes, &raw))
+		require.Contains(t, raw, "publicKey")
+		require.NotContains(t, raw, "verificationMethod")
+	})
+
+	t.Run("test invalid controller", func(t *testing.T) {
+		raw := &rawDoc{}
+		require.NoError(t, json.Unmarshal([]byte(validDoc), &raw))
+
+		for _, controller := range []interface{}{5, []interface{}{"did:example:123", 5}} {
+			raw.Controller = controller
+			docBytes, err := json.Marshal(raw)
+			require.NoError(t, err)
+
+			_, err = ParseDocument(docBytes)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "controller is not")
+		}
+	})
+
+	t.Run("test invalid public key multibase", func(t *testing.T) {
+		tests := map[string]string{
+			"z":         "decode public key multibase failed",
+			"z2VfUX":    "public key multibase of Ed25519VerificationKey2020 has not supported multicodec header",
+			"zinvalid0": "decode public key multibase failed",
+		}
+
+		for multibaseKey, errMsg := range tests {
+			raw := &rawDoc{}
+			require.NoError(t, json.Unmarshal([]byte(validDocV1DIDCore), &raw))
+			raw.VerificationMethod[0][jsonldPublicKeyMultibase] = multibaseKey
+			docBytes, err := json.Marshal(raw)
+			require.NoError(t, err)
+
+			_, err = ParseDocument(docBytes)
+			require.Error(t, err, multibaseKey)
+			require.Contains(t, err.Error(), errMsg, multibaseKey)
+		}
+	})
+}
@@ -0,0 +1,244 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package did
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ResolutionContext is the JSON-LD context of the DID resolution result.
+	ResolutionContext = "https://w3id.org/did-resolution/v1"
+
+	// ContentTypeDIDLDJSON is the content type of the JSON-LD DID document.
+	ContentTypeDIDLDJSON = "application/did+ld+json"
+
+	// ContentTypeDIDJSON is the content type of the plain JSON DID document.
+	ContentTypeDIDJSON = "application/did+json"
+
+	// ContentTypeDIDResolution is the content type of the DID resolution result.
+	ContentTypeDIDResolution = `application/ld+json;profile="https://w3id.org/did-resolution"`
+)
+
+// DID resolution error codes (https://w3c-ccg.github.io/did-resolution/#errors).
+const (
+	// ResolutionErrorInvalidDID is returned when the DID is not valid.
+	ResolutionErrorInvalidDID = "invalidDid"
+	// ResolutionErrorNotFound is returned when the DID document was not found.
+	ResolutionErrorNotFound = "notFound"
+	// ResolutionErrorMethodNotSupported is returned when the DID method is not supported.
+	ResolutionErrorMethodNotSupported = "methodNotSupported"
+	// ResolutionErrorInternal is returned on unexpected resolution errors.
+	ResolutionErrorInternal = "internalError"
+	// ResolutionErrorInvalidDIDURL is returned when the DID URL being dereferenced is not valid.
+	ResolutionErrorInvalidDIDURL = "invalidDidUrl"
+	// ResolutionErrorRepresentationNotSupported is returned when the requested representation of
+	// the DID document is not supported.
+	ResolutionErrorRepresentationNotSupported = "representationNotSupported"
+)
+
+// DocResolution is the DID resolution result (https://w3c-ccg.github.io/did-resolution/#did-resolution-result).
+type DocResolution struct {
+	Context            []string
+	DIDDocument        *Doc
+	ResolutionMetadata *ResolutionMetadata
+	DocumentMetadata   *DocumentMetadata
+}
+
+// ResolutionMetadata is the metadata of the DID resolution process.
+type ResolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+	// Message is a human readable description of the error.
+	Message string `json:"message,omitempty"`
+}
+
+// DocumentMetadata is the metadata of the resolved DID document.
+type DocumentMetadata struct {
+	Created     *time.Time `json:"created,omitempty"`
+	Updated     *time.Time `json:"updated,omitempty"`
+	Deactivated bool       `json:"deactivated,omitempty"`
+	VersionID   string     `json:"versionId,omitempty"`
+	// Method holds DID method specific metadata.
+	Method map[string]interface{} `json:"method,omitempty"`
+}
+
+type rawDocResolution struct {
+	Context            interface{}            `json:"@context,omitempty"`
+	DIDDocument        json.RawMessage        `json:"didDocument,omitempty"`
+	ResolutionMetadata *ResolutionMetadata    `json:"didResolutionMetadata,omitempty"`
+	DocumentMetadata   *DocumentMetadata      `json:"didDocumentMetadata,omitempty"`
+	ResolverMetadata   map[string]interface{} `json:"resolverMetadata,omitempty"`
+	MethodMetadata     map[string]interface{} `json:"methodMetadata,omitempty"`
+}
+
+// NewDocResolution returns DID resolution result of the given DID document. Created and updated
+// times of the document metadata are taken from the document.
+func NewDocResolution(doc *Doc) *DocResolution {
+	return &DocResolution{
+		Context:            []string{ResolutionContext},
+		DIDDocument:        doc,
+		ResolutionMetadata: &ResolutionMetadata{ContentType: ContentTypeDIDLDJSON},
+		DocumentMetadata:   &DocumentMetadata{Created: doc.Created, Updated: doc.Updated},
+	}
+}
+
+// NewResolutionError returns DID resolution result holding the error code.
+func NewResolutionError(code string, err error) *DocResolution {
+	metadata := &ResolutionMetadata{Error: code}
+	if err != nil {
+		metadata.Message = err.Error()
+	}
+
+	return &DocResolution{
+		Context:            []string{ResolutionContext},
+		ResolutionMetadata: metadata,
+		DocumentMetadata:   &DocumentMetadata{},
+	}
+}
+
+// ParseDocumentResolution parses DID resolution result. Besides the DID resolution result, a plain DID document
+// and the legacy Universal Resolver response (with resolverMetadata and methodMetadata) are accepted.
+func ParseDocumentResolution(data []byte) (*DocResolution, error) {
+	var raw rawDocResolution
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("unmarshal DID resolution result: %w", err)
+	}
+
+	if len(raw.DIDDocument) == 0 || string(raw.DIDDocument) == "null" {
+		if raw.ResolutionMetadata != nil && raw.ResolutionMetadata.Error != "" {
+			return &DocResolution{
+				Context:            resolutionContext(raw.Context),
+				ResolutionMetadata: raw.ResolutionMetadata,
+				DocumentMetadata:   documentMetadata(&raw),
+			}, nil
+		}
+
+		// not a resolution result, data is expected to be a DID document
+		doc, err := ParseDocument(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewDocResolution(doc), nil
+	}
+
+	doc, err := ParseDocument(raw.DIDDocument)
+	if err != nil {
+		return nil, fmt.Errorf("parse DID document of resolution result: %w", err)
+	}
+
+	resolution := &DocResolution{
+		Context:            resolutionContext(raw.Context),
+		DIDDocument:        doc,
+		ResolutionMetadata: raw.ResolutionMetadata,
+		DocumentMetadata:   documentMetadata(&raw),
+	}
+
+	if resolution.ResolutionMetadata == nil {
+		resolution.ResolutionMetadata = &ResolutionMetadata{ContentType: ContentTypeDIDLDJSON}
+	}
+
+	if resolution.DocumentMetadata.Created == nil {
+		resolution.DocumentMetadata.Created = doc.Created
+	}
+
+	if resolution.DocumentMetadata.Updated == nil {
+		resolution.DocumentMetadata.Updated = doc.Updated
+	}
+
+	return resolution, nil
+}
+
+// documentMetadata returns document metadata of the resolution result. Legacy method metadata
+// is kept as method specific metadata, its standard properties are promoted to the document metadata.
+func documentMetadata(raw *rawDocResolution) *DocumentMetadata {
+	metadata := raw.DocumentMetadata
+	if metadata == nil {
+		metadata = &DocumentMetadata{}
+	}
+
+	if len(raw.MethodMetadata) == 0 {
+		return metadata
+	}
+
+	if metadata.Method == nil {
+		metadata.Method = raw.MethodMetadata
+	}
+
+	if deactivated, ok := raw.MethodMetadata["deactivated"].(bool); ok {
+		metadata.Deactivated = metadata.Deactivated || deactivated
+	}
+
+	if versionID, ok := raw.MethodMetadata["versionId"].(string); ok && metadata.VersionID == "" {
+		metadata.VersionID = versionID
+	}
+
+	return metadata
+}
+
+func resolutionContext(context interface{}) []string {
+	if ctx, ok := context.(string); ok {
+		return []string{ctx}
+	}
+
+	return stringArray(context)
+}
+
+// JSONBytes converts DID resolution result to JSON.
+func (r *DocResolution) JSONBytes() ([]byte, error) {
+	raw := &rawDocResolution{
+		ResolutionMetadata: r.ResolutionMetadata,
+		DocumentMetadata:   r.DocumentMetadata,
+	}
+
+	if len(r.Context) == 1 {
+		raw.Context = r.Context[0]
+	} else if len(r.Context) > 1 {
+		raw.Context = r.Context
+	}
+
+	if r.DIDDocument != nil {
+		docBytes, err := r.DIDDocument.JSONBytes()
+		if err != nil {
+			return nil, fmt.Errorf("marshal DID document of resolution result: %w", err)
+		}
+
+		raw.DIDDocument = docBytes
+	}
+
+	if raw.ResolutionMetadata == nil {
+		raw.ResolutionMetadata = &ResolutionMetadata{}
+	}
+
+	if raw.DocumentMetadata == nil {
+		raw.DocumentMetadata = &DocumentMetadata{}
+	}
+
+	return json.Marshal(raw)
+}
+
+// Error returns the error of the resolution result or nil if DID was resolved successfully.
+func (r *DocResolution) Error() error {
+	if r.ResolutionMetadata == nil || r.ResolutionMetadata.Error == "" {
+		if r.DIDDocument == nil {
+			return errors.New("DID document is missing in the resolution result")
+		}
+
+		return nil
+	}
+
+	if r.ResolutionMetadata.Message != "" {
+		return fmt.Errorf("%s: %s", r.ResolutionMetadata.Error, r.ResolutionMetadata.Message)
+	}
+
+	return errors.New(r.ResolutionMetadata.Error)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package did
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDocResolution(t *testing.T) {
+	doc, err := ParseDocument([]byte(validDoc))
+	require.NoError(t, err)
+
+	resolution := NewDocResolution(doc)
+	require.Equal(t, []string{ResolutionContext}, resolution.Context)
+	require.Equal(t, doc, resolution.DIDDocument)
+	require.Equal(t, ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+	require.Equal(t, doc.Created, resolution.DocumentMetadata.Created)
+	require.Equal(t, doc.Updated, resolution.DocumentMetadata.Updated)
+	require.NoError(t, resolution.Error())
+}
+
+func TestNewResolutionError(t *testing.T) {
+	resolution := NewResolutionError(ResolutionErrorNotFound, errors.New("no such DID"))
+	require.Nil(t, resolution.DIDDocument)
+	require.Equal(t, ResolutionErrorNotFound, resolution.ResolutionMetadata.Error)
+	require.EqualError(t, resolution.Error(), "notFound: no such DID")
+
+	resolution = NewResolutionError(ResolutionErrorInvalidDID, nil)
+	require.EqualError(t, resolution.Error(), ResolutionErrorInvalidDID)
+
+	require.Error(t, (&DocResolution{}).Error())
+}
+
+func TestParseDocumentResolution(t *testing.T) {
+	t.Run("resolution result", func(t *testing.T) {
+		doc, err := ParseDocument([]byte(validDoc))
+		require.NoError(t, err)
+
+		created := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		resolution := NewDocResolution(doc)
+		resolution.DocumentMetadata = &DocumentMetadata{
+			Created:     &created,
+			Deactivated: true,
+			VersionID:   "3",
+			Method:      map[string]interface{}{"published": true},
+		}
+
+		resolutionBytes, err := resolution.JSONBytes()
+		require.NoError(t, err)
+
+		parsed, err := ParseDocumentResolution(resolutionBytes)
+		require.NoError(t, err)
+		require.Equal(t, resolution.Context, parsed.Context)
+		require.Equal(t, doc.ID, parsed.DIDDocument.ID)
+		require.Equal(t, ContentTypeDIDLDJSON, parsed.ResolutionMetadata.ContentType)
+		require.Equal(t, created, parsed.DocumentMetadata.Created.UTC())
+		require.Equal(t, doc.Updated, parsed.DocumentMetadata.Updated)
+		require.True(t, parsed.DocumentMetadata.Deactivated)
+		require.Equal(t, "3", parsed.DocumentMetadata.VersionID)
+		require.Equal(t, true, parsed.DocumentMetadata.Method["published"])
+	})
+
+	t.Run("plain DID document", func(t *testing.T) {
+		resolution, err := ParseDocumentResolution([]byte(validDoc))
+		require.NoError(t, err)
+		require.Equal(t, "did:example:21tDAKCERh95uGgKbJNHYp", resolution.DIDDocument.ID)
+		require.Equal(t, ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.NotNil(t, resolution.DocumentMetadata.Created)
+	})
+
+	t.Run("legacy resolution result", func(t *testing.T) {
+		resolution, err := ParseDocumentResolution([]byte(`{
+			"@context": "https://www.w3.org/ns/did-resolution/v1",
+			"didDocument": ` + validDoc + `,
+			"resolverMetadata": {"driverId": "did:example"},
+			"methodMetadata": {"deactivated": true, "versionId": "7", "operations": 2}
+		}`))
+		require.NoError(t, err)
+		require.Equal(t, []string{"https://www.w3.org/ns/did-resolution/v1"}, resolution.Context)
+		require.Equal(t, ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+		require.Equal(t, "7", resolution.DocumentMetadata.VersionID)
+		require.Equal(t, float64(2), resolution.DocumentMetadata.Method["operations"])
+	})
+
+	t.Run("resolution error", func(t *testing.T) {
+		resolution, err := ParseDocumentResolution([]byte(`{
+			"didDocument": null,
+			"didResolutionMetadata": {"error": "notFound"}
+		}`))
+		require.NoError(t, err)
+		require.Nil(t, resolution.DIDDocument)
+		require.EqualError(t, resolution.Error(), ResolutionErrorNotFound)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		_, err := ParseDocumentResolution([]byte("{"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal DID resolution result")
+	})
+
+	t.Run("invalid DID document", func(t *testing.T) {
+		_, err := ParseDocumentResolution([]byte(`{"didDocument": {"id": 1}}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse DID document of resolution result")
+
+		_, err = ParseDocumentResolution([]byte(`{"id": 1}`))
+		require.Error(t, err)
+	})
+}
+
+func TestDocResolution_JSONBytes(t *testing.T) {
+	resolutionBytes, err := NewResolutionError(ResolutionErrorInvalidDID, nil).JSONBytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"@context": "https://w3id.org/did-resolution/v1",
+		"didResolutionMetadata": {"error": "invalidDid"},
+		"didDocumentMetadata": {}
+	}`, string(resolutionBytes))
+
+	resolutionBytes, err = (&DocResolution{Context: []string{"ctx1", "ctx2"}}).JSONBytes()
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"@context": ["ctx1", "ctx2"],
+		"didResolutionMetadata": {},
+		"didDocumentMetadata": {}
+	}`, string(resolutionBytes))
+}
@@ -22,8 +22,15 @@ const (
         }
       ],
       "additionalItems": {
-        "type": "string",
-        "format": "uri"
+        "anyOf": [
+          {
+            "type": "string",
+            "format": "uri"
+          },
+          {
+            "type": "object"
+          }
+        ]
       }
     },
     "id": {
@@ -152,8 +159,15 @@ const (
         }
       ],
       "additionalItems": {
-        "type": "string",
-        "format": "uri"
+        "anyOf": [
+          {
+            "type": "string",
+            "format": "uri"
+          },
+          {
+            "type": "object"
+          }
+        ]
       }
     },
     "id": {
@@ -300,8 +314,15 @@ const (
         }
       ],
       "additionalItems": {
-        "type": "string",
-        "format": "uri"
+        "anyOf": [
+          {
+            "type": "string",
+            "format": "uri"
+          },
+          {
+            "type": "object"
+          }
+        ]
       }
     },
     "id": {
@@ -404,4 +425,182 @@ const (
     }
   }
 }`
+
+	// schemaV1DIDCore is the schema of DID Core v1.0 document, the @context is optional for plain JSON documents.
+	schemaV1DIDCore = `{
+  "required": [
+    "id"
+  ],
+  "properties": {
+    "@context": {
+      "type": ["array","string"],
+      "items": [
+        {
+          "type": "string",
+          "pattern": "^https://(w3id.org|www.w3.org/ns)/did/v1$"
+        }
+      ],
+      "additionalItems": {
+        "anyOf": [
+          {
+            "type": "string",
+            "format": "uri"
+          },
+          {
+            "type": "object"
+          }
+        ]
+      }
+    },
+    "id": {
+      "type": "string"
+    },
+    "controller": {
+      "oneOf": [
+        {
+          "type": "string"
+        },
+        {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      ]
+    },
+    "alsoKnownAs": {
+      "type": "array",
+      "items": {
+        "type": "string",
+        "format": "uri"
+      }
+    },
+    "verificationMethod": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verificationMethod"
+      }
+    },
+    "publicKey": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/verificationMethod"
+      }
+    },
+    "authentication": {
+      "$ref": "#/definitions/verificationRelationship"
+    },
+    "assertionMethod": {
+      "$ref": "#/definitions/verificationRelationship"
+    },
+    "keyAgreement": {
+      "$ref": "#/definitions/verificationRelationship"
+    },
+    "capabilityInvocation": {
+      "$ref": "#/definitions/verificationRelationship"
+    },
+    "capabilityDelegation": {
+      "$ref": "#/definitions/verificationRelationship"
+    },
+    "service": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/service"
+      }
+    },
+    "created": {
+      "type": "string"
+    },
+    "updated": {
+      "type": "string"
+    },
+    "proof": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/proof"
+      }
+    }
+  },
+  "definitions": {
+    "proof": {
+      "type": "object",
+      "required": [ "type", "creator", "created", "proofValue"],
+      "properties": {
+        "type": {
+          "type": "string",
+          "format": "uri-reference"
+        },
+        "creator": {
+          "type": "string",
+          "format": "uri-reference"
+        },
+        "created": {
+          "type": "string"
+        },
+        "proofValue": {
+          "type": "string"
+        },
+        "domain": {
+          "type": "string"
+        },
+        "nonce": {
+          "type": "string"
+        }
+      }
+    },
+    "verificationMethod": {
+      "required": [
+        "id",
+        "type",
+        "controller"
+      ],
+      "type": "object",
+      "minProperties": 4,
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "controller": {
+          "type": "string"
+        }
+      }
+    },
+    "verificationRelationship": {
+      "type": "array",
+      "items": {
+        "oneOf": [
+          {
+            "$ref": "#/definitions/verificationMethod"
+          },
+          {
+            "type": "string"
+          }
+        ]
+      }
+    },
+    "service": {
+      "required": [
+        "id",
+        "type",
+        "serviceEndpoint"
+      ],
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "serviceEndpoint": {
+          "type": "string",
+          "format": "uri"
+        }
+      }
+    }
+  }
+}`
 )
@@ -0,0 +1,58 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package did
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// DIDURL is the parsed DID URL (https://w3c.github.io/did-core/#did-url-syntax).
+type DIDURL struct {
+	DID
+	Path     string
+	Queries  url.Values
+	Fragment string
+}
+
+// ParseDIDURL parses the string according to the DID URL syntax, e.g.
+// did:example:123/path?service=agent&relativeRef=/inbox#key-1.
+func ParseDIDURL(didURL string) (*DIDURL, error) {
+	rest, fragment := splitOnce(didURL, "#")
+	rest, query := splitOnce(rest, "?")
+
+	didID, path := rest, ""
+	if i := strings.Index(rest, "/"); i >= 0 {
+		didID, path = rest[:i], rest[i:]
+	}
+
+	d, err := Parse(didID)
+	if err != nil {
+		return nil, err
+	}
+
+	queries, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid DID URL query: %w", err)
+	}
+
+	return &DIDURL{
+		DID:      *d,
+		Path:     path,
+		Queries:  queries,
+		Fragment: fragment,
+	}, nil
+}
+
+func splitOnce(s, sep string) (string, string) {
+	if i := strings.Index(s, sep); i >= 0 {
+		return s[:i], s[i+1:]
+	}
+
+	return s, ""
+}
@@ -0,0 +1,56 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package did
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDIDURL(t *testing.T) {
+	t.Run("test DID", func(t *testing.T) {
+		didURL, err := ParseDIDURL("did:example:123")
+		require.NoError(t, err)
+		require.Equal(t, "did:example:123", didURL.DID.String())
+		require.Empty(t, didURL.Path)
+		require.Empty(t, didURL.Queries)
+		require.Empty(t, didURL.Fragment)
+	})
+
+	t.Run("test fragment", func(t *testing.T) {
+		didURL, err := ParseDIDURL("did:example:123#key-1")
+		require.NoError(t, err)
+		require.Equal(t, "did:example:123", didURL.DID.String())
+		require.Equal(t, "key-1", didURL.Fragment)
+	})
+
+	t.Run("test path, queries and fragment", func(t *testing.T) {
+		didURL, err := ParseDIDURL("did:example:123/path/to?service=agent&relativeRef=%2Finbox%3Fa%3Db#frag")
+		require.NoError(t, err)
+		require.Equal(t, "example", didURL.Method)
+		require.Equal(t, "123", didURL.MethodSpecificID)
+		require.Equal(t, "/path/to", didURL.Path)
+		require.Equal(t, "agent", didURL.Queries.Get("service"))
+		require.Equal(t, "/inbox?a=b", didURL.Queries.Get("relativeRef"))
+		require.Equal(t, "frag", didURL.Fragment)
+
+		didURL, err = ParseDIDURL("did:example:123?versionId=2")
+		require.NoError(t, err)
+		require.Equal(t, "2", didURL.Queries.Get("versionId"))
+	})
+
+	t.Run("test invalid DID URL", func(t *testing.T) {
+		_, err := ParseDIDURL("did:example#key-1")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid did")
+
+		_, err = ParseDIDURL("did:example:123?service=%zz")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid DID URL query")
+	})
+}
@@ -18,6 +18,8 @@ import (
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri"
 )
 
 const (
@@ -45,6 +47,36 @@ func (k KeyResolverFunc) Resolve(what, kid string) (*verifier.PublicKey, error)
 	return k(what, kid)
 }
 
+// DIDKeyResolver resolves public key of the issuer DID using vdri.Registry. The key ID ("kid" JOSE header)
+// references the verification method of the DID document as DID URL.
+type DIDKeyResolver struct {
+	registry vdriapi.Registry
+}
+
+// NewDIDKeyResolver creates DIDKeyResolver.
+func NewDIDKeyResolver(registry vdriapi.Registry) *DIDKeyResolver {
+	return &DIDKeyResolver{registry: registry}
+}
+
+// Resolve resolves public key of the issuer DID by dereferencing the key ID. The key ID is either DID URL
+// of the issuer DID, relative DID URL or fragment.
+func (r *DIDKeyResolver) Resolve(issuerDID, keyID string) (*verifier.PublicKey, error) {
+	pk, err := vdri.NewDereferencer(r.registry).DereferenceKey(issuerDID, keyID)
+	if err != nil {
+		if errors.Is(err, vdri.ErrContentNotFound) {
+			return nil, fmt.Errorf("public key with KID %s is not found for DID %s", keyID, issuerDID)
+		}
+
+		return nil, fmt.Errorf("resolve DID %s: %w", issuerDID, err)
+	}
+
+	return &verifier.PublicKey{
+		Type:  pk.Type,
+		Value: pk.Value,
+		JWK:   pk.JSONWebKey(),
+	}, nil
+}
+
 // BasicVerifier defines basic Signed JWT verifier based on Issuer Claim and Key ID JOSE Header.
 type BasicVerifier struct {
 	resolver          KeyResolver
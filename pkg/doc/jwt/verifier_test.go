@@ -18,9 +18,12 @@ import (
 	"github.com/square/go-jose/v3/json"
 	"github.com/stretchr/testify/require"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
 )
 
 func getTestKeyResolver(pubKey *verifier.PublicKey, err error) KeyResolver {
@@ -182,3 +185,64 @@ func TestVerifyRS256(t *testing.T) {
 	}, []byte("test message"), signature)
 	r.Error(err)
 }
+
+func TestDIDKeyResolver_Resolve(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	doc := &did.Doc{
+		ID:        "did:example:123",
+		PublicKey: []did.PublicKey{*did.NewPublicKeyFromBytes("did:example:123#key-1", "Ed25519VerificationKey2018", "did:example:123", pubKey)}, //nolint:lll
+	}
+
+	registry := &mockvdri.MockVDRIRegistry{ResolveValue: doc}
+	resolver := NewDIDKeyResolver(registry)
+
+	for _, kid := range []string{"did:example:123#key-1", "#key-1", "key-1"} {
+		key, err := resolver.Resolve(doc.ID, kid)
+		require.NoError(t, err)
+		require.Equal(t, "Ed25519VerificationKey2018", key.Type)
+		require.Equal(t, []byte(pubKey), key.Value)
+	}
+
+	// verify JWT signed by the DID key
+	_, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	doc.PublicKey[0].Value = privKey.Public().(ed25519.PublicKey)
+
+	token, err := NewSigned(&Claims{Issuer: doc.ID}, jose.Headers{jose.HeaderKeyID: "key-1"},
+		newEd25519Signer(privKey))
+	require.NoError(t, err)
+
+	jws, err := token.Serialize(false)
+	require.NoError(t, err)
+
+	_, err = Parse(jws, WithSignatureVerifier(NewVerifier(resolver)))
+	require.NoError(t, err)
+
+	key, err := resolver.Resolve(doc.ID, "key-2")
+	require.EqualError(t, err, "public key with KID key-2 is not found for DID did:example:123")
+	require.Nil(t, key)
+
+	key, err = resolver.Resolve(doc.ID, "did:example:other#key-1")
+	require.EqualError(t, err, "public key with KID did:example:other#key-1 is not found for DID did:example:123")
+	require.Nil(t, key)
+
+	registry.ResolveErr = errors.New("resolver error")
+
+	key, err = resolver.Resolve(doc.ID, "key-1")
+	require.EqualError(t, err, "resolve DID did:example:123: resolver error")
+	require.Nil(t, key)
+
+	registry.ResolveWithMetadataFunc = func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+		return &did.DocResolution{DIDDocument: doc, DocumentMetadata: &did.DocumentMetadata{Deactivated: true}}, nil
+	}
+
+	key, err = resolver.Resolve(doc.ID, "key-1")
+	require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+	require.Nil(t, key)
+
+	_, err = Parse(jws, WithSignatureVerifier(NewVerifier(resolver)))
+	require.Error(t, err)
+}
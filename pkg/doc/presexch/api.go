@@ -30,15 +30,18 @@ const (
 
 // PresentationDefinitions presentation definitions (https://identity.foundation/presentation-exchange/).
 type PresentationDefinitions struct {
-	Name             string             `json:"name"`
-	Purpose          string             `json:"purpose"`
-	InputDescriptors []*InputDescriptor `json:"input_descriptors,omitempty"`
+	Name                   string                   `json:"name"`
+	Purpose                string                   `json:"purpose"`
+	SubmissionRequirements []*SubmissionRequirement `json:"submission_requirements,omitempty"`
+	InputDescriptors       []*InputDescriptor       `json:"input_descriptors,omitempty"`
 }
 
 // InputDescriptor input descriptors.
 type InputDescriptor struct {
-	ID     string  `json:"id,omitempty"`
-	Schema *Schema `json:"schema,omitempty"`
+	ID          string       `json:"id,omitempty"`
+	Group       []string     `json:"group,omitempty"`
+	Schema      *Schema      `json:"schema,omitempty"`
+	Constraints *Constraints `json:"constraints,omitempty"`
 }
 
 // Schema input descriptor schema.
@@ -69,6 +72,7 @@ type MatchOptions struct {
 type MatchOption func(*MatchOptions)
 
 // WithJSONLDDocumentLoader sets the loader to use when parsing the embedded verifiable credentials.
+// If not set, jsonld.DefaultDocumentLoader() is used.
 func WithJSONLDDocumentLoader(l ld.DocumentLoader) MatchOption {
 	return func(m *MatchOptions) {
 		m.JSONLDDocumentLoader = l
@@ -111,6 +115,8 @@ func (p *PresentationDefinitions) Match(vp *verifiable.Presentation, // nolint:g
 	builder := gval.Full(jsonpath.PlaceholderExtension())
 	result := make(map[string]*verifiable.Credential)
 
+	var descriptorErrors []*DescriptorError
+
 	for i := range descriptorMap {
 		mapping := descriptorMap[i]
 		// The object MUST include an id property, and its value MUST be a string matching the id property of
@@ -128,19 +134,27 @@ func (p *PresentationDefinitions) Match(vp *verifiable.Presentation, // nolint:g
 
 		inputDescriptor := p.inputDescriptor(mapping.ID)
 
-		// The schema of the candidate input must match one of the Input Descriptor schema object uri values exactly.
-		if !stringsContain(vc.Context, inputDescriptor.Schema.URI) {
-			return nil, fmt.Errorf(
-				"input descriptor id [%s] requires schema uri [%s] which is not in vc context [%+v]",
-				inputDescriptor.ID, inputDescriptor.Schema.URI, vc.Types,
-			)
+		reasons, evalErr := inputDescriptor.evaluate(builder, vc)
+		if evalErr != nil {
+			return nil, fmt.Errorf("failed to evaluate input descriptor [%s]: %w", inputDescriptor.ID, evalErr)
 		}
 
-		// TODO add support for constraints: https://github.com/hyperledger/aries-framework-go/issues/2108
+		if len(reasons) > 0 {
+			descriptorErrors = append(descriptorErrors, &DescriptorError{
+				DescriptorID: inputDescriptor.ID,
+				Reasons:      reasons,
+			})
+
+			continue
+		}
 
 		result[mapping.ID] = vc
 	}
 
+	if len(descriptorErrors) > 0 {
+		return nil, &MatchError{Descriptors: descriptorErrors}
+	}
+
 	err = p.evalSubmissionRequirements(result)
 	if err != nil {
 		return nil, fmt.Errorf("failed submission requirements: %w", err)
@@ -151,17 +165,13 @@ func (p *PresentationDefinitions) Match(vp *verifiable.Presentation, // nolint:g
 
 // Ensures the matched credentials meet the submission requirements.
 func (p *PresentationDefinitions) evalSubmissionRequirements(matched map[string]*verifiable.Credential) error {
-	// TODO support submission requirement rules: https://github.com/hyperledger/aries-framework-go/issues/2109
-	descriptorIDs := descriptorIDs(p.InputDescriptors)
+	satisfied := make([]string, 0, len(matched))
 
-	for i := range descriptorIDs {
-		_, found := matched[descriptorIDs[i]]
-		if !found {
-			return fmt.Errorf("no credential provided for input descriptor %s", descriptorIDs[i])
-		}
+	for id := range matched {
+		satisfied = append(satisfied, id)
 	}
 
-	return nil
+	return p.CheckSubmissionRequirements(satisfied)
 }
 
 func (p *PresentationDefinitions) inputDescriptor(id string) *InputDescriptor {
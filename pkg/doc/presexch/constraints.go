@@ -0,0 +1,273 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+const credentialSubjectProperty = "credentialSubject"
+
+// credentialSubjectPathRegexp extracts the top-level credentialSubject property addressed by JSONPath
+// (e.g. $.credentialSubject.name or $.credentialSubject["name"]).
+var credentialSubjectPathRegexp = regexp.MustCompile(`^\$\.credentialSubject(?:\.|\[['"])([^.\['"\]]+)`)
+
+// Constraints describes the constraints the submitted credential must satisfy
+// (https://identity.foundation/presentation-exchange/#input-descriptor-object).
+type Constraints struct {
+	// LimitDisclosure indicates that the credential must not disclose credentialSubject properties
+	// other than the ones selected by Fields.
+	LimitDisclosure bool     `json:"limit_disclosure,omitempty"`
+	Fields          []*Field `json:"fields,omitempty"`
+}
+
+// Field is a constraint on a credential property.
+type Field struct {
+	// Path is an array of JSONPath expressions; the first one which selects a value (passing Filter, if any)
+	// satisfies the field.
+	Path    []string `json:"path,omitempty"`
+	ID      string   `json:"id,omitempty"`
+	Purpose string   `json:"purpose,omitempty"`
+	// Filter is a JSON Schema descriptor the selected value must be valid against.
+	Filter map[string]interface{} `json:"filter,omitempty"`
+	// Optional indicates the field may be missing in the credential.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// DescriptorError describes why the credential submitted for the input descriptor does not satisfy it.
+type DescriptorError struct {
+	DescriptorID string
+	Reasons      []string
+}
+
+// Error implements error interface.
+func (e *DescriptorError) Error() string {
+	return fmt.Sprintf("input descriptor [%s]: %s", e.DescriptorID, strings.Join(e.Reasons, "; "))
+}
+
+// MatchError is returned by Match when submitted credentials do not satisfy the input descriptors.
+type MatchError struct {
+	Descriptors []*DescriptorError
+}
+
+// Error implements error interface.
+func (e *MatchError) Error() string {
+	msgs := make([]string, len(e.Descriptors))
+
+	for i := range e.Descriptors {
+		msgs[i] = e.Descriptors[i].Error()
+	}
+
+	return "input descriptors are not satisfied: " + strings.Join(msgs, ", ")
+}
+
+// evaluate checks the credential against the schema and the constraints of the input descriptor.
+// It returns the reasons why the credential does not satisfy the descriptor (empty if it does).
+func (d *InputDescriptor) evaluate(builder gval.Language, vc *verifiable.Credential) ([]string, error) {
+	var reasons []string
+
+	// The schema of the candidate input must match one of the Input Descriptor schema object uri values exactly.
+	if d.Schema != nil && !stringsContain(vc.Context, d.Schema.URI) {
+		reasons = append(reasons, fmt.Sprintf("requires schema uri [%s] which is not in vc context %v",
+			d.Schema.URI, vc.Context))
+	}
+
+	if d.Constraints == nil {
+		return reasons, nil
+	}
+
+	vcBytes, err := vc.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal vc: %w", err)
+	}
+
+	var typelessVC interface{}
+
+	err = json.Unmarshal(vcBytes, &typelessVC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal vc: %w", err)
+	}
+
+	for i, field := range d.Constraints.Fields {
+		reason, err := field.evaluate(builder, typelessVC)
+		if err != nil {
+			return nil, fmt.Errorf("field %d: %w", i, err)
+		}
+
+		if reason != "" && !field.Optional {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	if d.Constraints.LimitDisclosure {
+		reasons = append(reasons, d.Constraints.checkLimitDisclosure(typelessVC)...)
+	}
+
+	return reasons, nil
+}
+
+// evaluate returns the reason why the field is not satisfied by the credential (empty if it is satisfied).
+func (f *Field) evaluate(builder gval.Language, vc interface{}) (string, error) {
+	if len(f.Path) == 0 {
+		return "", fmt.Errorf("field %s has no path", f.fieldName())
+	}
+
+	var filter *gojsonschema.Schema
+
+	if f.Filter != nil {
+		var err error
+
+		filter, err = gojsonschema.NewSchema(gojsonschema.NewGoLoader(f.Filter))
+		if err != nil {
+			return "", fmt.Errorf("invalid filter of field %s: %w", f.fieldName(), err)
+		}
+	}
+
+	var filterErrors []string
+
+	for _, jsonPath := range f.Path {
+		eval, err := builder.NewEvaluable(jsonPath)
+		if err != nil {
+			return "", fmt.Errorf("failed to build new json path evaluator: %w", err)
+		}
+
+		value, err := eval(context.TODO(), vc)
+		if err != nil || value == nil {
+			// path does not select anything, try the next one
+			continue
+		}
+
+		if filter == nil {
+			return "", nil
+		}
+
+		valid, errs, err := filterValue(filter, value)
+		if err != nil {
+			return "", fmt.Errorf("failed to apply filter of field %s: %w", f.fieldName(), err)
+		}
+
+		if valid {
+			return "", nil
+		}
+
+		filterErrors = append(filterErrors, errs...)
+	}
+
+	if len(filterErrors) > 0 {
+		return fmt.Sprintf("field %s does not pass filter: %s", f.fieldName(), strings.Join(filterErrors, ", ")), nil
+	}
+
+	return fmt.Sprintf("field %s is not found by paths %v", f.fieldName(), f.Path), nil
+}
+
+func (f *Field) fieldName() string {
+	if f.ID != "" {
+		return "[" + f.ID + "]"
+	}
+
+	return fmt.Sprintf("%v", f.Path)
+}
+
+// checkLimitDisclosure makes sure the credential does not disclose credentialSubject properties
+// which are not selected by the fields.
+func (c *Constraints) checkLimitDisclosure(vc interface{}) []string {
+	vcMap, ok := vc.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	allowed := map[string]bool{"id": true, "type": true}
+
+	for _, field := range c.Fields {
+		for _, jsonPath := range field.Path {
+			if m := credentialSubjectPathRegexp.FindStringSubmatch(jsonPath); m != nil {
+				allowed[m[1]] = true
+			}
+		}
+	}
+
+	var subjects []interface{}
+
+	switch s := vcMap[credentialSubjectProperty].(type) {
+	case map[string]interface{}:
+		subjects = []interface{}{s}
+	case []interface{}:
+		subjects = s
+	}
+
+	disclosed := make(map[string]bool)
+
+	for _, subject := range subjects {
+		subjectMap, ok := subject.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for k := range subjectMap {
+			if !allowed[k] {
+				disclosed[k] = true
+			}
+		}
+	}
+
+	if len(disclosed) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(disclosed))
+	for k := range disclosed {
+		names = append(names, k)
+	}
+
+	sort.Strings(names)
+
+	return []string{fmt.Sprintf("limit_disclosure is required but credential discloses %v", names)}
+}
+
+// filterValue validates the value against the filter. If the value is an array (e.g. selected by wildcard),
+// it's enough for one of the elements to pass the filter.
+func filterValue(filter *gojsonschema.Schema, value interface{}) (bool, []string, error) {
+	result, err := filter.Validate(gojsonschema.NewGoLoader(value))
+	if err != nil {
+		return false, nil, err
+	}
+
+	if result.Valid() {
+		return true, nil, nil
+	}
+
+	if values, ok := value.([]interface{}); ok {
+		for _, v := range values {
+			elemResult, err := filter.Validate(gojsonschema.NewGoLoader(v))
+			if err != nil {
+				return false, nil, err
+			}
+
+			if elemResult.Valid() {
+				return true, nil, nil
+			}
+		}
+	}
+
+	errs := make([]string, len(result.Errors()))
+
+	for i, desc := range result.Errors() {
+		errs[i] = desc.String()
+	}
+
+	return false, errs, nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestPresentationDefinitions_Match_Constraints(t *testing.T) {
+	uri := randomURI()
+
+	match := func(descriptor *InputDescriptor, vc *verifiable.Credential) (map[string]*verifiable.Credential, error) {
+		defs := &PresentationDefinitions{InputDescriptors: []*InputDescriptor{descriptor}}
+
+		return defs.Match(newVP(t,
+			&PresentationSubmission{DescriptorMap: []*InputDescriptorMapping{{
+				ID:   descriptor.ID,
+				Path: "$.verifiableCredential[0]",
+			}}},
+			vc,
+		), WithJSONLDDocumentLoader(jsonldContextLoader(t, uri)))
+	}
+
+	newSubjectVC := func(subject map[string]interface{}) *verifiable.Credential {
+		vc := newVC([]string{uri})
+		vc.Subject = subject
+
+		return vc
+	}
+
+	t.Run("fields with filters are satisfied", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID:     uuid.New().String(),
+			Schema: &Schema{URI: uri},
+			Constraints: &Constraints{Fields: []*Field{
+				{
+					Path:   []string{"$.credentialSubject.dob", "$.credentialSubject.birthDate"},
+					Filter: map[string]interface{}{"type": "string", "pattern": "^19[0-9]{2}"},
+				},
+				{
+					Path: []string{"$.issuer.id", "$.issuer"},
+					Filter: map[string]interface{}{
+						"type": "string",
+						"enum": []interface{}{"http://test.issuer.com"},
+					},
+				},
+				{
+					Path: []string{"$.type"},
+					Filter: map[string]interface{}{
+						"type":  "string",
+						"const": "VerifiableCredential",
+					},
+				},
+			}},
+		}
+
+		matched, err := match(descriptor, newSubjectVC(map[string]interface{}{
+			"id":        uuid.New().String(),
+			"birthDate": "1985-06-01",
+		}))
+		require.NoError(t, err)
+		require.Len(t, matched, 1)
+	})
+
+	t.Run("optional field may be missing", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID: uuid.New().String(),
+			Constraints: &Constraints{Fields: []*Field{{
+				Path:     []string{"$.credentialSubject.nickname"},
+				Optional: true,
+			}}},
+		}
+
+		matched, err := match(descriptor, newVC([]string{uri}))
+		require.NoError(t, err)
+		require.Len(t, matched, 1)
+	})
+
+	t.Run("limit disclosure is satisfied", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID: uuid.New().String(),
+			Constraints: &Constraints{
+				LimitDisclosure: true,
+				Fields: []*Field{{
+					Path: []string{`$.credentialSubject["age"]`},
+				}},
+			},
+		}
+
+		matched, err := match(descriptor, newSubjectVC(map[string]interface{}{
+			"id":  uuid.New().String(),
+			"age": 21,
+		}))
+		require.NoError(t, err)
+		require.Len(t, matched, 1)
+	})
+
+	t.Run("reports per-descriptor failure reasons", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID:     "age-check",
+			Schema: &Schema{URI: randomURI()},
+			Constraints: &Constraints{
+				LimitDisclosure: true,
+				Fields: []*Field{
+					{
+						ID:     "age",
+						Path:   []string{"$.credentialSubject.age"},
+						Filter: map[string]interface{}{"type": "number", "minimum": 18},
+					},
+					{
+						ID:   "license",
+						Path: []string{"$.credentialSubject.license"},
+					},
+				},
+			},
+		}
+
+		_, err := match(descriptor, newSubjectVC(map[string]interface{}{
+			"id":   uuid.New().String(),
+			"age":  16,
+			"name": "Jayden Doe",
+		}))
+		require.Error(t, err)
+
+		var matchErr *MatchError
+		require.True(t, errors.As(err, &matchErr))
+		require.Len(t, matchErr.Descriptors, 1)
+		require.Equal(t, "age-check", matchErr.Descriptors[0].DescriptorID)
+
+		reasons := matchErr.Descriptors[0].Reasons
+		require.Len(t, reasons, 4)
+		require.Contains(t, reasons[0], "requires schema uri")
+		require.Contains(t, reasons[1], "field [age] does not pass filter")
+		require.Contains(t, reasons[2], "field [license] is not found")
+		require.Contains(t, reasons[3], "limit_disclosure is required but credential discloses [name]")
+	})
+
+	t.Run("error if field has no path", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID:          uuid.New().String(),
+			Constraints: &Constraints{Fields: []*Field{{ID: "empty"}}},
+		}
+
+		_, err := match(descriptor, newVC([]string{uri}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "field [empty] has no path")
+	})
+
+	t.Run("error if filter is invalid", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID: uuid.New().String(),
+			Constraints: &Constraints{Fields: []*Field{{
+				Path:   []string{"$.id"},
+				Filter: map[string]interface{}{"type": 123},
+			}}},
+		}
+
+		_, err := match(descriptor, newVC([]string{uri}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid filter")
+	})
+
+	t.Run("error if path is invalid", func(t *testing.T) {
+		descriptor := &InputDescriptor{
+			ID: uuid.New().String(),
+			Constraints: &Constraints{Fields: []*Field{{
+				Path: []string{"$.credentialSubject[?("},
+			}}},
+		}
+
+		_, err := match(descriptor, newVC([]string{uri}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to build new json path evaluator")
+	})
+}
+
+func TestInputDescriptor_JSON(t *testing.T) {
+	const descriptorJSON = `{
+  "id": "citizenship_input",
+  "schema": {"uri": "https://eu.com/claims/DriversLicense.json"},
+  "constraints": {
+    "limit_disclosure": true,
+    "fields": [
+      {
+        "path": ["$.credentialSubject.dob", "$.vc.credentialSubject.dob"],
+        "filter": {"type": "string", "format": "date"}
+      },
+      {
+        "id": "nickname",
+        "path": ["$.credentialSubject.nickname"],
+        "purpose": "We may address you by your nickname.",
+        "optional": true
+      }
+    ]
+  }
+}`
+
+	var descriptor InputDescriptor
+
+	require.NoError(t, json.Unmarshal([]byte(descriptorJSON), &descriptor))
+	require.True(t, descriptor.Constraints.LimitDisclosure)
+	require.Len(t, descriptor.Constraints.Fields, 2)
+	require.Equal(t, []string{"$.credentialSubject.dob", "$.vc.credentialSubject.dob"},
+		descriptor.Constraints.Fields[0].Path)
+	require.Equal(t, "date", descriptor.Constraints.Fields[0].Filter["format"])
+	require.True(t, descriptor.Constraints.Fields[1].Optional)
+	require.Equal(t, "nickname", descriptor.Constraints.Fields[1].ID)
+}
@@ -0,0 +1,170 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+const (
+	vpContext = "https://www.w3.org/2018/credentials/v1"
+	vpType    = "VerifiablePresentation"
+)
+
+// CredentialSelector chooses the credential to be submitted for the input descriptor among the candidates
+// (there is at least one candidate).
+type CredentialSelector func(descriptorID string, candidates []*verifiable.Credential) (*verifiable.Credential, error)
+
+// CreateVPOptions is a holder of options that can be set when creating a presentation submission.
+type CreateVPOptions struct {
+	CredentialSelector CredentialSelector
+}
+
+// CreateVPOption is an option that sets an option for when creating a presentation submission.
+type CreateVPOption func(*CreateVPOptions)
+
+// WithCredentialSelector sets the selector used to choose among credentials matching the same input descriptor.
+// By default, the first matching credential is submitted.
+func WithCredentialSelector(selector CredentialSelector) CreateVPOption {
+	return func(o *CreateVPOptions) {
+		o.CredentialSelector = selector
+	}
+}
+
+// FindCandidates returns the credentials satisfying each of the input descriptors (map key is input descriptor ID).
+// Input descriptors which are not satisfied by any of the credentials are not present in the result.
+func (p *PresentationDefinitions) FindCandidates(
+	credentials []*verifiable.Credential) (map[string][]*verifiable.Credential, error) {
+	builder := gval.Full(jsonpath.PlaceholderExtension())
+	candidates := make(map[string][]*verifiable.Credential)
+
+	for _, descriptor := range p.InputDescriptors {
+		for _, vc := range credentials {
+			reasons, err := descriptor.evaluate(builder, vc)
+			if err != nil {
+				return nil, fmt.Errorf("failed to evaluate input descriptor [%s]: %w", descriptor.ID, err)
+			}
+
+			if len(reasons) == 0 {
+				candidates[descriptor.ID] = append(candidates[descriptor.ID], vc)
+			}
+		}
+	}
+
+	return candidates, nil
+}
+
+// CreateVP creates presentation submission from the holder's credentials. It selects the credentials which
+// satisfy input descriptors and submission requirements, and builds the presentation_submission with a
+// descriptor_map referring to the credentials embedded in the presentation. The returned presentation is not
+// signed and has no holder set.
+func (p *PresentationDefinitions) CreateVP(credentials []*verifiable.Credential,
+	options ...CreateVPOption) (*verifiable.Presentation, error) {
+	opts := &CreateVPOptions{CredentialSelector: selectFirst}
+
+	for i := range options {
+		options[i](opts)
+	}
+
+	candidates, err := p.FindCandidates(credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	available := make([]string, 0, len(candidates))
+
+	for _, id := range descriptorIDs(p.InputDescriptors) {
+		if _, ok := candidates[id]; ok {
+			available = append(available, id)
+		}
+	}
+
+	selected, err := p.SelectDescriptors(available)
+	if err != nil {
+		return nil, fmt.Errorf("credentials do not satisfy presentation definition: %w", err)
+	}
+
+	var vcs []interface{}
+
+	submission := &PresentationSubmission{}
+
+	for _, id := range selected {
+		vc, err := opts.CredentialSelector(id, candidates[id])
+		if err != nil {
+			return nil, fmt.Errorf("failed to select credential for input descriptor [%s]: %w", id, err)
+		}
+
+		index := indexOf(vcs, vc)
+		if index < 0 {
+			index = len(vcs)
+			vcs = append(vcs, vc)
+		}
+
+		submission.DescriptorMap = append(submission.DescriptorMap, &InputDescriptorMapping{
+			ID:   id,
+			Path: fmt.Sprintf("$.verifiableCredential[%d]", index),
+		})
+	}
+
+	submissionMap, err := toTypelessMap(submission)
+	if err != nil {
+		return nil, err
+	}
+
+	vp := &verifiable.Presentation{
+		Context:      []string{vpContext, PresentationSubmissionJSONLDContext},
+		Type:         []string{vpType, PresentationSubmissionJSONLDType},
+		CustomFields: verifiable.CustomFields{submissionProperty: submissionMap},
+	}
+
+	if err = vp.SetCredentials(vcs...); err != nil {
+		return nil, fmt.Errorf("failed to set credentials: %w", err)
+	}
+
+	return vp, nil
+}
+
+func selectFirst(_ string, candidates []*verifiable.Credential) (*verifiable.Credential, error) {
+	if len(candidates) == 0 {
+		return nil, errors.New("no candidates")
+	}
+
+	return candidates[0], nil
+}
+
+func indexOf(vcs []interface{}, vc *verifiable.Credential) int {
+	for i := range vcs {
+		if vcs[i] == vc {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func toTypelessMap(v interface{}) (map[string]interface{}, error) {
+	bits, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal presentation submission: %w", err)
+	}
+
+	m := make(map[string]interface{})
+
+	err = json.Unmarshal(bits, &m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal presentation submission: %w", err)
+	}
+
+	return m, nil
+}
@@ -0,0 +1,143 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestPresentationDefinitions_FindCandidates(t *testing.T) {
+	uri := randomURI()
+
+	defs := &PresentationDefinitions{
+		InputDescriptors: []*InputDescriptor{
+			{ID: "schema", Schema: &Schema{URI: uri}},
+			{ID: "any"},
+		},
+	}
+
+	withSchema := newVC([]string{uri})
+	withoutSchema := newVC(nil)
+
+	candidates, err := defs.FindCandidates([]*verifiable.Credential{withSchema, withoutSchema})
+	require.NoError(t, err)
+	require.Equal(t, []*verifiable.Credential{withSchema}, candidates["schema"])
+	require.Equal(t, []*verifiable.Credential{withSchema, withoutSchema}, candidates["any"])
+
+	candidates, err = defs.FindCandidates([]*verifiable.Credential{withoutSchema})
+	require.NoError(t, err)
+	require.NotContains(t, candidates, "schema")
+
+	defs.InputDescriptors[1].Constraints = &Constraints{Fields: []*Field{{ID: "empty"}}}
+	_, err = defs.FindCandidates([]*verifiable.Credential{withSchema})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to evaluate input descriptor [any]")
+}
+
+func TestPresentationDefinitions_CreateVP(t *testing.T) {
+	uri := randomURI()
+
+	newSubjectVC := func(id string, subject map[string]interface{}) *verifiable.Credential {
+		vc := newVC([]string{uri})
+		vc.ID = id
+		vc.Subject = subject
+
+		return vc
+	}
+
+	defs := &PresentationDefinitions{
+		SubmissionRequirements: []*SubmissionRequirement{
+			{Rule: Pick, Count: 1, From: "ID"},
+			{Rule: All, From: "adult"},
+		},
+		InputDescriptors: []*InputDescriptor{
+			{
+				ID:     "passport",
+				Group:  []string{"ID"},
+				Schema: &Schema{URI: uri},
+				Constraints: &Constraints{Fields: []*Field{{
+					Path: []string{"$.credentialSubject.passportNumber"},
+				}}},
+			},
+			{
+				ID:     "drivers",
+				Group:  []string{"ID"},
+				Schema: &Schema{URI: uri},
+				Constraints: &Constraints{Fields: []*Field{{
+					Path: []string{"$.credentialSubject.licenseNumber"},
+				}}},
+			},
+			{
+				ID:     "age",
+				Group:  []string{"adult"},
+				Schema: &Schema{URI: uri},
+				Constraints: &Constraints{Fields: []*Field{{
+					Path:   []string{"$.credentialSubject.age"},
+					Filter: map[string]interface{}{"type": "number", "minimum": 18},
+				}}},
+			},
+		},
+	}
+
+	license := newSubjectVC("http://example.edu/license", map[string]interface{}{"licenseNumber": "123", "age": 21})
+	otherLicense := newSubjectVC("http://example.edu/license2", map[string]interface{}{"licenseNumber": "456"})
+	minor := newSubjectVC("http://example.edu/minor", map[string]interface{}{"age": 16})
+
+	t.Run("creates presentation matching the definition", func(t *testing.T) {
+		vp, err := defs.CreateVP([]*verifiable.Credential{minor, license, otherLicense})
+		require.NoError(t, err)
+		require.Equal(t, []string{vpType, PresentationSubmissionJSONLDType}, vp.Type)
+
+		// the same credential satisfies both of the selected descriptors
+		require.Len(t, vp.Credentials(), 1)
+
+		matched, err := defs.Match(vp, WithJSONLDDocumentLoader(jsonldContextLoader(t, uri)))
+		require.NoError(t, err)
+		require.Len(t, matched, 2)
+		require.Equal(t, license.ID, matched["drivers"].ID)
+		require.Equal(t, license.ID, matched["age"].ID)
+	})
+
+	t.Run("caller chooses among alternatives", func(t *testing.T) {
+		vp, err := defs.CreateVP([]*verifiable.Credential{license, otherLicense},
+			WithCredentialSelector(func(id string, candidates []*verifiable.Credential) (*verifiable.Credential, error) {
+				if id == "drivers" {
+					require.Len(t, candidates, 2)
+
+					return candidates[1], nil
+				}
+
+				return candidates[0], nil
+			}))
+		require.NoError(t, err)
+		require.Len(t, vp.Credentials(), 2)
+
+		matched, err := defs.Match(vp, WithJSONLDDocumentLoader(jsonldContextLoader(t, uri)))
+		require.NoError(t, err)
+		require.Equal(t, otherLicense.ID, matched["drivers"].ID)
+		require.Equal(t, license.ID, matched["age"].ID)
+	})
+
+	t.Run("error if credentials do not satisfy the definition", func(t *testing.T) {
+		_, err := defs.CreateVP([]*verifiable.Credential{minor, otherLicense})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credentials do not satisfy presentation definition")
+	})
+
+	t.Run("error if selector fails", func(t *testing.T) {
+		_, err := defs.CreateVP([]*verifiable.Credential{license},
+			WithCredentialSelector(func(string, []*verifiable.Credential) (*verifiable.Credential, error) {
+				return nil, errors.New("cancelled")
+			}))
+		require.EqualError(t, err, "failed to select credential for input descriptor [drivers]: cancelled")
+	})
+}
@@ -0,0 +1,262 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Rule is a submission requirement rule.
+type Rule string
+
+const (
+	// All rule requires all the input descriptors (or nested requirements) to be satisfied.
+	All Rule = "all"
+	// Pick rule requires the number of satisfied input descriptors (or nested requirements)
+	// to fit count, min and max constraints.
+	Pick Rule = "pick"
+)
+
+// SubmissionRequirement describes which combinations of input descriptors must be satisfied
+// (https://identity.foundation/presentation-exchange/#submission-requirements).
+type SubmissionRequirement struct {
+	Name       string                   `json:"name,omitempty"`
+	Purpose    string                   `json:"purpose,omitempty"`
+	Rule       Rule                     `json:"rule,omitempty"`
+	Count      int                      `json:"count,omitempty"`
+	Min        int                      `json:"min,omitempty"`
+	Max        int                      `json:"max,omitempty"`
+	From       string                   `json:"from,omitempty"`
+	FromNested []*SubmissionRequirement `json:"from_nested,omitempty"`
+}
+
+// CheckSubmissionRequirements checks that the given set of satisfied input descriptors meets the submission
+// requirements. If no submission requirements are defined, all the input descriptors must be satisfied.
+func (p *PresentationDefinitions) CheckSubmissionRequirements(satisfied []string) error {
+	if len(p.SubmissionRequirements) == 0 {
+		for _, id := range descriptorIDs(p.InputDescriptors) {
+			if !stringsContain(satisfied, id) {
+				return fmt.Errorf("no credential provided for input descriptor %s", id)
+			}
+		}
+
+		return nil
+	}
+
+	for i, requirement := range p.SubmissionRequirements {
+		if err := requirement.check(p.InputDescriptors, satisfied); err != nil {
+			return fmt.Errorf("submission requirement %s: %w", requirement.name(i), err)
+		}
+	}
+
+	return nil
+}
+
+// SelectDescriptors is a holder-side counterpart of CheckSubmissionRequirements. Given input descriptors the holder
+// has credentials for, it selects the ones to be submitted so that submission requirements are met. For "pick"
+// rules, it selects the first "count" (or "min") of available descriptors in the order they are defined.
+// It returns an error if the requirements can't be met with the available descriptors.
+func (p *PresentationDefinitions) SelectDescriptors(available []string) ([]string, error) {
+	if len(p.SubmissionRequirements) == 0 {
+		if err := p.CheckSubmissionRequirements(available); err != nil {
+			return nil, err
+		}
+
+		return descriptorIDs(p.InputDescriptors), nil
+	}
+
+	var selected []string
+
+	for i, requirement := range p.SubmissionRequirements {
+		ids, err := requirement.selectDescriptors(p.InputDescriptors, available)
+		if err != nil {
+			return nil, fmt.Errorf("submission requirement %s: %w", requirement.name(i), err)
+		}
+
+		selected = appendUnique(selected, ids...)
+	}
+
+	if err := p.CheckSubmissionRequirements(selected); err != nil {
+		return nil, err
+	}
+
+	return selected, nil
+}
+
+func (r *SubmissionRequirement) check(descriptors []*InputDescriptor, satisfied []string) error {
+	total, matched, err := r.count(descriptors, satisfied)
+	if err != nil {
+		return err
+	}
+
+	switch r.Rule {
+	case All:
+		if matched != total {
+			return fmt.Errorf("rule %q requires %d, but %d satisfied", r.Rule, total, matched)
+		}
+	case Pick:
+		if err := r.checkPick(matched); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *SubmissionRequirement) checkPick(matched int) error {
+	if r.Count > 0 && matched != r.Count {
+		return fmt.Errorf("rule %q requires count %d, but %d satisfied", r.Rule, r.Count, matched)
+	}
+
+	if matched < r.Min {
+		return fmt.Errorf("rule %q requires min %d, but %d satisfied", r.Rule, r.Min, matched)
+	}
+
+	if r.Max > 0 && matched > r.Max {
+		return fmt.Errorf("rule %q allows max %d, but %d satisfied", r.Rule, r.Max, matched)
+	}
+
+	return nil
+}
+
+// count returns the number of candidates (input descriptors of the group or nested requirements) and
+// how many of them are satisfied.
+func (r *SubmissionRequirement) count(descriptors []*InputDescriptor, satisfied []string) (int, int, error) {
+	if err := r.validate(); err != nil {
+		return 0, 0, err
+	}
+
+	if r.From != "" {
+		group := groupDescriptors(descriptors, r.From)
+		if len(group) == 0 {
+			return 0, 0, fmt.Errorf("group %s matches no input descriptors", r.From)
+		}
+
+		matched := 0
+
+		for _, id := range group {
+			if stringsContain(satisfied, id) {
+				matched++
+			}
+		}
+
+		return len(group), matched, nil
+	}
+
+	matched := 0
+
+	for _, nested := range r.FromNested {
+		err := nested.check(descriptors, satisfied)
+		if err == nil {
+			matched++
+		}
+	}
+
+	return len(r.FromNested), matched, nil
+}
+
+func (r *SubmissionRequirement) selectDescriptors(descriptors []*InputDescriptor,
+	available []string) ([]string, error) {
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+
+	// candidates are the groups of descriptors which can be submitted for each of the options of the rule
+	var candidates [][]string
+
+	if r.From != "" {
+		group := groupDescriptors(descriptors, r.From)
+		if len(group) == 0 {
+			return nil, fmt.Errorf("group %s matches no input descriptors", r.From)
+		}
+
+		for _, id := range group {
+			if stringsContain(available, id) {
+				candidates = append(candidates, []string{id})
+			} else if r.Rule == All {
+				return nil, fmt.Errorf("no credential available for input descriptor %s of group %s", id, r.From)
+			}
+		}
+	} else {
+		for i, nested := range r.FromNested {
+			ids, err := nested.selectDescriptors(descriptors, available)
+			if err == nil {
+				candidates = append(candidates, ids)
+			} else if r.Rule == All {
+				return nil, fmt.Errorf("nested requirement %s: %w", nested.name(i), err)
+			}
+		}
+	}
+
+	n := len(candidates)
+
+	if r.Rule == Pick {
+		switch {
+		case r.Count > 0:
+			n = r.Count
+		case r.Min > 0:
+			n = r.Min
+		case r.Max > 0 && r.Max < n:
+			n = r.Max
+		}
+
+		if n > len(candidates) {
+			return nil, fmt.Errorf("rule %q requires %d, but only %d available", r.Rule, n, len(candidates))
+		}
+	}
+
+	var selected []string
+
+	for _, ids := range candidates[:n] {
+		selected = appendUnique(selected, ids...)
+	}
+
+	return selected, nil
+}
+
+func (r *SubmissionRequirement) validate() error {
+	if (r.From == "") == (len(r.FromNested) == 0) {
+		return errors.New("exactly one of from or from_nested must be defined")
+	}
+
+	if r.Rule != All && r.Rule != Pick {
+		return fmt.Errorf("unsupported rule %q", r.Rule)
+	}
+
+	return nil
+}
+
+func (r *SubmissionRequirement) name(i int) string {
+	if r.Name != "" {
+		return "[" + r.Name + "]"
+	}
+
+	return fmt.Sprintf("[%d]", i)
+}
+
+func groupDescriptors(descriptors []*InputDescriptor, group string) []string {
+	var ids []string
+
+	for _, descriptor := range descriptors {
+		if stringsContain(descriptor.Group, group) {
+			ids = append(ids, descriptor.ID)
+		}
+	}
+
+	return ids
+}
+
+func appendUnique(s []string, vals ...string) []string {
+	for _, v := range vals {
+		if !stringsContain(s, v) {
+			s = append(s, v)
+		}
+	}
+
+	return s
+}
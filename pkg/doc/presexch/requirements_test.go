@@ -0,0 +1,262 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package presexch
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+func TestPresentationDefinitions_CheckSubmissionRequirements(t *testing.T) {
+	defs := newRequirementsDefinitions()
+
+	t.Run("satisfied", func(t *testing.T) {
+		require.NoError(t, defs.CheckSubmissionRequirements([]string{"passport", "bank", "utility"}))
+		require.NoError(t, defs.CheckSubmissionRequirements([]string{"drivers", "bank", "utility"}))
+		require.NoError(t, defs.CheckSubmissionRequirements([]string{"passport", "drivers", "utility", "lease"}))
+	})
+
+	t.Run("nested rule is not satisfied", func(t *testing.T) {
+		err := defs.CheckSubmissionRequirements([]string{"passport", "drivers"})
+		require.EqualError(t, err,
+			`submission requirement [address]: rule "pick" requires min 1, but 0 satisfied`)
+	})
+
+	t.Run("pick rule is not satisfied", func(t *testing.T) {
+		err := defs.CheckSubmissionRequirements([]string{"passport", "utility"})
+		require.EqualError(t, err,
+			`submission requirement [identity]: rule "pick" requires count 2, but 1 satisfied`)
+
+		err = defs.CheckSubmissionRequirements([]string{"passport", "drivers", "bank", "utility"})
+		require.EqualError(t, err,
+			`submission requirement [identity]: rule "pick" requires count 2, but 3 satisfied`)
+	})
+
+	t.Run("min and max", func(t *testing.T) {
+		minMax := &PresentationDefinitions{
+			SubmissionRequirements: []*SubmissionRequirement{{Rule: Pick, Min: 1, Max: 2, From: "A"}},
+			InputDescriptors: []*InputDescriptor{
+				{ID: "1", Group: []string{"A"}},
+				{ID: "2", Group: []string{"A"}},
+				{ID: "3", Group: []string{"A"}},
+			},
+		}
+
+		require.NoError(t, minMax.CheckSubmissionRequirements([]string{"1"}))
+		require.NoError(t, minMax.CheckSubmissionRequirements([]string{"1", "3"}))
+		require.EqualError(t, minMax.CheckSubmissionRequirements(nil),
+			`submission requirement [0]: rule "pick" requires min 1, but 0 satisfied`)
+		require.EqualError(t, minMax.CheckSubmissionRequirements([]string{"1", "2", "3"}),
+			`submission requirement [0]: rule "pick" allows max 2, but 3 satisfied`)
+	})
+
+	t.Run("invalid requirement", func(t *testing.T) {
+		invalid := &PresentationDefinitions{
+			SubmissionRequirements: []*SubmissionRequirement{{Rule: All}},
+		}
+
+		require.EqualError(t, invalid.CheckSubmissionRequirements(nil),
+			"submission requirement [0]: exactly one of from or from_nested must be defined")
+
+		invalid.SubmissionRequirements[0] = &SubmissionRequirement{Rule: "any", From: "A"}
+		require.EqualError(t, invalid.CheckSubmissionRequirements(nil),
+			`submission requirement [0]: unsupported rule "any"`)
+
+		invalid.SubmissionRequirements[0] = &SubmissionRequirement{Rule: All, From: "A"}
+		require.EqualError(t, invalid.CheckSubmissionRequirements(nil),
+			"submission requirement [0]: group A matches no input descriptors")
+
+		_, err := invalid.SelectDescriptors(nil)
+		require.EqualError(t, err, "submission requirement [0]: group A matches no input descriptors")
+	})
+
+	t.Run("no submission requirements", func(t *testing.T) {
+		noRequirements := &PresentationDefinitions{
+			InputDescriptors: []*InputDescriptor{{ID: "1"}, {ID: "2"}},
+		}
+
+		require.NoError(t, noRequirements.CheckSubmissionRequirements([]string{"1", "2"}))
+		require.EqualError(t, noRequirements.CheckSubmissionRequirements([]string{"2"}),
+			"no credential provided for input descriptor 1")
+	})
+}
+
+func TestPresentationDefinitions_SelectDescriptors(t *testing.T) {
+	defs := newRequirementsDefinitions()
+
+	t.Run("selects descriptors to submit", func(t *testing.T) {
+		selected, err := defs.SelectDescriptors([]string{"passport", "drivers", "bank", "utility", "lease"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"passport", "drivers", "utility"}, selected)
+
+		selected, err = defs.SelectDescriptors([]string{"drivers", "bank", "lease"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"drivers", "bank", "lease"}, selected)
+	})
+
+	t.Run("requirements can't be met", func(t *testing.T) {
+		_, err := defs.SelectDescriptors([]string{"passport", "utility"})
+		require.EqualError(t, err,
+			`submission requirement [identity]: rule "pick" requires 2, but only 1 available`)
+
+		_, err = defs.SelectDescriptors([]string{"passport", "drivers"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "submission requirement [address]")
+	})
+
+	t.Run("all rule with a missing descriptor", func(t *testing.T) {
+		all := &PresentationDefinitions{
+			SubmissionRequirements: []*SubmissionRequirement{{Rule: All, From: "A"}},
+			InputDescriptors: []*InputDescriptor{
+				{ID: "1", Group: []string{"A"}},
+				{ID: "2", Group: []string{"A"}},
+			},
+		}
+
+		_, err := all.SelectDescriptors([]string{"1"})
+		require.EqualError(t, err,
+			"submission requirement [0]: no credential available for input descriptor 2 of group A")
+	})
+
+	t.Run("pick rule with max", func(t *testing.T) {
+		withMax := &PresentationDefinitions{
+			SubmissionRequirements: []*SubmissionRequirement{{Rule: Pick, Max: 2, From: "A"}},
+			InputDescriptors: []*InputDescriptor{
+				{ID: "1", Group: []string{"A"}},
+				{ID: "2", Group: []string{"A"}},
+				{ID: "3", Group: []string{"A"}},
+			},
+		}
+
+		selected, err := withMax.SelectDescriptors([]string{"1", "2", "3"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"1", "2"}, selected)
+	})
+
+	t.Run("no submission requirements", func(t *testing.T) {
+		noRequirements := &PresentationDefinitions{
+			InputDescriptors: []*InputDescriptor{{ID: "1"}, {ID: "2"}},
+		}
+
+		selected, err := noRequirements.SelectDescriptors([]string{"2", "1"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"1", "2"}, selected)
+
+		_, err = noRequirements.SelectDescriptors([]string{"1"})
+		require.Error(t, err)
+	})
+}
+
+func TestPresentationDefinitions_Match_SubmissionRequirements(t *testing.T) {
+	uri := randomURI()
+
+	defs := &PresentationDefinitions{
+		SubmissionRequirements: []*SubmissionRequirement{{
+			Name:  "any two IDs",
+			Rule:  Pick,
+			Count: 2,
+			From:  "ID",
+		}},
+		InputDescriptors: []*InputDescriptor{
+			{ID: "passport", Group: []string{"ID"}, Schema: &Schema{URI: uri}},
+			{ID: "drivers", Group: []string{"ID"}, Schema: &Schema{URI: uri}},
+			{ID: "national", Group: []string{"ID"}, Schema: &Schema{URI: uri}},
+		},
+	}
+
+	submit := func(ids ...string) (map[string]*verifiable.Credential, error) {
+		submission := &PresentationSubmission{}
+		vcs := make([]*verifiable.Credential, len(ids))
+
+		for i, id := range ids {
+			submission.DescriptorMap = append(submission.DescriptorMap, &InputDescriptorMapping{
+				ID:   id,
+				Path: fmt.Sprintf("$.verifiableCredential[%d]", i),
+			})
+			vcs[i] = newVC([]string{uri})
+		}
+
+		return defs.Match(newVP(t, submission, vcs...), WithJSONLDDocumentLoader(jsonldContextLoader(t, uri)))
+	}
+
+	matched, err := submit("passport", "national")
+	require.NoError(t, err)
+	require.Len(t, matched, 2)
+
+	_, err = submit("drivers")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `submission requirement [any two IDs]: rule "pick" requires count 2`)
+}
+
+func TestSubmissionRequirement_JSON(t *testing.T) {
+	const definitionJSON = `{
+  "name": "Verify identity",
+  "purpose": "KYC",
+  "submission_requirements": [
+    {
+      "name": "Citizenship Information",
+      "rule": "pick",
+      "count": 1,
+      "from_nested": [
+        {"rule": "all", "from": "A"},
+        {"rule": "pick", "min": 2, "max": 3, "from": "B"}
+      ]
+    }
+  ],
+  "input_descriptors": [
+    {"id": "passport", "group": ["A"]},
+    {"id": "bank", "group": ["B"]}
+  ]
+}`
+
+	var defs PresentationDefinitions
+
+	require.NoError(t, json.Unmarshal([]byte(definitionJSON), &defs))
+	require.Len(t, defs.SubmissionRequirements, 1)
+	require.Equal(t, Pick, defs.SubmissionRequirements[0].Rule)
+	require.Equal(t, 1, defs.SubmissionRequirements[0].Count)
+	require.Len(t, defs.SubmissionRequirements[0].FromNested, 2)
+	require.Equal(t, &SubmissionRequirement{Rule: Pick, Min: 2, Max: 3, From: "B"},
+		defs.SubmissionRequirements[0].FromNested[1])
+	require.Equal(t, []string{"A"}, defs.InputDescriptors[0].Group)
+}
+
+// newRequirementsDefinitions requires any two identity documents and either a utility bill or a bank statement
+// together with a lease.
+func newRequirementsDefinitions() *PresentationDefinitions {
+	return &PresentationDefinitions{
+		SubmissionRequirements: []*SubmissionRequirement{
+			{
+				Name:  "identity",
+				Rule:  Pick,
+				Count: 2,
+				From:  "ID",
+			},
+			{
+				Name: "address",
+				Rule: Pick,
+				Min:  1,
+				FromNested: []*SubmissionRequirement{
+					{Rule: All, From: "utility"},
+					{Rule: All, From: "bank_and_lease"},
+				},
+			},
+		},
+		InputDescriptors: []*InputDescriptor{
+			{ID: "passport", Group: []string{"ID"}},
+			{ID: "drivers", Group: []string{"ID"}},
+			{ID: "bank", Group: []string{"ID", "bank_and_lease"}},
+			{ID: "utility", Group: []string{"utility"}},
+			{ID: "lease", Group: []string{"bank_and_lease"}},
+		},
+	}
+}
@@ -0,0 +1,221 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/piprate/json-gold/ld"
+
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	// ContextsStoreName is the name of the store holding JSON-LD contexts added to DocumentLoader.
+	ContextsStoreName = "jsonldcontexts"
+
+	contextKeyPrefix = "context_"
+)
+
+// ErrContextNotFound is returned when JSON-LD context document is neither embedded nor found in the context store,
+// and the loader is not allowed to fetch it from the network.
+var ErrContextNotFound = errors.New("JSON-LD context not found")
+
+// nolint:gochecknoglobals
+var (
+	defaultLoader     *DocumentLoader
+	defaultLoaderOnce sync.Once
+)
+
+// ContextDocument is a JSON-LD context document together with the URL it is resolved by.
+type ContextDocument struct {
+	URL     string          `json:"url"`
+	Content json.RawMessage `json:"content"`
+}
+
+// DocumentLoader is a JSON-LD document loader (ld.DocumentLoader) which does not need network access for
+// the W3C credentials, DID, security and examples contexts as they are embedded into the binary.
+// Additional contexts are added with AddContexts and persisted in the context store.
+// Contexts which are neither embedded nor stored are fetched by the remote loader, if one is set.
+type DocumentLoader struct {
+	store  storage.Store
+	remote ld.DocumentLoader
+
+	mu    sync.RWMutex
+	cache map[string]*ld.RemoteDocument
+}
+
+// DocumentLoaderOpts configures DocumentLoader.
+type DocumentLoaderOpts func(loader *DocumentLoader)
+
+// WithRemoteDocumentLoader sets the loader used for the contexts which are neither embedded nor stored.
+// By default, such contexts are not loaded (ErrContextNotFound is returned).
+func WithRemoteDocumentLoader(remote ld.DocumentLoader) DocumentLoaderOpts {
+	return func(loader *DocumentLoader) {
+		loader.remote = remote
+	}
+}
+
+// NewDocumentLoader creates a new DocumentLoader with the embedded contexts and the contexts
+// previously added to the context store opened from the storage provider.
+func NewDocumentLoader(storageProvider storage.Provider, opts ...DocumentLoaderOpts) (*DocumentLoader, error) {
+	store, err := storageProvider.OpenStore(ContextsStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open context store: %w", err)
+	}
+
+	loader := newDocumentLoader(store, opts...)
+
+	err = loader.loadStoredContexts()
+	if err != nil {
+		return nil, err
+	}
+
+	return loader, nil
+}
+
+// DefaultDocumentLoader returns the shared DocumentLoader used when no JSON-LD document loader is given.
+// It serves the embedded contexts only and never accesses the network.
+func DefaultDocumentLoader() *DocumentLoader {
+	defaultLoaderOnce.Do(func() {
+		defaultLoader = newDocumentLoader(nil)
+	})
+
+	return defaultLoader
+}
+
+func newDocumentLoader(store storage.Store, opts ...DocumentLoaderOpts) *DocumentLoader {
+	loader := &DocumentLoader{
+		store: store,
+		cache: make(map[string]*ld.RemoteDocument),
+	}
+
+	for _, opt := range opts {
+		opt(loader)
+	}
+
+	return loader
+}
+
+// LoadDocument returns the JSON-LD document resolved by the URL.
+func (l *DocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	l.mu.RLock()
+	doc, ok := l.cache[u]
+	l.mu.RUnlock()
+
+	if ok {
+		return doc, nil
+	}
+
+	if c, ok := embeddedContext(u); ok {
+		return l.loadEmbeddedContext(c)
+	}
+
+	if l.remote == nil {
+		return nil, fmt.Errorf("%w: %s", ErrContextNotFound, u)
+	}
+
+	return l.remote.LoadDocument(u)
+}
+
+// AddContexts adds the context documents to the loader and persists them in the context store.
+// A context added for the URL of an embedded context takes precedence over the embedded one.
+func (l *DocumentLoader) AddContexts(contexts ...ContextDocument) error {
+	if l.store == nil {
+		return errors.New("context store is not configured")
+	}
+
+	docs := make([]*ld.RemoteDocument, len(contexts))
+
+	for i, c := range contexts {
+		doc, err := parseContext(c)
+		if err != nil {
+			return err
+		}
+
+		docs[i] = doc
+	}
+
+	for i, c := range contexts {
+		err := l.store.Put(contextKeyPrefix+c.URL, c.Content)
+		if err != nil {
+			return fmt.Errorf("failed to store context %s: %w", c.URL, err)
+		}
+
+		l.mu.Lock()
+		l.cache[c.URL] = docs[i]
+		l.mu.Unlock()
+	}
+
+	return nil
+}
+
+// loadEmbeddedContext parses the embedded context and caches it unless a context was added for the same URL.
+func (l *DocumentLoader) loadEmbeddedContext(c ContextDocument) (*ld.RemoteDocument, error) {
+	doc, err := parseContext(c)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load embedded context: %w", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cached, ok := l.cache[c.URL]; ok {
+		return cached, nil
+	}
+
+	l.cache[c.URL] = doc
+
+	return doc, nil
+}
+
+func (l *DocumentLoader) loadStoredContexts() error {
+	itr := l.store.Iterator(contextKeyPrefix, contextKeyPrefix+storage.EndKeySuffix)
+	defer itr.Release()
+
+	for itr.Next() {
+		c := ContextDocument{
+			URL:     string(itr.Key())[len(contextKeyPrefix):],
+			Content: itr.Value(),
+		}
+
+		doc, err := parseContext(c)
+		if err != nil {
+			return fmt.Errorf("failed to load stored context: %w", err)
+		}
+
+		l.cache[c.URL] = doc
+	}
+
+	if err := itr.Error(); err != nil {
+		return fmt.Errorf("failed to iterate context store: %w", err)
+	}
+
+	return nil
+}
+
+func parseContext(c ContextDocument) (*ld.RemoteDocument, error) {
+	if c.URL == "" {
+		return nil, errors.New("context URL is mandatory")
+	}
+
+	doc, err := ld.DocumentFromReader(bytes.NewReader(c.Content))
+	if err != nil {
+		return nil, fmt.Errorf("invalid context %s: %w", c.URL, err)
+	}
+
+	docMap, ok := doc.(map[string]interface{})
+	if !ok || docMap["@context"] == nil {
+		return nil, fmt.Errorf("invalid context %s: @context is missing", c.URL)
+	}
+
+	return &ld.RemoteDocument{DocumentURL: c.URL, Document: doc}, nil
+}
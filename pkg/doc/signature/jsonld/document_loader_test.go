@@ -0,0 +1,164 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package jsonld
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/require"
+
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+const sampleContextURL = "https://example.com/context/v1"
+
+const sampleContext = `{
+  "@context": {
+    "@version": 1.1,
+    "name": "http://schema.org/name"
+  }
+}`
+
+func TestNewDocumentLoader(t *testing.T) {
+	t.Run("loads embedded contexts", func(t *testing.T) {
+		loader, err := NewDocumentLoader(mockstore.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		for _, c := range embeddedContexts() {
+			doc, err := loader.LoadDocument(c.URL)
+			require.NoError(t, err, c.URL)
+			require.Equal(t, c.URL, doc.DocumentURL)
+		}
+
+		_, err = loader.LoadDocument(sampleContextURL)
+		require.True(t, errors.Is(err, ErrContextNotFound))
+	})
+
+	t.Run("loads stored contexts", func(t *testing.T) {
+		storeProvider := mockstore.NewMockStoreProvider()
+
+		loader, err := NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+		require.NoError(t, loader.AddContexts(ContextDocument{URL: sampleContextURL, Content: []byte(sampleContext)}))
+
+		doc, err := loader.LoadDocument(sampleContextURL)
+		require.NoError(t, err)
+		require.Equal(t, sampleContextURL, doc.DocumentURL)
+
+		// contexts are loaded from the store when the loader is created again
+		loader, err = NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+
+		doc, err = loader.LoadDocument(sampleContextURL)
+		require.NoError(t, err)
+		require.NotNil(t, doc.Document)
+	})
+
+	t.Run("stored context takes precedence over embedded one", func(t *testing.T) {
+		const url = "https://www.w3.org/2018/credentials/examples/v1"
+
+		loader, err := NewDocumentLoader(mockstore.NewMockStoreProvider())
+		require.NoError(t, err)
+		require.NoError(t, loader.AddContexts(ContextDocument{URL: url, Content: []byte(sampleContext)}))
+
+		doc, err := loader.LoadDocument(url)
+		require.NoError(t, err)
+		require.Contains(t, doc.Document.(map[string]interface{})["@context"], "name")
+	})
+
+	t.Run("falls back to remote loader", func(t *testing.T) {
+		remote := ld.NewCachingDocumentLoader(ld.NewDefaultDocumentLoader(nil))
+		remote.AddDocument(sampleContextURL, map[string]interface{}{"@context": map[string]interface{}{}})
+
+		loader, err := NewDocumentLoader(mockstore.NewMockStoreProvider(), WithRemoteDocumentLoader(remote))
+		require.NoError(t, err)
+
+		doc, err := loader.LoadDocument(sampleContextURL)
+		require.NoError(t, err)
+		require.Equal(t, sampleContextURL, doc.DocumentURL)
+	})
+
+	t.Run("error opening context store", func(t *testing.T) {
+		_, err := NewDocumentLoader(&mockstore.MockStoreProvider{ErrOpenStoreHandle: fmt.Errorf("open error")})
+		require.EqualError(t, err, "failed to open context store: open error")
+	})
+
+	t.Run("error loading invalid stored context", func(t *testing.T) {
+		storeProvider := mockstore.NewMockStoreProvider()
+		storeProvider.Store.Store[contextKeyPrefix+sampleContextURL] = []byte("{}")
+
+		_, err := NewDocumentLoader(storeProvider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to load stored context")
+	})
+
+	t.Run("error iterating context store", func(t *testing.T) {
+		storeProvider := mockstore.NewMockStoreProvider()
+		storeProvider.Store.ErrItr = errors.New("iterator error")
+
+		_, err := NewDocumentLoader(storeProvider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "iterator error")
+	})
+}
+
+func TestDocumentLoader_AddContexts(t *testing.T) {
+	t.Run("invalid contexts", func(t *testing.T) {
+		loader, err := NewDocumentLoader(mockstore.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		err = loader.AddContexts(ContextDocument{Content: []byte(sampleContext)})
+		require.EqualError(t, err, "context URL is mandatory")
+
+		err = loader.AddContexts(ContextDocument{URL: sampleContextURL, Content: []byte("{")})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid context "+sampleContextURL)
+
+		err = loader.AddContexts(ContextDocument{URL: sampleContextURL, Content: []byte(`{"name": "value"}`)})
+		require.EqualError(t, err, "invalid context "+sampleContextURL+": @context is missing")
+
+		_, err = loader.LoadDocument(sampleContextURL)
+		require.True(t, errors.Is(err, ErrContextNotFound))
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		storeProvider := mockstore.NewMockStoreProvider()
+		storeProvider.Store.ErrPut = errors.New("put error")
+
+		loader, err := NewDocumentLoader(storeProvider)
+		require.NoError(t, err)
+
+		err = loader.AddContexts(ContextDocument{URL: sampleContextURL, Content: []byte(sampleContext)})
+		require.EqualError(t, err, "failed to store context "+sampleContextURL+": put error")
+	})
+
+	t.Run("default loader has no context store", func(t *testing.T) {
+		err := DefaultDocumentLoader().AddContexts(ContextDocument{URL: sampleContextURL, Content: []byte(sampleContext)})
+		require.EqualError(t, err, "context store is not configured")
+	})
+}
+
+func TestDefaultDocumentLoader(t *testing.T) {
+	require.Same(t, DefaultDocumentLoader(), DefaultDocumentLoader())
+
+	doc, err := DefaultDocumentLoader().LoadDocument("https://www.w3.org/2018/credentials/v1")
+	require.NoError(t, err)
+	require.NotNil(t, doc.Document)
+
+	// the default loader never accesses the network
+	_, err = DefaultDocumentLoader().LoadDocument(sampleContextURL)
+	require.True(t, errors.Is(err, ErrContextNotFound))
+}
+
+func TestDocumentLoader_loadEmbeddedContext(t *testing.T) {
+	_, err := DefaultDocumentLoader().loadEmbeddedContext(ContextDocument{URL: sampleContextURL, Content: []byte("{")})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to load embedded context")
+}
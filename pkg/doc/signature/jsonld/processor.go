@@ -46,6 +46,7 @@ func WithRemoveAllInvalidRDF() ProcessorOpts {
 }
 
 // WithDocumentLoader option is for passing custom JSON-LD document loader.
+// If not set, DefaultDocumentLoader() is used.
 func WithDocumentLoader(loader ld.DocumentLoader) ProcessorOpts {
 	return func(opts *normalizeOpts) {
 		opts.documentLoader = loader
@@ -98,9 +99,7 @@ func (p *Processor) GetCanonicalDocument(doc map[string]interface{}, opts ...Pro
 	ldOptions.Format = format
 	ldOptions.ProduceGeneralizedRdf = true
 
-	if procOptions.documentLoader != nil {
-		ldOptions.DocumentLoader = procOptions.documentLoader
-	}
+	ldOptions.DocumentLoader = procOptions.documentLoader
 
 	if len(procOptions.externalContexts) > 0 {
 		doc["@context"] = AppendExternalContexts(doc["@context"], procOptions.externalContexts...)
@@ -154,9 +153,7 @@ func (p *Processor) Compact(input, context map[string]interface{},
 
 	procOptions := prepareOpts(opts)
 
-	if procOptions.documentLoader != nil {
-		options.DocumentLoader = procOptions.documentLoader
-	}
+	options.DocumentLoader = procOptions.documentLoader
 
 	if context == nil {
 		inputContext := input["@context"]
@@ -246,5 +243,9 @@ func prepareOpts(opts []ProcessorOpts) *normalizeOpts {
 		opt(nOpts)
 	}
 
+	if nOpts.documentLoader == nil {
+		nOpts.documentLoader = DefaultDocumentLoader()
+	}
+
 	return nOpts
 }
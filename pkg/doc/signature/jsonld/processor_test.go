@@ -9,7 +9,6 @@ package jsonld
 import (
 	"encoding/json"
 	"log"
-	"net/http"
 	"strings"
 	"testing"
 
@@ -199,7 +198,7 @@ func TestCompact(t *testing.T) {
 }
 
 func createInMemoryDocumentLoader(url, inMemoryContext string) *ld.CachingDocumentLoader {
-	loader := ld.NewCachingDocumentLoader(ld.NewRFC7324CachingDocumentLoader(&http.Client{}))
+	loader := ld.NewCachingDocumentLoader(DefaultDocumentLoader())
 
 	reader, err := ld.DocumentFromReader(strings.NewReader(inMemoryContext))
 	if err != nil {
@@ -27,6 +27,12 @@ type signatureSuite interface {
 	CompactProof() bool
 }
 
+// proofTypeKeeper is implemented by signature suites which keep the proof type in the canonical proof options
+// (e.g. Ed25519Signature2020). Other suites exclude it.
+type proofTypeKeeper interface {
+	KeepProofType() bool
+}
+
 // SignatureRepresentation defines a representation of signature value.
 type SignatureRepresentation int
 
@@ -66,6 +72,11 @@ func CreateVerifyHash(suite signatureSuite, jsonldDoc, proofOptions map[string]i
 		proofOptions[jsonldContext] = jsonldDoc[jsonldContext]
 	}
 
+	previousProof, _ := proofOptions[jsonldPreviousProof].(string)
+	if previousProof != "" {
+		proofOptions[jsonldContext] = withPreviousProofContext(proofOptions[jsonldContext])
+	}
+
 	canonicalProofOptions, err := prepareCanonicalProofOptions(suite, proofOptions, opts...)
 	if err != nil {
 		return nil, err
@@ -73,7 +84,7 @@ func CreateVerifyHash(suite signatureSuite, jsonldDoc, proofOptions map[string]i
 
 	proofOptionsDigest := suite.GetDigest(canonicalProofOptions)
 
-	canonicalDoc, err := prepareCanonicalDocument(suite, jsonldDoc, opts...)
+	canonicalDoc, err := prepareCanonicalDocument(suite, jsonldDoc, previousProof, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -93,8 +104,12 @@ func prepareCanonicalProofOptions(suite signatureSuite, proofOptions map[string]
 	// copy from the original proof options map without specific keys
 	proofOptionsCopy := make(map[string]interface{}, len(proofOptions))
 
+	keeper, ok := suite.(proofTypeKeeper)
+	keepType := ok && keeper.KeepProofType()
+
 	for key, value := range proofOptions {
-		if excludedKeyFromString(key) == 0 {
+		ek := excludedKeyFromString(key)
+		if ek == 0 || (ek == proofType && keepType) {
 			proofOptionsCopy[key] = value
 		}
 	}
@@ -103,10 +118,13 @@ func prepareCanonicalProofOptions(suite signatureSuite, proofOptions map[string]
 	return suite.GetCanonicalDocument(proofOptionsCopy, opts...)
 }
 
-func prepareCanonicalDocument(suite signatureSuite, jsonldObject map[string]interface{},
+func prepareCanonicalDocument(suite signatureSuite, jsonldObject map[string]interface{}, previousProof string,
 	opts ...jsonld.ProcessorOpts) ([]byte, error) {
-	// copy document object without proof
-	docCopy := GetCopyWithoutProof(jsonldObject)
+	// copy document object without proof (but the previous one in case of proof chain)
+	docCopy, err := GetCopyWithPreviousProof(jsonldObject, previousProof)
+	if err != nil {
+		return nil, err
+	}
 
 	// build canonical document
 	return suite.GetCanonicalDocument(docCopy, opts...)
@@ -45,7 +45,7 @@ func TestPrepareCanonicalDocument(t *testing.T) {
 	err := json.Unmarshal([]byte(test1), &doc)
 	require.NoError(t, err)
 
-	normalizedDoc, err := prepareCanonicalDocument(&mockSignatureSuite{}, doc)
+	normalizedDoc, err := prepareCanonicalDocument(&mockSignatureSuite{}, doc, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, normalizedDoc)
 	require.Equal(t, test1Result, string(normalizedDoc))
@@ -65,6 +65,13 @@ func TestPrepareCanonicalProofOptions(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, canonicalProofOptions)
 
+	// proof type is a part of canonical proof options of some suites
+	canonicalProofOptionsWithType, err := prepareCanonicalProofOptions(&mockTypeKeeperSuite{}, proofOptions)
+	require.NoError(t, err)
+	require.NotEqual(t, canonicalProofOptions, canonicalProofOptionsWithType)
+	require.Contains(t, string(canonicalProofOptionsWithType), "<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>")
+	require.NotContains(t, string(canonicalProofOptions), "<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>")
+
 	// test missing created
 	delete(proofOptions, jsonldCreated)
 	canonicalProofOptions, err = prepareCanonicalProofOptions(&mockSignatureSuite{}, proofOptions)
@@ -127,6 +134,14 @@ func (s *mockSignatureSuite) CompactProof() bool {
 	return s.compactProof
 }
 
+type mockTypeKeeperSuite struct {
+	mockSignatureSuite
+}
+
+func (s *mockTypeKeeperSuite) KeepProofType() bool {
+	return true
+}
+
 //nolint:lll
 const validDoc = `{
   "@context": ["https://w3id.org/did/v1"],
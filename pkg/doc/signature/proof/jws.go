@@ -32,7 +32,9 @@ func CreateDetachedJWTHeader(p *Proof) string {
 	switch p.Type {
 	case "EcdsaSecp256k1Signature2019":
 		jwsAlg = "ES256K"
-	case "Ed25519Signature2018":
+	case "EcdsaSecp256r1Signature2019":
+		jwsAlg = "ES256"
+	case "Ed25519Signature2018", ed25519Signature2020:
 		jwsAlg = "EdDSA"
 	default:
 		jwsAlg = p.Type
@@ -88,7 +90,7 @@ func createVerifyJWS(suite signatureSuite, jsonldDoc map[string]interface{}, p *
 
 	proofOptionsDigest := suite.GetDigest(canonicalProofOptions)
 
-	canonicalDoc, err := prepareDocumentForJWS(suite, jsonldDoc, opts...)
+	canonicalDoc, err := prepareDocumentForJWS(suite, jsonldDoc, p.PreviousProof, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +111,11 @@ func prepareJWSProof(suite signatureSuite, proofOptions map[string]interface{},
 	opts ...jsonld.ProcessorOpts) ([]byte, error) {
 	// TODO proof contexts shouldn't be hardcoded in jws, should be passed in jsonld doc by author [Issue#1833]
 	proofOptions[jsonldContext] = []interface{}{securityContext, securityContextJWK2020}
+
+	if _, ok := proofOptions[jsonldPreviousProof]; ok {
+		proofOptions[jsonldContext] = withPreviousProofContext(proofOptions[jsonldContext])
+	}
+
 	proofOptionsCopy := make(map[string]interface{}, len(proofOptions))
 
 	for key, value := range proofOptions {
@@ -121,18 +128,19 @@ func prepareJWSProof(suite signatureSuite, proofOptions map[string]interface{},
 	return suite.GetCanonicalDocument(proofOptionsCopy, opts...)
 }
 
-func prepareDocumentForJWS(suite signatureSuite, jsonldObject map[string]interface{},
+func prepareDocumentForJWS(suite signatureSuite, jsonldObject map[string]interface{}, previousProof string,
 	opts ...jsonld.ProcessorOpts) ([]byte, error) {
-	// copy document object without proof
-	doc := GetCopyWithoutProof(jsonldObject)
+	// copy document object without proof (but the previous one in case of proof chain)
+	doc, err := GetCopyWithPreviousProof(jsonldObject, previousProof)
+	if err != nil {
+		return nil, err
+	}
 
 	if suite.CompactProof() {
-		docCompacted, err := getCompactedWithSecuritySchema(doc, opts...)
+		doc, err = getCompactedWithSecuritySchema(doc, opts...)
 		if err != nil {
 			return nil, err
 		}
-
-		doc = docCompacted
 	}
 
 	// build canonical document
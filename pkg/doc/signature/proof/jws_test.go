@@ -100,6 +100,16 @@ func TestCreateDetachedJWTHeader(t *testing.T) {
 	require.Equal(t, false, jwtHeaderMap["b64"])
 	require.Equal(t, []interface{}{"b64"}, jwtHeaderMap["crit"])
 
+	jwtHeader = CreateDetachedJWTHeader(&Proof{
+		Type: "EcdsaSecp256r1Signature2019",
+	})
+	require.Equal(t, "ES256", getJwtHeaderMap(jwtHeader)["alg"])
+
+	jwtHeader = CreateDetachedJWTHeader(&Proof{
+		Type: "Ed25519Signature2020",
+	})
+	require.Equal(t, "EdDSA", getJwtHeaderMap(jwtHeader)["alg"])
+
 	jwtHeader = CreateDetachedJWTHeader(&Proof{
 		Type: "JsonWebSignature2020",
 	})
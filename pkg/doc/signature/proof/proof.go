@@ -8,6 +8,10 @@ package proof
 import (
 	"encoding/base64"
 	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/multiformats/go-multibase"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
 )
@@ -33,10 +37,18 @@ const (
 	jsonldVerificationMethod = "verificationMethod"
 	// jsonldChallenge is a key for challenge.
 	jsonldChallenge = "challenge"
+	// jsonldID is a key for proof ID.
+	jsonldID = "id"
+	// jsonldPreviousProof is a key for ID of the previous proof in a proof chain.
+	jsonldPreviousProof = "previousProof"
+
+	// ed25519Signature2020 proofs hold the signature in "proofValue" as base58-btc multibase value.
+	ed25519Signature2020 = "Ed25519Signature2020"
 )
 
 // Proof is cryptographic proof of the integrity of the DID Document.
 type Proof struct {
+	ID                      string
 	Type                    string
 	Created                 *util.TimeWithTrailingZeroMsec
 	Creator                 string
@@ -47,6 +59,7 @@ type Proof struct {
 	Domain                  string
 	Nonce                   []byte
 	Challenge               string
+	PreviousProof           string
 	SignatureRepresentation SignatureRepresentation
 }
 
@@ -66,7 +79,7 @@ func NewProof(emap map[string]interface{}) (*Proof, error) {
 	)
 
 	if generalProof, ok := emap[jsonldProofValue]; ok {
-		proofValue, err = base64.RawURLEncoding.DecodeString(stringEntry(generalProof))
+		proofValue, err = decodeProofValue(stringEntry(generalProof), stringEntry(emap[jsonldType]))
 		if err != nil {
 			return nil, err
 		}
@@ -87,6 +100,7 @@ func NewProof(emap map[string]interface{}) (*Proof, error) {
 	}
 
 	return &Proof{
+		ID:                      stringEntry(emap[jsonldID]),
 		Type:                    stringEntry(emap[jsonldType]),
 		Created:                 timeValue,
 		Creator:                 stringEntry(emap[jsonldCreator]),
@@ -98,6 +112,7 @@ func NewProof(emap map[string]interface{}) (*Proof, error) {
 		Domain:                  stringEntry(emap[jsonldDomain]),
 		Nonce:                   nonce,
 		Challenge:               stringEntry(emap[jsonldChallenge]),
+		PreviousProof:           stringEntry(emap[jsonldPreviousProof]),
 	}, nil
 }
 
@@ -115,6 +130,10 @@ func (p *Proof) JSONLdObject() map[string]interface{} {
 	emap := make(map[string]interface{})
 	emap[jsonldType] = p.Type
 
+	if p.ID != "" {
+		emap[jsonldID] = p.ID
+	}
+
 	if p.Creator != "" {
 		emap[jsonldCreator] = p.Creator
 	}
@@ -128,7 +147,7 @@ func (p *Proof) JSONLdObject() map[string]interface{} {
 	}
 
 	if len(p.ProofValue) > 0 {
-		emap[jsonldProofValue] = base64.RawURLEncoding.EncodeToString(p.ProofValue)
+		emap[jsonldProofValue] = encodeProofValue(p.ProofValue, p.Type)
 	}
 
 	if len(p.JWS) > 0 {
@@ -151,9 +170,38 @@ func (p *Proof) JSONLdObject() map[string]interface{} {
 		emap[jsonldChallenge] = p.Challenge
 	}
 
+	if p.PreviousProof != "" {
+		emap[jsonldPreviousProof] = p.PreviousProof
+	}
+
 	return emap
 }
 
+func decodeProofValue(proofValue, proofType string) ([]byte, error) {
+	if proofType != ed25519Signature2020 {
+		return base64.RawURLEncoding.DecodeString(proofValue)
+	}
+
+	encoding, value, err := multibase.Decode(proofValue)
+	if err != nil {
+		return nil, fmt.Errorf("decode multibase proof value: %w", err)
+	}
+
+	if encoding != multibase.Base58BTC {
+		return nil, errors.New("proof value is not base58-btc multibase encoded")
+	}
+
+	return value, nil
+}
+
+func encodeProofValue(proofValue []byte, proofType string) string {
+	if proofType != ed25519Signature2020 {
+		return base64.RawURLEncoding.EncodeToString(proofValue)
+	}
+
+	return string(multibase.Base58BTC) + base58.Encode(proofValue)
+}
+
 // PublicKeyID provides ID of public key to be used to independently verify the proof.
 // "verificationMethod" field is checked first. If not empty, its value is returned.
 // Otherwise, "creator" field is returned if not empty. Otherwise, error is returned.
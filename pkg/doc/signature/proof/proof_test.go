@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
@@ -99,6 +100,41 @@ func TestInvalidProofValue(t *testing.T) {
 	require.Contains(t, err.Error(), "signature is not defined")
 }
 
+func TestMultibaseProofValue(t *testing.T) {
+	proofValueBytes, err := base64.RawURLEncoding.DecodeString(proofValueBase64)
+	require.NoError(t, err)
+
+	proofValueMultibase := "z" + base58.Encode(proofValueBytes)
+
+	p, err := NewProof(map[string]interface{}{
+		"type":               "Ed25519Signature2020",
+		"verificationMethod": "did:example:123456#key1",
+		"created":            "2018-03-15T00:00:00Z",
+		"proofValue":         proofValueMultibase,
+	})
+	require.NoError(t, err)
+	require.Equal(t, proofValueBytes, p.ProofValue)
+	require.Equal(t, SignatureProofValue, p.SignatureRepresentation)
+	require.Equal(t, proofValueMultibase, p.JSONLdObject()["proofValue"])
+
+	// base64url proof value is not a valid multibase value
+	_, err = NewProof(map[string]interface{}{
+		"type":       "Ed25519Signature2020",
+		"created":    "2018-03-15T00:00:00Z",
+		"proofValue": proofValueBase64,
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decode multibase proof value")
+
+	// multibase value of other than base58-btc encoding
+	_, err = NewProof(map[string]interface{}{
+		"type":       "Ed25519Signature2020",
+		"created":    "2018-03-15T00:00:00Z",
+		"proofValue": "u" + proofValueBase64,
+	})
+	require.EqualError(t, err, "proof value is not base58-btc multibase encoded")
+}
+
 func TestInvalidNonce(t *testing.T) {
 	p, err := NewProof(map[string]interface{}{
 		"type":       "Ed25519Signature2018",
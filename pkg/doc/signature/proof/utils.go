@@ -7,10 +7,13 @@ package proof
 
 import (
 	"errors"
+	"fmt"
 )
 
 const (
 	jsonldProof = "proof"
+
+	previousProofIRI = "https://w3id.org/security#previousProof"
 )
 
 // GetProofs gets proof(s) from LD Object.
@@ -87,5 +90,61 @@ func GetCopyWithoutProof(jsonLdObject map[string]interface{}) map[string]interfa
 	return dest
 }
 
+// GetCopyWithPreviousProof gets copy of JSON LD Object without proofs except the one with previousProof ID.
+// It is used to create verify data of a proof in a proof chain, which signs the document together with
+// the previous proof. If previousProof is empty, the copy without proofs is returned.
+func GetCopyWithPreviousProof(jsonLdObject map[string]interface{}, previousProof string) (map[string]interface{},
+	error) {
+	dest := GetCopyWithoutProof(jsonLdObject)
+
+	if previousProof == "" {
+		return dest, nil
+	}
+
+	entry, ok := jsonLdObject[jsonldProof]
+	if !ok {
+		return nil, fmt.Errorf("previous proof %s not found", previousProof)
+	}
+
+	proofs, ok := entry.([]interface{})
+	if !ok {
+		proofs = []interface{}{entry}
+	}
+
+	for _, p := range proofs {
+		proofMap, ok := p.(map[string]interface{})
+		if !ok || proofMap[jsonldID] != previousProof {
+			continue
+		}
+
+		dest[jsonldProof] = proofMap
+		dest[jsonldContext] = withPreviousProofContext(dest[jsonldContext])
+
+		return dest, nil
+	}
+
+	return nil, fmt.Errorf("previous proof %s not found", previousProof)
+}
+
+// withPreviousProofContext appends the definition of previousProof term to the JSON-LD context
+// as it's not defined by the contexts used by proofs.
+func withPreviousProofContext(context interface{}) []interface{} {
+	var contexts []interface{}
+
+	switch c := context.(type) {
+	case nil:
+	case []interface{}:
+		contexts = append(contexts, c...)
+	case []string:
+		for i := range c {
+			contexts = append(contexts, c[i])
+		}
+	default:
+		contexts = append(contexts, c)
+	}
+
+	return append(contexts, map[string]interface{}{jsonldPreviousProof: previousProofIRI})
+}
+
 // ErrProofNotFound is returned when proof is not found.
 var ErrProofNotFound = errors.New("proof not found")
@@ -63,6 +63,45 @@ func TestGetCopyWithoutProof(t *testing.T) {
 	require.True(t, reflect.DeepEqual(docCopy, getDefaultDoc()))
 }
 
+func TestGetCopyWithPreviousProof(t *testing.T) {
+	doc := getDefaultDoc()
+
+	now := time.Now()
+
+	for _, id := range []string{"urn:uuid:proof-1", "urn:uuid:proof-2"} {
+		require.NoError(t, AddProof(doc, &Proof{
+			ID:         id,
+			Creator:    "creator",
+			Created:    util.NewTime(now),
+			ProofValue: []byte("proof"),
+			Type:       "Ed25519Signature2018",
+		}))
+	}
+
+	docCopy, err := GetCopyWithPreviousProof(doc, "")
+	require.NoError(t, err)
+	require.Equal(t, GetCopyWithoutProof(doc), docCopy)
+
+	docCopy, err = GetCopyWithPreviousProof(doc, "urn:uuid:proof-1")
+	require.NoError(t, err)
+
+	proofs, err := GetProofs(docCopy)
+	require.NoError(t, err)
+	require.Len(t, proofs, 1)
+	require.Equal(t, "urn:uuid:proof-1", proofs[0].ID)
+
+	// previousProof term is defined in the context of the copy
+	contexts, ok := docCopy["@context"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, map[string]interface{}{"previousProof": previousProofIRI}, contexts[len(contexts)-1])
+
+	_, err = GetCopyWithPreviousProof(doc, "urn:uuid:proof-3")
+	require.EqualError(t, err, "previous proof urn:uuid:proof-3 not found")
+
+	_, err = GetCopyWithPreviousProof(getDefaultDoc(), "urn:uuid:proof-1")
+	require.EqualError(t, err, "previous proof urn:uuid:proof-1 not found")
+}
+
 func TestAddSingleProof(t *testing.T) {
 	doc := map[string]interface{}{
 		"test": "test",
@@ -52,7 +52,9 @@ type Context struct {
 	Nonce                   []byte                        // optional
 	VerificationMethod      string                        // optional
 	Challenge               string                        // optional
-	Purpose                 string                        // optional
+	Purpose                 string                        // optional, "assertionMethod" by default
+	ID                      string                        // optional
+	PreviousProof           string                        // optional, ID of the previous proof in a proof chain
 }
 
 // New returns new instance of document verifier.
@@ -101,6 +103,7 @@ func (signer *DocumentSigner) signObject(context *Context, jsonLdObject map[stri
 	}
 
 	p := &proof.Proof{
+		ID:                      context.ID,
 		Type:                    context.SignatureType,
 		SignatureRepresentation: context.SignatureRepresentation,
 		Creator:                 context.Creator,
@@ -110,10 +113,11 @@ func (signer *DocumentSigner) signObject(context *Context, jsonLdObject map[stri
 		VerificationMethod:      context.VerificationMethod,
 		Challenge:               context.Challenge,
 		ProofPurpose:            context.Purpose,
+		PreviousProof:           context.PreviousProof,
 	}
 
-	// TODO support custom proof purpose
-	//  (https://github.com/hyperledger/aries-framework-go/issues/1586)
+	// any proof purpose (e.g. "authentication", "capabilityInvocation") is accepted,
+	// it's the verifier who checks it against DID verification relationships of the signer
 	if p.ProofPurpose == "" {
 		p.ProofPurpose = defaultProofPurpose
 	}
@@ -62,6 +62,32 @@ func TestDocumentSigner_Sign(t *testing.T) {
 	require.Contains(t, proofMap, "jws")
 }
 
+func TestDocumentSigner_SignWithProofPurpose(t *testing.T) {
+	signer, err := newCryptoSigner(kmsapi.ED25519Type)
+	require.NoError(t, err)
+
+	s := New(ed25519signature2018.New(suite.WithSigner(signer)))
+
+	for _, purpose := range []string{"authentication", "capabilityInvocation", "capabilityDelegation"} {
+		context := getSignatureContext()
+		context.Purpose = purpose
+
+		signedDoc, err := s.Sign(context, []byte(validDoc))
+		require.NoError(t, err)
+
+		var signedMap map[string]interface{}
+		require.NoError(t, json.Unmarshal(signedDoc, &signedMap))
+
+		proofs, ok := signedMap["proof"].([]interface{})
+		require.True(t, ok)
+		require.Len(t, proofs, 1)
+
+		proofMap, ok := proofs[0].(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, purpose, proofMap["proofPurpose"])
+	}
+}
+
 func TestDocumentSigner_SignErrors(t *testing.T) {
 	context := getSignatureContext()
 	signer, err := newCryptoSigner(kmsapi.ED25519Type)
@@ -0,0 +1,60 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package ecdsasecp256r1signature2019 implements the EcdsaSecp256r1Signature2019 signature suite
+// for the Linked Data Signatures specification (https://w3c-ccg.github.io/ld-cryptosuite-registry/).
+// It uses the RDF Dataset Normalization Algorithm to transform the input document into its canonical form.
+// It uses SHA-256 [RFC6234] as the message digest algorithm and ECDSA with P-256 curve as the signature algorithm.
+// The suite terms are defined by the W3C credentials (https://www.w3.org/2018/credentials/v1)
+// and security (https://w3id.org/security/v2) contexts.
+package ecdsasecp256r1signature2019
+
+import (
+	"crypto/sha256"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+)
+
+// Suite implements EcdsaSecp256r1Signature2019 signature suite.
+type Suite struct {
+	suite.SignatureSuite
+	jsonldProcessor *jsonld.Processor
+}
+
+const (
+	// SignatureType is the EcdsaSecp256r1Signature2019 signature type.
+	SignatureType = "EcdsaSecp256r1Signature2019"
+	// VerificationKeyType is the type of the public key verifying EcdsaSecp256r1Signature2019 proofs.
+	VerificationKeyType = "EcdsaSecp256r1VerificationKey2019"
+	rdfDataSetAlg       = "URDNA2015"
+)
+
+// New an instance of EcdsaSecp256r1Signature2019 signature suite.
+func New(opts ...suite.Opt) *Suite {
+	s := &Suite{jsonldProcessor: jsonld.NewProcessor(rdfDataSetAlg)}
+
+	suite.InitSuiteOptions(&s.SignatureSuite, opts...)
+
+	return s
+}
+
+// GetCanonicalDocument will return normalized/canonical version of the document.
+// EcdsaSecp256r1Signature2019 signature suite uses RDF Dataset Normalization as canonicalization algorithm.
+func (s *Suite) GetCanonicalDocument(doc map[string]interface{}, opts ...jsonld.ProcessorOpts) ([]byte, error) {
+	return s.jsonldProcessor.GetCanonicalDocument(doc, opts...)
+}
+
+// GetDigest returns document digest.
+func (s *Suite) GetDigest(doc []byte) []byte {
+	digest := sha256.Sum256(doc)
+	return digest[:]
+}
+
+// Accept will accept only EcdsaSecp256r1Signature2019 signature type.
+func (s *Suite) Accept(t string) bool {
+	return t == SignatureType
+}
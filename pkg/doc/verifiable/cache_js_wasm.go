@@ -9,10 +9,22 @@ package verifiable
 import "time"
 
 // NewExpirableSchemaCache creates new instance of ExpirableSchemaCache.
+// fastcache is not available in JS/WASM build, so the returned cache does not keep any schema.
+// Use StoreSchemaCache (e.g. with jsindexeddb storage) to cache the schemas instead.
 func NewExpirableSchemaCache(size int, expiration time.Duration) *ExpirableSchemaCache {
-	// TODO Add cache implementation for VC wasm https://github.com/hyperledger/aries-framework-go/issues/1009
 	return &ExpirableSchemaCache{
-		cache:      nil,
+		cache:      noopCache{},
 		expiration: expiration,
 	}
 }
+
+// noopCache is a cache which keeps nothing.
+type noopCache struct{}
+
+func (noopCache) Set(k, v []byte) {}
+
+func (noopCache) HasGet(dst, k []byte) ([]byte, bool) {
+	return dst, false
+}
+
+func (noopCache) Del(k []byte) {}
@@ -17,13 +17,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/piprate/json-gold/ld"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/xeipuuv/gojsonschema"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
-	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 // TODO https://github.com/square/go-jose/issues/263 support ES256K
@@ -73,37 +74,21 @@ func SingleKey(pubKey []byte, pubKeyType string) PublicKeyFetcher {
 }
 
 // DIDKeyResolver resolves DID in order to find public keys for VC verification using vdri.Registry.
+// The key ID references the verification method of the DID document as DID URL, it is dereferenced
+// by vdri.Dereferencer and key IDs of other DIDs are rejected.
 // A source of DID could be issuer of VC or holder of VP. It can be also obtained from
 // JWS "issuer" claim or "verificationMethod" of Linked Data Proof.
 type DIDKeyResolver struct {
-	vdriRegistry vdri.Registry
+	vdriRegistry vdriapi.Registry
 }
 
 // NewDIDKeyResolver creates DIDKeyResolver.
-func NewDIDKeyResolver(vdriRegistry vdri.Registry) *DIDKeyResolver {
+func NewDIDKeyResolver(vdriRegistry vdriapi.Registry) *DIDKeyResolver {
 	return &DIDKeyResolver{vdriRegistry: vdriRegistry}
 }
 
 func (r *DIDKeyResolver) resolvePublicKey(issuerDID, keyID string) (*verifier.PublicKey, error) {
-	doc, err := r.vdriRegistry.Resolve(issuerDID)
-	if err != nil {
-		return nil, fmt.Errorf("resolve DID %s: %w", issuerDID, err)
-	}
-
-	methods := doc.VerificationMethods()
-	for _, verificationMethods := range methods {
-		for _, vm := range verificationMethods {
-			if strings.Contains(vm.PublicKey.ID, keyID) {
-				return &verifier.PublicKey{
-					Type:  vm.PublicKey.Type,
-					Value: vm.PublicKey.Value,
-					JWK:   vm.PublicKey.JSONWebKey(),
-				}, nil
-			}
-		}
-	}
-
-	return nil, fmt.Errorf("public key with KID %s is not found for DID %s", keyID, issuerDID)
+	return jwt.NewDIDKeyResolver(r.vdriRegistry).Resolve(issuerDID, keyID)
 }
 
 // PublicKeyFetcher returns Public Key Fetcher via DID resolution mechanism.
@@ -180,6 +165,27 @@ func describeSchemaValidationError(result *gojsonschema.Result, what string) str
 	return errMsg
 }
 
+// describeJSONSchema2020ValidationError describes the leaf errors of JSON Schema (2019-09 and later) validation.
+func describeJSONSchema2020ValidationError(validationErr *jsonschema.ValidationError, what string) string {
+	errMsg := what + " is not valid:\n"
+
+	var describe func(ve *jsonschema.ValidationError)
+
+	describe = func(ve *jsonschema.ValidationError) {
+		if len(ve.Causes) == 0 {
+			errMsg += fmt.Sprintf("- %s: %s\n", ve.InstanceLocation, ve.Message)
+		}
+
+		for _, cause := range ve.Causes {
+			describe(cause)
+		}
+	}
+
+	describe(validationErr)
+
+	return errMsg
+}
+
 func stringSlice(values []interface{}) ([]string, error) {
 	s := make([]string, len(values))
 
@@ -266,6 +266,11 @@ func TestDIDKeyResolver_Resolve(t *testing.T) {
 	r.Equal(assertionMethod.PublicKey.Value, assertMethPubKey.Value)
 	r.Equal("Ed25519VerificationKey2018", assertMethPubKey.Type)
 
+	pubKey, err = resolver.PublicKeyFetcher()(didDoc.ID, "did:test:8STcrCQFzFxKey7YSbj62A#keys-2")
+	r.EqualError(err, fmt.Sprintf("public key with KID did:test:8STcrCQFzFxKey7YSbj62A#keys-2 is not found for DID %s",
+		didDoc.ID))
+	r.Nil(pubKey)
+
 	pubKey, err = resolver.PublicKeyFetcher()(didDoc.ID, "invalid key")
 	r.Error(err)
 	r.EqualError(err, fmt.Sprintf("public key with KID invalid key is not found for DID %s", didDoc.ID))
@@ -287,8 +292,8 @@ func createDIDDoc() *did.Doc {
   "id": "did:test:2WxUJa8nVjXr5yS69JWoKZ",
   "publicKey": [
     {
-      "controller": "did:test:8STcrCQFzFxKey7YSbj62A",
-      "id": "did:test:8STcrCQFzFxKey7YSbj62A#keys-1",
+      "controller": "did:test:2WxUJa8nVjXr5yS69JWoKZ",
+      "id": "did:test:2WxUJa8nVjXr5yS69JWoKZ#keys-2",
       "publicKeyJwk": {
         "kty": "OKP",
         "crv": "Ed25519",
@@ -325,9 +330,9 @@ func createDIDDoc() *did.Doc {
   ],
   "assertionMethod": [
     {
-      "id": "did:v1:test:nym:z6MkfG5HTrBXzsAP8AbayNpG3ZaoyM4PCqNPrdWQRSpHDV6J#z6MkqfvdBsFw4QdGrZrnx7L1EKfY5zh9tT4gumUGsMMEZHY3",
+      "id": "did:test:2WxUJa8nVjXr5yS69JWoKZ#z6MkqfvdBsFw4QdGrZrnx7L1EKfY5zh9tT4gumUGsMMEZHY3",
       "type": "Ed25519VerificationKey2018",
-      "controller": "did:v1:test:nym:z6MkfG5HTrBXzsAP8AbayNpG3ZaoyM4PCqNPrdWQRSpHDV6J",
+      "controller": "did:test:2WxUJa8nVjXr5yS69JWoKZ",
       "publicKeyBase58": "CDfabd1Vis8ok526GYNAPE7YGRRJUZpLDkZM35PDe4kf"
     }
   ],
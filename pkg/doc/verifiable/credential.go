@@ -6,22 +6,28 @@ SPDX-License-Identifier: Apache-2.0
 package verifiable
 
 import (
+	"bytes"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/piprate/json-gold/ld"
+	"github.com/santhosh-tekuri/jsonschema/v5"
 	"github.com/xeipuuv/gojsonschema"
 
 	"github.com/hyperledger/aries-framework-go/pkg/common/log"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 var logger = log.New("aries-framework/doc/verifiable")
@@ -229,6 +235,21 @@ const defaultSchema = `{
 // https://www.w3.org/TR/vc-data-model/#data-schemas
 const jsonSchema2018Type = "JsonSchemaValidator2018"
 
+// https://w3c.github.io/vc-json-schema/
+const (
+	// the credential schema ID is the URL of JSON Schema (2020-12 or earlier draft).
+	jsonSchema2020Type = "JsonSchema2020"
+
+	// the credential schema ID is the URL of Verifiable Credential holding JSON Schema in its subject.
+	jsonSchemaCredentialType = "JsonSchemaCredential"
+
+	// the subject field of JsonSchemaCredential holding JSON Schema.
+	jsonSchemaField = "jsonSchema"
+
+	// the meta-schema URL prefix of JSON Schema 2019-09 draft and later which are validated by jsonschema package.
+	jsonSchemaDraftURL = "json-schema.org/draft/"
+)
+
 const (
 	// https://www.w3.org/TR/vc-data-model/#base-context
 	baseContext = "https://www.w3.org/2018/credentials/v1"
@@ -352,18 +373,7 @@ func (b *CredentialSchemaLoaderBuilder) Build() *CredentialSchemaLoader {
 
 // Put element to the cache. It also adds a mark of when the element will expire.
 func (sc *ExpirableSchemaCache) Put(k string, v []byte) {
-	expires := time.Now().Add(sc.expiration).Unix()
-
-	const numBytesTime = 8
-
-	b := make([]byte, numBytesTime)
-	binary.LittleEndian.PutUint64(b, uint64(expires))
-
-	ve := make([]byte, numBytesTime+len(v))
-	copy(ve[:numBytesTime], b)
-	copy(ve[numBytesTime:], v)
-
-	sc.cache.Set([]byte(k), ve)
+	sc.cache.Set([]byte(k), withExpiration(v, sc.expiration))
 }
 
 // Get element from the cache. If element is present, it checks if the element is expired.
@@ -374,16 +384,41 @@ func (sc *ExpirableSchemaCache) Get(k string) ([]byte, bool) {
 		return nil, false
 	}
 
-	const numBytesTime = 8
-
-	expires := int64(binary.LittleEndian.Uint64(b[:numBytesTime]))
-	if expires < time.Now().Unix() {
+	v, ok := notExpired(b)
+	if !ok {
 		// cache expires
 		sc.cache.Del([]byte(k))
 		return nil, false
 	}
 
-	return b[numBytesTime:], true
+	return v, true
+}
+
+const numBytesTime = 8
+
+// withExpiration prepends the value with the time when it will expire.
+func withExpiration(v []byte, expiration time.Duration) []byte {
+	expires := time.Now().Add(expiration).Unix()
+
+	ve := make([]byte, numBytesTime+len(v))
+	binary.LittleEndian.PutUint64(ve[:numBytesTime], uint64(expires))
+	copy(ve[numBytesTime:], v)
+
+	return ve
+}
+
+// notExpired returns the value prepended by withExpiration if it is not expired yet.
+func notExpired(ve []byte) ([]byte, bool) {
+	if len(ve) < numBytesTime {
+		return nil, false
+	}
+
+	expires := int64(binary.LittleEndian.Uint64(ve[:numBytesTime]))
+	if expires < time.Now().Unix() {
+		return nil, false
+	}
+
+	return ve[numBytesTime:], true
 }
 
 // Evidence defines evidence of Verifiable Credential.
@@ -578,6 +613,10 @@ type credentialOpts struct {
 	disabledProofCheck    bool
 	strictValidation      bool
 	ldpSuites             []verifier.SignatureSuite
+	statusVerifier        *StatusListVerifier
+	vdriRegistry          vdri.Registry
+	proofPolicy           *ProofPolicy
+	issuerTrustChecker    IssuerTrustChecker
 
 	jsonldCredentialOpts
 }
@@ -654,7 +693,7 @@ func WithBaseContextExtendedValidation(customContexts, customTypes []string) Cre
 }
 
 // WithJSONLDDocumentLoader defines custom JSON-LD document loader. If not defined, when decoding VC
-// a new document loader will be created using CachingJSONLDLoader() if JSON-LD validation is made.
+// jsonld.DefaultDocumentLoader() is used if JSON-LD validation is made.
 func WithJSONLDDocumentLoader(documentLoader ld.DocumentLoader) CredentialOpt {
 	return func(opts *credentialOpts) {
 		opts.jsonldDocumentLoader = documentLoader
@@ -696,6 +735,46 @@ func WithEmbeddedSignatureSuites(suites ...verifier.SignatureSuite) CredentialOp
 	}
 }
 
+// WithStatusCheck option enables the check of credential status (e.g. revocation) using status list
+// credential referred by credentialStatus of VC. Proof of the status list credential is checked
+// using the same options as for VC unless they are overridden in StatusListVerifier.
+func WithStatusCheck(statusVerifier *StatusListVerifier) CredentialOpt {
+	return func(opts *credentialOpts) {
+		opts.statusVerifier = statusVerifier
+	}
+}
+
+// WithProofPurposeCheck option enables the check of linked data proof purpose. DID of the proof's
+// verification method is resolved using vdri.Registry and the method must be listed under the DID
+// verification relationship which corresponds to the proof purpose (e.g. "assertionMethod").
+// In case of JWS, the key which signed JWT must belong to the issuer and be listed under "assertionMethod".
+func WithProofPurposeCheck(vdriRegistry vdri.Registry) CredentialOpt {
+	return func(opts *credentialOpts) {
+		opts.vdriRegistry = vdriRegistry
+	}
+}
+
+// WithProofPolicy option defines the embedded proofs which must be present in VC (e.g. proof types, signers
+// or a proof chain). VC is rejected if its proofs do not satisfy the policy. VC secured by JWS is rejected
+// as it has no embedded proofs.
+func WithProofPolicy(policy *ProofPolicy) CredentialOpt {
+	return func(opts *credentialOpts) {
+		opts.proofPolicy = policy
+	}
+}
+
+// IssuerTrustChecker checks that the issuer is trusted to issue the credential (e.g. using a trust registry).
+type IssuerTrustChecker interface {
+	CheckIssuerTrust(vc *Credential) error
+}
+
+// WithIssuerTrustCheck option enables the check that VC issuer is trusted to issue VC of its types and contexts.
+func WithIssuerTrustCheck(checker IssuerTrustChecker) CredentialOpt {
+	return func(opts *credentialOpts) {
+		opts.issuerTrustChecker = checker
+	}
+}
+
 // parseIssuer parses raw issuer.
 //
 // Issuer can be defined by:
@@ -813,6 +892,20 @@ func ParseCredential(vcData []byte, opts ...CredentialOpt) (*Credential, error)
 		return nil, err
 	}
 
+	if vcOpts.statusVerifier != nil {
+		err = vcOpts.statusVerifier.check(vc, vcOpts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if vcOpts.issuerTrustChecker != nil {
+		err = vcOpts.issuerTrustChecker.CheckIssuerTrust(vc)
+		if err != nil {
+			return nil, fmt.Errorf("check issuer trust: %w", err)
+		}
+	}
+
 	return vc, nil
 }
 
@@ -1038,11 +1131,16 @@ func decodeRaw(vcData []byte, vcOpts *credentialOpts) ([]byte, error) {
 	vcStr := string(vcData)
 
 	if jwt.IsJWS(vcStr) { // External proof, is checked by JWS.
+		if vcOpts.proofPolicy != nil && !vcOpts.disabledProofCheck {
+			return nil, errProofPolicyForJWS
+		}
+
 		if vcOpts.publicKeyFetcher == nil && !vcOpts.disabledProofCheck {
 			return nil, errors.New("public key fetcher is not defined")
 		}
 
-		vcDecodedBytes, err := decodeCredJWS(vcStr, !vcOpts.disabledProofCheck, vcOpts.publicKeyFetcher)
+		vcDecodedBytes, err := decodeCredJWS(vcStr, !vcOpts.disabledProofCheck, vcOpts.publicKeyFetcher,
+			vcOpts.vdriRegistry)
 		if err != nil {
 			return nil, fmt.Errorf("JWS decoding: %w", err)
 		}
@@ -1068,6 +1166,8 @@ func getEmbeddedProofCheckOpts(vcOpts *credentialOpts) *embeddedProofCheckOpts {
 		publicKeyFetcher:     vcOpts.publicKeyFetcher,
 		disabledProofCheck:   vcOpts.disabledProofCheck,
 		ldpSuites:            vcOpts.ldpSuites,
+		vdriRegistry:         vcOpts.vdriRegistry,
+		proofPolicy:          vcOpts.proofPolicy,
 		jsonldCredentialOpts: vcOpts.jsonldCredentialOpts,
 	}
 }
@@ -1086,7 +1186,7 @@ func getCredentialOpts(opts []CredentialOpt) *credentialOpts {
 	}
 
 	if crOpts.jsonldDocumentLoader == nil {
-		crOpts.jsonldDocumentLoader = CachingJSONLDLoader()
+		crOpts.jsonldDocumentLoader = jsonld.DefaultDocumentLoader()
 	}
 
 	return crOpts
@@ -1191,11 +1291,22 @@ func (vc *Credential) validateJSONSchema(data []byte, opts *credentialOpts) erro
 func validateCredentialUsingJSONSchema(data []byte, schemas []TypedID, opts *credentialOpts) error {
 	// Validate that the Verifiable Credential conforms to the serialization of the Verifiable Credential data model
 	// (https://w3c.github.io/vc-data-model/#example-1-a-simple-example-of-a-verifiable-credential)
-	schemaLoader, err := getSchemaLoader(schemas, opts)
+	schemaID, customSchemaData, err := getCustomSchema(schemas, opts)
 	if err != nil {
 		return err
 	}
 
+	schemaLoader := defaultSchemaLoader()
+
+	if customSchemaData != nil {
+		// gojsonschema supports draft-07 and earlier only and would silently ignore the keywords introduced later
+		if isJSONSchema2019OrLater(customSchemaData) {
+			return validateUsingJSONSchema2020(data, schemaID, customSchemaData)
+		}
+
+		schemaLoader = gojsonschema.NewBytesLoader(customSchemaData)
+	}
+
 	loader := gojsonschema.NewStringLoader(string(data))
 
 	result, err := gojsonschema.Validate(schemaLoader, loader)
@@ -1211,27 +1322,86 @@ func validateCredentialUsingJSONSchema(data []byte, schemas []TypedID, opts *cre
 	return nil
 }
 
-func getSchemaLoader(schemas []TypedID, opts *credentialOpts) (gojsonschema.JSONLoader, error) {
+// getCustomSchema returns ID and content of the first supported custom credential schema. Nil content is returned
+// if there is no such schema or the custom schema check is disabled.
+func getCustomSchema(schemas []TypedID, opts *credentialOpts) (string, []byte, error) {
 	if opts.disabledCustomSchema {
-		return defaultSchemaLoader(), nil
+		return "", nil, nil
 	}
 
 	for _, schema := range schemas {
 		switch schema.Type {
-		case jsonSchema2018Type:
+		case jsonSchema2018Type, jsonSchema2020Type:
 			customSchemaData, err := getJSONSchema(schema.ID, opts)
 			if err != nil {
-				return nil, fmt.Errorf("load of custom credential schema from %s: %w", schema.ID, err)
+				return "", nil, fmt.Errorf("load of custom credential schema from %s: %w", schema.ID, err)
 			}
 
-			return gojsonschema.NewBytesLoader(customSchemaData), nil
+			return schema.ID, customSchemaData, nil
+		case jsonSchemaCredentialType:
+			customSchemaData, err := getJSONSchemaFromCredential(schema.ID, opts)
+			if err != nil {
+				return "", nil, fmt.Errorf("load of custom credential schema from %s: %w", schema.ID, err)
+			}
+
+			return schema.ID, customSchemaData, nil
 		default:
 			logger.Warnf("unsupported credential schema: %s. Using default schema for validation", schema.Type)
 		}
 	}
 
 	// If no custom schema is chosen, use default one
-	return defaultSchemaLoader(), nil
+	return "", nil, nil
+}
+
+// isJSONSchema2019OrLater checks if JSON Schema is of 2019-09 draft or later (e.g. 2020-12).
+func isJSONSchema2019OrLater(schemaData []byte) bool {
+	var schema struct {
+		Draft string `json:"$schema"`
+	}
+
+	// the schema which is not a JSON object is reported by the validator
+	return json.Unmarshal(schemaData, &schema) == nil && strings.Contains(schema.Draft, jsonSchemaDraftURL)
+}
+
+// validateUsingJSONSchema2020 validates the credential using JSON Schema of 2019-09 or 2020-12 draft.
+// The schemas referenced by the custom schema are not loaded.
+func validateUsingJSONSchema2020(data []byte, schemaID string, schemaData []byte) error {
+	compiler := jsonschema.NewCompiler()
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("load of referenced schema %s is not supported", url)
+	}
+
+	if err := compiler.AddResource(schemaID, bytes.NewReader(schemaData)); err != nil {
+		return fmt.Errorf("load of custom credential schema from %s: %w", schemaID, err)
+	}
+
+	schema, err := compiler.Compile(schemaID)
+	if err != nil {
+		return fmt.Errorf("compile custom credential schema from %s: %w", schemaID, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var doc interface{}
+
+	if err = decoder.Decode(&doc); err != nil {
+		return fmt.Errorf("validation of verifiable credential: %w", err)
+	}
+
+	err = schema.Validate(doc)
+
+	var validationErr *jsonschema.ValidationError
+	if errors.As(err, &validationErr) {
+		return errors.New(describeJSONSchema2020ValidationError(validationErr, "verifiable credential"))
+	}
+
+	if err != nil {
+		return fmt.Errorf("validation of verifiable credential: %w", err)
+	}
+
+	return nil
 }
 
 func defaultSchemaLoader() gojsonschema.JSONLoader {
@@ -1239,11 +1409,28 @@ func defaultSchemaLoader() gojsonschema.JSONLoader {
 }
 
 func getJSONSchema(url string, opts *credentialOpts) ([]byte, error) {
-	loader := opts.schemaLoader
-	cache := loader.cache
+	return getCachedJSONSchema(url, opts, func() ([]byte, error) {
+		return loadJSONSchema(url, opts.schemaLoader.schemaDownloadClient)
+	})
+}
+
+// getJSONSchemaFromCredential loads JsonSchemaCredential and returns JSON Schema held in its subject.
+func getJSONSchemaFromCredential(url string, opts *credentialOpts) ([]byte, error) {
+	return getCachedJSONSchema(url, opts, func() ([]byte, error) {
+		vcBytes, err := loadJSONSchema(url, opts.schemaLoader.schemaDownloadClient)
+		if err != nil {
+			return nil, err
+		}
+
+		return jsonSchemaFromCredential(vcBytes, opts)
+	})
+}
+
+func getCachedJSONSchema(url string, opts *credentialOpts, load func() ([]byte, error)) ([]byte, error) {
+	cache := opts.schemaLoader.cache
 
 	if cache == nil {
-		return loadJSONSchema(url, loader.schemaDownloadClient)
+		return load()
 	}
 
 	// Check the cache first.
@@ -1251,7 +1438,7 @@ func getJSONSchema(url string, opts *credentialOpts) ([]byte, error) {
 		return cachedBytes, nil
 	}
 
-	schemaBytes, err := loadJSONSchema(url, loader.schemaDownloadClient)
+	schemaBytes, err := load()
 	if err != nil {
 		return nil, err
 	}
@@ -1262,6 +1449,39 @@ func getJSONSchema(url string, opts *credentialOpts) ([]byte, error) {
 	return schemaBytes, nil
 }
 
+func jsonSchemaFromCredential(vcBytes []byte, vcOpts *credentialOpts) ([]byte, error) {
+	schemaVC, err := ParseCredential(vcBytes, func(opts *credentialOpts) {
+		*opts = *vcOpts
+		// the schema credential itself is validated against the default model
+		opts.disabledCustomSchema = true
+		opts.modelValidationMode = combinedValidation
+		// the checks of the credential being parsed do not apply to its schema credential
+		opts.statusVerifier = nil
+		opts.proofPolicy = nil
+		opts.issuerTrustChecker = nil
+		opts.vdriRegistry = nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse credential schema credential: %w", err)
+	}
+
+	if !hasType(schemaVC.Types, jsonSchemaCredentialType) {
+		return nil, fmt.Errorf("credential schema credential is not of %s type", jsonSchemaCredentialType)
+	}
+
+	subjects, ok := schemaVC.Subject.([]Subject)
+	if !ok || len(subjects) != 1 {
+		return nil, errors.New("credential schema credential must have a single subject")
+	}
+
+	jsonSchema, ok := subjects[0].CustomFields[jsonSchemaField].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s is not defined in credential schema credential", jsonSchemaField)
+	}
+
+	return json.Marshal(jsonSchema)
+}
+
 func loadJSONSchema(url string, client *http.Client) ([]byte, error) {
 	resp, err := client.Get(url)
 	if err != nil {
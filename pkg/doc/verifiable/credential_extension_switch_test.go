@@ -157,16 +157,6 @@ func (fp *FailingCredentialProducer) Apply(*Credential, []byte) (interface{}, er
 	return nil, errors.New("failed to apply credential extension")
 }
 
-func hasType(allTypes []string, targetType string) bool {
-	for _, thatType := range allTypes {
-		if thatType == targetType {
-			return true
-		}
-	}
-
-	return false
-}
-
 func TestCredentialExtensibilitySwitch(t *testing.T) {
 	producers := []CustomCredentialProducer{NewCred1Producer(), NewCred2Producer()}
 
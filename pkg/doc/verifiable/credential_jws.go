@@ -5,6 +5,11 @@ SPDX-License-Identifier: Apache-2.0
 
 package verifiable
 
+import (
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
 // MarshalJWS serializes JWT into signed form (JWS).
 func (jcc *JWTCredClaims) MarshalJWS(signatureAlg JWSAlgorithm, signer Signer, keyID string) (string, error) {
 	return marshalJWS(jcc, signatureAlg, signer, keyID)
@@ -21,8 +26,29 @@ func unmarshalJWSClaims(rawJwt string, checkProof bool, fetcher PublicKeyFetcher
 	return &claims, err
 }
 
-func decodeCredJWS(rawJwt string, checkProof bool, fetcher PublicKeyFetcher) ([]byte, error) {
+// decodeCredJWS decodes VC from JWS. If VDRI registry is defined, the key which signed JWS must be
+// authorized for issuing assertions by the issuer's DID.
+func decodeCredJWS(rawJwt string, checkProof bool, fetcher PublicKeyFetcher,
+	vdriRegistry vdri.Registry) ([]byte, error) {
+	var keyID string
+
+	// remember the key which signed JWT to check it against the proof purpose
+	keyFetcher := func(issuerID, kid string) (*verifier.PublicKey, error) {
+		keyID = kid
+
+		return fetcher(issuerID, kid)
+	}
+
 	return decodeCredJWT(rawJwt, func(vcJWTBytes string) (*JWTCredClaims, error) {
-		return unmarshalJWSClaims(rawJwt, checkProof, fetcher)
+		claims, err := unmarshalJWSClaims(rawJwt, checkProof, keyFetcher)
+		if err != nil || !checkProof || vdriRegistry == nil {
+			return claims, err
+		}
+
+		if err := checkJWTProofPurpose(keyID, claims.Issuer, AssertionMethodProofPurpose, vdriRegistry); err != nil {
+			return nil, err
+		}
+
+		return claims, nil
 	})
 }
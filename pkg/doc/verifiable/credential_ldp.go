@@ -11,8 +11,15 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 )
 
-// AddLinkedDataProof appends proof to the Verifiable Credential.
+// AddLinkedDataProof appends proof to the Verifiable Credential. The proofs which are already present are
+// kept (proof set). If PreviousProof of the context is set, the new proof also signs the referenced proof,
+// so the proofs form a proof chain. The JSON-LD context of the signature suite is added if it is missing
+// and there are no proofs yet.
 func (vc *Credential) AddLinkedDataProof(context *LinkedDataProofContext, jsonldOpts ...jsonld.ProcessorOpts) error {
+	if len(vc.Proofs) == 0 {
+		vc.Context = withSuiteContext(vc.Context, context.SignatureType)
+	}
+
 	vcBytes, err := vc.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("add linked data proof to VC: %w", err)
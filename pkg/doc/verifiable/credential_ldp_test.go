@@ -27,6 +27,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/jsonwebsignature2020"
 	sigverifier "github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util/signature"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
 	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
 )
@@ -951,6 +952,105 @@ func TestParseCredentialWithSeveralLinkedDataProofs(t *testing.T) {
 	r.Equal(vc, vcWithLdp)
 }
 
+func TestParseCredentialWithLinkedDataProofChain(t *testing.T) {
+	r := require.New(t)
+
+	issuerSigner, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	notarySigner, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	newSuite := func(signer signature.Signer) *ed25519signature2018.Suite {
+		return ed25519signature2018.New(
+			suite.WithSigner(signer),
+			suite.WithVerifier(ed25519signature2018.NewPublicKeyVerifier()))
+	}
+
+	vc, err := parseTestCredential([]byte(validCredential))
+	r.NoError(err)
+
+	err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+		ID:                      "urn:uuid:issuer-proof",
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: SignatureProofValue,
+		Suite:                   newSuite(issuerSigner),
+		VerificationMethod:      "did:example:issuer#key1",
+	}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+	r.NoError(err)
+
+	err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+		ID:                      "urn:uuid:notary-proof",
+		PreviousProof:           "urn:uuid:issuer-proof",
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: SignatureJWS,
+		Suite:                   newSuite(notarySigner),
+		VerificationMethod:      "did:example:notary#key1",
+	}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+	r.NoError(err)
+	r.Len(vc.Proofs, 2)
+	r.Equal("urn:uuid:issuer-proof", vc.Proofs[1]["previousProof"])
+
+	vcBytes, err := json.Marshal(vc)
+	r.NoError(err)
+
+	pubKeyFetcher := func(issuerID, keyID string) (*sigverifier.PublicKey, error) {
+		switch issuerID {
+		case "did:example:issuer":
+			return &sigverifier.PublicKey{Type: "Ed25519Signature2018", Value: issuerSigner.PublicKeyBytes()}, nil
+		case "did:example:notary":
+			return &sigverifier.PublicKey{Type: "Ed25519Signature2018", Value: notarySigner.PublicKeyBytes()}, nil
+		}
+
+		return nil, errors.New("unsupported issuer")
+	}
+
+	t.Run("proof chain satisfies the policy", func(t *testing.T) {
+		vcWithLdp, err := parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(pubKeyFetcher),
+			WithProofPolicy(&ProofPolicy{
+				Chain:           true,
+				MinProofs:       2,
+				RequiredTypes:   []string{"Ed25519Signature2018"},
+				RequiredSigners: []string{"did:example:issuer", "did:example:notary"},
+			}))
+		require.NoError(t, err)
+		require.Equal(t, vc, vcWithLdp)
+	})
+
+	t.Run("chained proof does not verify without the previous proof", func(t *testing.T) {
+		vcMap := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal(vcBytes, &vcMap))
+
+		notaryProof, ok := vcMap["proof"].([]interface{})[1].(map[string]interface{})
+		require.True(t, ok)
+
+		vcMap["proof"] = notaryProof
+
+		vcBytes, err := json.Marshal(vcMap)
+		require.NoError(t, err)
+
+		_, err = parseTestCredential(vcBytes, WithPublicKeyFetcher(pubKeyFetcher))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "previous proof urn:uuid:issuer-proof not found")
+	})
+
+	t.Run("policy is not satisfied", func(t *testing.T) {
+		_, err := parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(pubKeyFetcher),
+			WithProofPolicy(&ProofPolicy{RequiredSigners: []string{"did:example:auditor"}}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proof signed by did:example:auditor is required")
+	})
+
+	t.Run("policy is not satisfied by unsigned VC", func(t *testing.T) {
+		_, err := parseTestCredential([]byte(validCredential),
+			WithProofPolicy(&ProofPolicy{MinProofs: 1}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "at least 1 proofs are required, got 0")
+	})
+}
+
 func createLocalCrypto() (*LocalCrypto, error) {
 	lKMS, err := createKMS()
 	if err != nil {
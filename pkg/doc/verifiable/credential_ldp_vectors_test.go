@@ -0,0 +1,245 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiformats/go-multibase"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ecdsasecp256r1signature2019"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2020"
+	sigverifier "github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+)
+
+const (
+	// Ed25519 key pair from RFC 8032 (section 7.1, TEST 1).
+	vectorEd25519Seed   = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+	vectorEd25519PubKey = "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+
+	// uncompressed P-256 public key.
+	vectorP256PubKey = "04629be76ae3e1a8310636bb99c5e0f4a8dda28e403f4662103f97d567d84f6ad8" +
+		"ac5af641ce4485d12ef3572c328fe6796653e130fb19370a6edec2d571d498ee"
+)
+
+type ed25519TestSigner struct {
+	privKey ed25519.PrivateKey
+}
+
+func (s *ed25519TestSigner) Sign(doc []byte) ([]byte, error) {
+	return ed25519.Sign(s.privKey, doc), nil
+}
+
+func TestParseCredentialFromLinkedDataProof_Ed25519Signature2020(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	sigSuite := ed25519signature2020.New(
+		suite.WithSigner(signer),
+		suite.WithVerifier(ed25519signature2020.NewPublicKeyVerifier()))
+
+	vc, err := parseTestCredential([]byte(validCredential))
+	r.NoError(err)
+
+	r.NotContains(vc.Context, ed25519signature2020.ContextURL)
+
+	err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+		SignatureType:           ed25519signature2020.SignatureType,
+		SignatureRepresentation: SignatureProofValue,
+		Suite:                   sigSuite,
+		VerificationMethod:      "did:example:123456#key1",
+	}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+	r.NoError(err)
+	r.Contains(vc.Context, ed25519signature2020.ContextURL)
+	r.Len(vc.Proofs, 1)
+	r.Regexp("^z[1-9A-HJ-NP-Za-km-z]+$", vc.Proofs[0]["proofValue"])
+
+	vcBytes, err := json.Marshal(vc)
+	r.NoError(err)
+
+	vcWithLdp, err := parseTestCredential(vcBytes,
+		WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), ed25519signature2020.VerificationKeyType)))
+	r.NoError(err)
+	r.Equal(vc, vcWithLdp)
+}
+
+func TestParseCredentialFromLinkedDataProof_EcdsaSecp256r1Signature2019(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ECDSAP256TypeIEEEP1363)
+	r.NoError(err)
+
+	sigSuite := ecdsasecp256r1signature2019.New(suite.WithSigner(signer))
+
+	vc, err := parseTestCredential([]byte(validCredential))
+	r.NoError(err)
+
+	err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+		SignatureType:           ecdsasecp256r1signature2019.SignatureType,
+		SignatureRepresentation: SignatureJWS,
+		Suite:                   sigSuite,
+		VerificationMethod:      "did:example:123456#key1",
+	}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+	r.NoError(err)
+
+	vcBytes, err := json.Marshal(vc)
+	r.NoError(err)
+
+	vcWithLdp, err := parseTestCredential(vcBytes,
+		WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), ecdsasecp256r1signature2019.VerificationKeyType)))
+	r.NoError(err)
+	r.Equal(vc, vcWithLdp)
+
+	_, err = parseTestCredential(vcBytes,
+		WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), "EcdsaSecp256k1VerificationKey2019")))
+	r.Error(err)
+	r.Contains(err.Error(), "a type of public key is not 'EcdsaSecp256r1VerificationKey2019'")
+}
+
+// TestLinkedDataProofRegressionVectors checks the proofs of testdata/vectors. The vectors were produced by this
+// implementation (the Ed25519 one with the RFC 8032 key), so they detect regressions of the signature suites.
+// The Ed25519Signature2020 vector is also checked against the verify hash algorithm of the specification
+// implemented independently of the suite (see specEd25519Signature2020VerifyData).
+// TODO add the published vectors of the suites (e.g. the credential example of the Ed25519Signature2020
+//  specification) to testdata/vectors and check them as well, the regression vectors do not prove
+//  the interoperability with other implementations.
+func TestLinkedDataProofRegressionVectors(t *testing.T) {
+	t.Run("Ed25519Signature2020", func(t *testing.T) {
+		r := require.New(t)
+
+		vcBytes := readVector(t, "ed25519signature2020_vc.json")
+
+		pubKey, err := hex.DecodeString(vectorEd25519PubKey)
+		r.NoError(err)
+
+		vc, err := parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(SingleKey(pubKey, ed25519signature2020.VerificationKeyType)))
+		r.NoError(err)
+
+		// the proof value is a multibase (base58-btc) encoded signature of the spec verify data
+		encoding, signature, err := multibase.Decode(vc.Proofs[0]["proofValue"].(string))
+		r.NoError(err)
+		r.Equal(multibase.Encoding(multibase.Base58BTC), encoding)
+		r.True(ed25519.Verify(pubKey, specEd25519Signature2020VerifyData(t, vcBytes), signature))
+
+		// Ed25519 signatures are deterministic, so signing the credential with the same key
+		// and proof options results in the same proof.
+		seed, err := hex.DecodeString(vectorEd25519Seed)
+		r.NoError(err)
+
+		created := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+		expectedProofValue := vc.Proofs[0]["proofValue"]
+		vc.Proofs = nil
+
+		vectorSigner := &ed25519TestSigner{privKey: ed25519.NewKeyFromSeed(seed)}
+
+		err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           ed25519signature2020.SignatureType,
+			SignatureRepresentation: SignatureProofValue,
+			Suite:                   ed25519signature2020.New(suite.WithSigner(vectorSigner)),
+			Created:                 &created,
+			VerificationMethod:      "did:example:76e12ec712ebc6f1c221ebfeb1f#key-1",
+		}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+		r.NoError(err)
+		r.Equal(expectedProofValue, vc.Proofs[0]["proofValue"])
+
+		// tampered credential
+		vc.Subject = "did:example:other"
+
+		vcBytes, err = json.Marshal(vc)
+		r.NoError(err)
+
+		_, err = parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(SingleKey(pubKey, ed25519signature2020.VerificationKeyType)))
+		r.Error(err)
+	})
+
+	t.Run("EcdsaSecp256r1Signature2019", func(t *testing.T) {
+		r := require.New(t)
+
+		vcBytes := readVector(t, "ecdsasecp256r1signature2019_vc.json")
+
+		pubKey, err := hex.DecodeString(vectorP256PubKey)
+		r.NoError(err)
+
+		_, err = parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(func(issuerID, keyID string) (*sigverifier.PublicKey, error) {
+				r.Equal("#key-2", keyID)
+
+				return &sigverifier.PublicKey{
+					Type:  ecdsasecp256r1signature2019.VerificationKeyType,
+					Value: pubKey,
+				}, nil
+			}))
+		r.NoError(err)
+
+		otherSigner, err := newCryptoSigner(kms.ECDSAP256TypeIEEEP1363)
+		r.NoError(err)
+
+		_, err = parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(SingleKey(otherSigner.PublicKeyBytes(),
+				ecdsasecp256r1signature2019.VerificationKeyType)))
+		r.Error(err)
+		r.Contains(err.Error(), "ecdsa: invalid signature")
+	})
+}
+
+// specEd25519Signature2020VerifyData builds the data signed by Ed25519Signature2020 proof of the credential
+// as defined by the specification: SHA-256 of URDNA2015 canonical proof options (the proof without "proofValue"
+// but with "@context" of the credential) followed by SHA-256 of URDNA2015 canonical credential without proof.
+func specEd25519Signature2020VerifyData(t *testing.T, vcBytes []byte) []byte {
+	t.Helper()
+
+	var doc map[string]interface{}
+	require.NoError(t, json.Unmarshal(vcBytes, &doc))
+
+	proofOptions, ok := doc["proof"].(map[string]interface{})
+	require.True(t, ok)
+
+	delete(doc, "proof")
+	delete(proofOptions, "proofValue")
+	proofOptions["@context"] = doc["@context"]
+
+	canonize := func(d map[string]interface{}) []byte {
+		options := ld.NewJsonLdOptions("")
+		options.Algorithm = "URDNA2015"
+		options.Format = "application/n-quads"
+		options.DocumentLoader = createTestJSONLDDocumentLoader()
+
+		canonical, err := ld.NewJsonLdProcessor().Normalize(d, options)
+		require.NoError(t, err)
+		require.NotEmpty(t, canonical)
+
+		digest := sha256.Sum256([]byte(canonical.(string)))
+
+		return digest[:]
+	}
+
+	return append(canonize(proofOptions), canonize(doc)...)
+}
+
+func readVector(t *testing.T, name string) []byte {
+	t.Helper()
+
+	vector, err := ioutil.ReadFile(filepath.Clean(filepath.Join("testdata/vectors", name)))
+	require.NoError(t, err)
+
+	return vector
+}
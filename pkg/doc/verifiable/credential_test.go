@@ -7,6 +7,7 @@ package verifiable
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -979,6 +980,272 @@ func TestCustomCredentialJsonSchemaValidator2018(t *testing.T) {
 	})
 }
 
+func TestCustomCredentialJsonSchema2020(t *testing.T) {
+	customSchema := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal([]byte(defaultSchema), &customSchema))
+
+	// extend default schema to require new referenceNumber field to be mandatory
+	customSchema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	customSchema["required"] = append(customSchema["required"].([]interface{}), "referenceNumber")
+
+	schemaVC := map[string]interface{}{
+		"@context":     []string{"https://www.w3.org/2018/credentials/v1"},
+		"id":           "https://example.com/credentials/3732",
+		"type":         []string{"VerifiableCredential", "JsonSchemaCredential"},
+		"issuer":       "did:example:76e12ec712ebc6f1c221ebfeb1f",
+		"issuanceDate": "2010-01-01T19:23:24Z",
+		"credentialSubject": map[string]interface{}{
+			"id":         "https://example.com/schemas/degree.json",
+			"type":       "JsonSchema",
+			"jsonSchema": customSchema,
+		},
+	}
+
+	// JSON Schema 2020-12 with the keywords which are not supported by draft-07 ($defs, prefixItems)
+	schema2020 := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal([]byte(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["credentialSubject", "referenceNumbers"],
+  "$defs": {
+    "referenceNumber": {"type": "string", "pattern": "^[0-9]+$"}
+  },
+  "properties": {
+    "credentialSubject": {"type": "object", "required": ["id"]},
+    "referenceNumbers": {
+      "type": "array",
+      "prefixItems": [{"$ref": "#/$defs/referenceNumber"}, {"type": "integer"}],
+      "items": false
+    }
+  }
+}`), &schema2020))
+
+	schema2020VC := make(map[string]interface{})
+	for k, v := range schemaVC {
+		schema2020VC[k] = v
+	}
+
+	schema2020VC["credentialSubject"] = map[string]interface{}{
+		"id":         "https://example.com/schemas/degree-2020.json",
+		"type":       "JsonSchema",
+		"jsonSchema": schema2020,
+	}
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		var doc interface{} = customSchema
+
+		switch req.URL.Path {
+		case "/credential":
+			doc = schemaVC
+		case "/schema-2020":
+			doc = schema2020
+		case "/credential-2020":
+			doc = schema2020VC
+		}
+
+		bytes, err := json.Marshal(doc)
+		require.NoError(t, err)
+
+		res.WriteHeader(http.StatusOK)
+		_, err = res.Write(bytes)
+		require.NoError(t, err)
+	}))
+
+	defer func() { testServer.Close() }()
+
+	customSchemaCred := func(t *testing.T, schema *TypedID, referenceNumber bool) []byte {
+		raw := make(map[string]interface{})
+		require.NoError(t, json.Unmarshal([]byte(validCredential), &raw))
+
+		raw["credentialSchema"] = schema
+
+		if referenceNumber {
+			raw["referenceNumber"] = 83294847
+		}
+
+		vcBytes, err := json.Marshal(raw)
+		require.NoError(t, err)
+
+		return vcBytes
+	}
+
+	extendedValidation := WithBaseContextExtendedValidation([]string{
+		"https://www.w3.org/2018/credentials/v1",
+		"https://www.w3.org/2018/credentials/examples/v1",
+		"https://trustbloc.github.io/context/vc/credentials-v1.jsonld",
+		"https://trustbloc.github.io/context/vc/examples-v1.jsonld",
+	}, []string{
+		"VerifiableCredential",
+		"UniversityDegreeCredential",
+	})
+
+	for _, schema := range []*TypedID{
+		{ID: testServer.URL + "/schema", Type: "JsonSchema2020"},
+		{ID: testServer.URL + "/credential", Type: "JsonSchemaCredential"},
+	} {
+		schema := schema
+
+		t.Run("Applies custom "+schema.Type, func(t *testing.T) {
+			vc, err := parseTestCredential(customSchemaCred(t, schema, false))
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "referenceNumber is required")
+			require.Nil(t, vc)
+
+			vc, err = parseTestCredential(customSchemaCred(t, schema, true), extendedValidation)
+			require.NoError(t, err)
+			require.Equal(t, schema.Type, vc.Schemas[0].Type)
+		})
+	}
+
+	for _, schema := range []*TypedID{
+		{ID: testServer.URL + "/schema-2020", Type: "JsonSchema2020"},
+		{ID: testServer.URL + "/credential-2020", Type: "JsonSchemaCredential"},
+	} {
+		schema := schema
+
+		t.Run("Applies custom JSON Schema 2020-12 of "+schema.Type, func(t *testing.T) {
+			vcWithReferenceNumbers := func(referenceNumbers ...interface{}) []byte {
+				raw := make(map[string]interface{})
+				require.NoError(t, json.Unmarshal(customSchemaCred(t, schema, false), &raw))
+
+				if referenceNumbers != nil {
+					raw["referenceNumbers"] = referenceNumbers
+				}
+
+				vcBytes, err := json.Marshal(raw)
+				require.NoError(t, err)
+
+				return vcBytes
+			}
+
+			vc, err := parseTestCredential(vcWithReferenceNumbers("83294847", 2), extendedValidation)
+			require.NoError(t, err)
+			require.Equal(t, schema.Type, vc.Schemas[0].Type)
+
+			for _, invalid := range []struct {
+				referenceNumbers []interface{}
+				err              string
+			}{
+				{referenceNumbers: nil, err: "missing properties: 'referenceNumbers'"},
+				// the item of $defs does not match
+				{referenceNumbers: []interface{}{"AB3294847"}, err: "does not match pattern"},
+				// prefixItems requires integer as the second item
+				{referenceNumbers: []interface{}{"83294847", "2"}, err: "expected integer, but got string"},
+				// no items are allowed after prefixItems
+				{referenceNumbers: []interface{}{"83294847", 2, 3}, err: "/referenceNumbers/2: not allowed"},
+			} {
+				vc, err = parseTestCredential(vcWithReferenceNumbers(invalid.referenceNumbers...), extendedValidation)
+				require.Error(t, err)
+				require.Contains(t, err.Error(), "verifiable credential is not valid")
+				require.Contains(t, err.Error(), invalid.err)
+				require.Nil(t, vc)
+			}
+		})
+	}
+
+	t.Run("Error when custom JSON Schema 2020-12 is invalid", func(t *testing.T) {
+		err := validateUsingJSONSchema2020([]byte(validCredential), "https://example.com/schema.json",
+			[]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "$ref": "file:///etc/passwd"}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "load of referenced schema file:///etc/passwd is not supported")
+
+		err = validateUsingJSONSchema2020([]byte(validCredential), "https://example.com/schema.json",
+			[]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema", "prefixItems": {}}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "compile custom credential schema from https://example.com/schema.json")
+
+		err = validateUsingJSONSchema2020([]byte("{"), "https://example.com/schema.json",
+			[]byte(`{"$schema": "https://json-schema.org/draft/2020-12/schema"}`))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "validation of verifiable credential")
+	})
+
+	t.Run("Checks of credential do not apply to its schema credential", func(t *testing.T) {
+		subject := schemaVC["credentialSubject"]
+		schemaVC["credentialSubject"] = map[string]interface{}{
+			"id":   "https://example.com/schemas/subject.json",
+			"type": "JsonSchema",
+			"jsonSchema": map[string]interface{}{
+				"$schema":  "https://json-schema.org/draft/2020-12/schema",
+				"required": []string{"credentialSubject"},
+			},
+		}
+
+		defer func() { schemaVC["credentialSubject"] = subject }()
+
+		signer, err := newCryptoSigner(kms.ED25519Type)
+		require.NoError(t, err)
+
+		schema := &TypedID{ID: testServer.URL + "/credential", Type: "JsonSchemaCredential"}
+
+		vc, err := parseTestCredential(customSchemaCred(t, schema, false))
+		require.NoError(t, err)
+
+		// the signed credential schema has to be defined by JSON-LD context
+		const schemaContext = "https://example.com/context/json-schema-credential/v1"
+
+		documentLoader := createTestJSONLDDocumentLoader()
+		addJSONLDCachedContext(documentLoader, schemaContext, `{"@context": {
+  "JsonSchemaCredential": "https://www.w3.org/2018/credentials#JsonSchemaCredential"
+}}`)
+
+		vc.Context = append(vc.Context, schemaContext)
+
+		err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           "Ed25519Signature2018",
+			Suite:                   ed25519signature2018.New(suite.WithSigner(signer)),
+			SignatureRepresentation: SignatureJWS,
+			VerificationMethod:      "did:example:76e12ec712ebc6f1c221ebfeb1f#key1",
+		}, jsonld.WithDocumentLoader(documentLoader))
+		require.NoError(t, err)
+
+		vcBytes, err := json.Marshal(vc)
+		require.NoError(t, err)
+
+		var checkedVCs []string
+
+		// the unsigned schema credential does not satisfy the proof policy and is not trusted
+		vc, err = parseTestCredential(vcBytes, WithJSONLDDocumentLoader(documentLoader),
+			WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+			WithProofPolicy(&ProofPolicy{MinProofs: 1}),
+			WithIssuerTrustCheck(issuerTrustCheckerFunc(func(vc *Credential) error {
+				checkedVCs = append(checkedVCs, vc.ID)
+
+				if hasType(vc.Types, "JsonSchemaCredential") {
+					return errors.New("untrusted issuer")
+				}
+
+				return nil
+			})))
+		require.NoError(t, err)
+		require.Len(t, vc.Proofs, 1)
+		require.Equal(t, []string{"http://example.edu/credentials/1872"}, checkedVCs)
+	})
+
+	t.Run("Error when credential schema credential is not of JsonSchemaCredential type", func(t *testing.T) {
+		schema := &TypedID{ID: testServer.URL + "/schema", Type: "JsonSchemaCredential"}
+
+		vc, err := parseTestCredential(customSchemaCred(t, schema, true))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse credential schema credential")
+		require.Nil(t, vc)
+	})
+
+	t.Run("Error when credential schema credential has no JSON Schema", func(t *testing.T) {
+		subject := schemaVC["credentialSubject"]
+		schemaVC["credentialSubject"] = "did:example:ebfeb1f712ebc6f1c276e12ec21"
+
+		defer func() { schemaVC["credentialSubject"] = subject }()
+
+		schema := &TypedID{ID: testServer.URL + "/credential", Type: "JsonSchemaCredential"}
+
+		vc, err := parseTestCredential(customSchemaCred(t, schema, true))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "jsonSchema is not defined in credential schema credential")
+		require.Nil(t, vc)
+	})
+}
+
 func TestDownloadCustomSchema(t *testing.T) {
 	t.Parallel()
 
@@ -2055,3 +2322,9 @@ func TestSubjectToBytes(t *testing.T) {
 		r.NoError(err)
 	})
 }
+
+type issuerTrustCheckerFunc func(vc *Credential) error
+
+func (f issuerTrustCheckerFunc) CheckIssuerTrust(vc *Credential) error {
+	return f(vc)
+}
@@ -16,14 +16,19 @@ import (
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ecdsasecp256k1signature2019"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ecdsasecp256r1signature2019"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2020"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/jsonwebsignature2020"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 const (
 	ed25519Signature2018        = "Ed25519Signature2018"
 	jsonWebSignature2020        = "JsonWebSignature2020"
 	ecdsaSecp256k1Signature2019 = "EcdsaSecp256k1Signature2019"
+	ed25519Signature2020        = "Ed25519Signature2020"
+	ecdsaSecp256r1Signature2019 = "EcdsaSecp256r1Signature2019"
 )
 
 func getProofType(proofMap map[string]interface{}) (string, error) {
@@ -34,7 +39,8 @@ func getProofType(proofMap map[string]interface{}) (string, error) {
 
 	proofTypeStr := safeStringValue(proofType)
 	switch proofTypeStr {
-	case ed25519Signature2018, jsonWebSignature2020, ecdsaSecp256k1Signature2019:
+	case ed25519Signature2018, jsonWebSignature2020, ecdsaSecp256k1Signature2019,
+		ed25519Signature2020, ecdsaSecp256r1Signature2019:
 		return proofTypeStr, nil
 	default:
 		return "", fmt.Errorf("unsupported proof type: %s", proofType)
@@ -47,6 +53,11 @@ type embeddedProofCheckOpts struct {
 
 	ldpSuites []verifier.SignatureSuite
 
+	vdriRegistry vdri.Registry
+	challenge    string
+	domain       string
+	proofPolicy  *ProofPolicy
+
 	jsonldCredentialOpts
 }
 
@@ -63,6 +74,16 @@ func checkEmbeddedProof(docBytes []byte, opts *embeddedProofCheckOpts) ([]byte,
 
 	proofElement, ok := jsonldDoc["proof"]
 	if !ok || proofElement == nil {
+		if opts.challenge != "" || opts.domain != "" {
+			return nil, errors.New("check embedded proof: proof with challenge or domain is expected")
+		}
+
+		if opts.proofPolicy != nil {
+			if err := opts.proofPolicy.check(nil); err != nil {
+				return nil, fmt.Errorf("check embedded proof: %w", err)
+			}
+		}
+
 		// do not make a check if there is no proof defined as proof presence is not mandatory
 		return docBytes, nil
 	}
@@ -94,9 +115,35 @@ func checkEmbeddedProof(docBytes []byte, opts *embeddedProofCheckOpts) ([]byte,
 		return nil, fmt.Errorf("check embedded proof: %w", err)
 	}
 
+	if err := checkProofRequirements(proofs, expectedProofSigner(jsonldDoc), opts); err != nil {
+		return nil, fmt.Errorf("check embedded proof: %w", err)
+	}
+
 	return docBytes, nil
 }
 
+func checkProofRequirements(proofs []map[string]interface{}, signerDID string, opts *embeddedProofCheckOpts) error {
+	for _, p := range proofs {
+		if err := checkChallengeAndDomain(p, opts.challenge, opts.domain); err != nil {
+			return err
+		}
+
+		if opts.vdriRegistry == nil {
+			continue
+		}
+
+		if err := checkProofPurpose(p, signerDID, opts.vdriRegistry); err != nil {
+			return err
+		}
+	}
+
+	if opts.proofPolicy != nil {
+		return opts.proofPolicy.check(proofs)
+	}
+
+	return nil
+}
+
 func getSuites(proofs []map[string]interface{}, opts *embeddedProofCheckOpts) ([]verifier.SignatureSuite, error) {
 	ldpSuites := opts.ldpSuites
 
@@ -117,6 +164,12 @@ func getSuites(proofs []map[string]interface{}, opts *embeddedProofCheckOpts) ([
 			case ecdsaSecp256k1Signature2019:
 				ldpSuites = append(ldpSuites, ecdsasecp256k1signature2019.New(
 					suite.WithVerifier(ecdsasecp256k1signature2019.NewPublicKeyVerifier())))
+			case ed25519Signature2020:
+				ldpSuites = append(ldpSuites, ed25519signature2020.New(
+					suite.WithVerifier(ed25519signature2020.NewPublicKeyVerifier())))
+			case ecdsaSecp256r1Signature2019:
+				ldpSuites = append(ldpSuites, ecdsasecp256r1signature2019.New(
+					suite.WithVerifier(ecdsasecp256r1signature2019.NewPublicKeyVerifier())))
 			}
 		}
 	}
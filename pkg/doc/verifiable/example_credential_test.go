@@ -407,7 +407,7 @@ func ExampleCredential_AddLinkedDataProof() {
 }
 
 //nolint:lll,govet
-func ExampleCredential_AddLinkedDataProofMultiProofs() {
+func ExampleCredential_AddLinkedDataProof_multipleProofs() {
 	log.SetLevel("aries-framework/json-ld-processor", log.ERROR)
 
 	vcJSON := `
@@ -101,7 +101,7 @@ func Test_compactJSONLD(t *testing.T) {
 `
 		vc := fmt.Sprintf(vcJSONTemplate, testServer.URL)
 
-		opts := &jsonldCredentialOpts{jsonldDocumentLoader: CachingJSONLDLoader()}
+		opts := &jsonldCredentialOpts{jsonldDocumentLoader: createTestRemoteJSONLDDocumentLoader()}
 
 		err := compactJSONLD(vc, opts, true)
 		require.NoError(t, err)
@@ -376,5 +376,5 @@ func Test_compactJSONLD_CornerErrorCases(t *testing.T) {
 }
 
 func defaultOpts() *jsonldCredentialOpts {
-	return &jsonldCredentialOpts{jsonldDocumentLoader: CachingJSONLDLoader()}
+	return &jsonldCredentialOpts{jsonldDocumentLoader: createTestRemoteJSONLDDocumentLoader()}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/proof"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/signer"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2020"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
 )
 
@@ -62,6 +63,8 @@ type LinkedDataProofContext struct {
 	Challenge               string                  // optional
 	Domain                  string                  // optional
 	Purpose                 string                  // optional
+	ID                      string                  // optional, IRI (e.g. "urn:uuid:...") to refer the proof
+	PreviousProof           string                  // optional, ID of the proof the new proof is chained to
 }
 
 func checkLinkedDataProof(jsonldBytes []byte, suites []verifier.SignatureSuite,
@@ -101,8 +104,7 @@ func addLinkedDataProof(context *LinkedDataProofContext, jsonldBytes []byte,
 	jsonldOpts ...jsonld.ProcessorOpts) ([]Proof, error) {
 	documentSigner := signer.New(context.Suite)
 
-	vcWithNewProofBytes, err := documentSigner.Sign(mapContext(context), jsonldBytes,
-		append([]jsonld.ProcessorOpts{jsonld.WithDocumentLoader(CachingJSONLDLoader())}, jsonldOpts...)...)
+	vcWithNewProofBytes, err := documentSigner.Sign(mapContext(context), jsonldBytes, jsonldOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("add linked data proof: %w", err)
 	}
@@ -123,6 +125,22 @@ func addLinkedDataProof(context *LinkedDataProofContext, jsonldBytes []byte,
 	return proofs, nil
 }
 
+// withSuiteContext adds the JSON-LD context defining terms of the signature suite if it is missing.
+// Ed25519Signature2020 proof options are canonized with the proof type, so the document has to define it.
+func withSuiteContext(contexts []string, signatureType string) []string {
+	if signatureType != ed25519Signature2020 {
+		return contexts
+	}
+
+	for _, c := range contexts {
+		if c == ed25519signature2020.ContextURL {
+			return contexts
+		}
+	}
+
+	return append(contexts, ed25519signature2020.ContextURL)
+}
+
 func mapContext(context *LinkedDataProofContext) *signer.Context {
 	return &signer.Context{
 		SignatureType:           context.SignatureType,
@@ -132,5 +150,7 @@ func mapContext(context *LinkedDataProofContext) *signer.Context {
 		Challenge:               context.Challenge,
 		Domain:                  context.Domain,
 		Purpose:                 context.Purpose,
+		ID:                      context.ID,
+		PreviousProof:           context.PreviousProof,
 	}
 }
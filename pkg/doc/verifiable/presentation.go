@@ -15,7 +15,9 @@ import (
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 const basePresentationSchema = `
@@ -343,6 +345,10 @@ type presentationOpts struct {
 	strictValidation   bool
 	requireVC          bool
 	requireProof       bool
+	vdriRegistry       vdri.Registry
+	challenge          string
+	domain             string
+	proofPolicy        *ProofPolicy
 
 	jsonldCredentialOpts
 }
@@ -382,13 +388,46 @@ func WithPresStrictValidation() PresentationOpt {
 }
 
 // WithPresJSONLDDocumentLoader defines custom JSON-LD document loader. If not defined, when decoding VP
-// a new document loader will be created using CachingJSONLDLoader() if JSON-LD validation is made.
+// jsonld.DefaultDocumentLoader() is used if JSON-LD validation is made.
 func WithPresJSONLDDocumentLoader(documentLoader ld.DocumentLoader) PresentationOpt {
 	return func(opts *presentationOpts) {
 		opts.jsonldDocumentLoader = documentLoader
 	}
 }
 
+// WithPresProofPurposeCheck option enables the check of linked data proof purpose of VP. DID of the proof's
+// verification method is resolved using vdri.Registry and the method must be listed under the DID
+// verification relationship which corresponds to the proof purpose (e.g. "authentication").
+// In case of JWS, the key which signed JWT must belong to the holder and be listed under "authentication".
+func WithPresProofPurposeCheck(vdriRegistry vdri.Registry) PresentationOpt {
+	return func(opts *presentationOpts) {
+		opts.vdriRegistry = vdriRegistry
+	}
+}
+
+// WithPresChallenge defines the challenge which must be present in each linked data proof of VP.
+func WithPresChallenge(challenge string) PresentationOpt {
+	return func(opts *presentationOpts) {
+		opts.challenge = challenge
+	}
+}
+
+// WithPresDomain defines the domain which must be present in each linked data proof of VP.
+func WithPresDomain(domain string) PresentationOpt {
+	return func(opts *presentationOpts) {
+		opts.domain = domain
+	}
+}
+
+// WithPresProofPolicy option defines the embedded proofs which must be present in VP (e.g. proof types, signers
+// or a proof chain). VP is rejected if its proofs do not satisfy the policy. VP secured by JWS is rejected
+// as it has no embedded proofs.
+func WithPresProofPolicy(policy *ProofPolicy) PresentationOpt {
+	return func(opts *presentationOpts) {
+		opts.proofPolicy = policy
+	}
+}
+
 // ParsePresentation creates an instance of Verifiable Presentation by reading a JSON document from bytes.
 // It also applies miscellaneous options like custom decoders or settings of schema validation.
 func ParsePresentation(vpData []byte, opts ...PresentationOpt) (*Presentation, error) {
@@ -439,7 +478,7 @@ func getPresentationOpts(opts []PresentationOpt) *presentationOpts {
 	}
 
 	if vpOpts.jsonldDocumentLoader == nil {
-		vpOpts.jsonldDocumentLoader = CachingJSONLDLoader()
+		vpOpts.jsonldDocumentLoader = jsonld.DefaultDocumentLoader()
 	}
 
 	return vpOpts
@@ -581,11 +620,15 @@ func decodeRawPresentation(vpData []byte, vpOpts *presentationOpts) ([]byte, *ra
 	vpStr := string(vpData)
 
 	if jwt.IsJWS(vpStr) {
+		if vpOpts.proofPolicy != nil && !vpOpts.disabledProofCheck {
+			return nil, nil, errProofPolicyForJWS
+		}
+
 		if vpOpts.publicKeyFetcher == nil {
 			return nil, nil, errors.New("public key fetcher is not defined")
 		}
 
-		vcDataFromJwt, rawCred, err := decodeVPFromJWS(vpStr, !vpOpts.disabledProofCheck, vpOpts.publicKeyFetcher)
+		vcDataFromJwt, rawCred, err := decodeVPFromJWS(vpStr, vpOpts)
 		if err != nil {
 			return nil, nil, fmt.Errorf("decoding of Verifiable Presentation from JWS: %w", err)
 		}
@@ -597,6 +640,10 @@ func decodeRawPresentation(vpData []byte, vpOpts *presentationOpts) ([]byte, *ra
 		publicKeyFetcher:     vpOpts.publicKeyFetcher,
 		disabledProofCheck:   vpOpts.disabledProofCheck,
 		ldpSuites:            vpOpts.ldpSuites,
+		vdriRegistry:         vpOpts.vdriRegistry,
+		challenge:            vpOpts.challenge,
+		domain:               vpOpts.domain,
+		proofPolicy:          vpOpts.proofPolicy,
 		jsonldCredentialOpts: vpOpts.jsonldCredentialOpts,
 	}
 
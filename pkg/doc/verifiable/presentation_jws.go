@@ -5,6 +5,10 @@ SPDX-License-Identifier: Apache-2.0
 
 package verifiable
 
+import (
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+)
+
 // MarshalJWS serializes JWT presentation claims into signed form (JWS).
 func (jpc *JWTPresClaims) MarshalJWS(signatureAlg JWSAlgorithm, signer Signer, keyID string) (string, error) {
 	return marshalJWS(jpc, signatureAlg, signer, keyID)
@@ -21,8 +25,28 @@ func unmarshalPresJWSClaims(vpJWT string, checkProof bool, fetcher PublicKeyFetc
 	return &claims, err
 }
 
-func decodeVPFromJWS(vpJWT string, checkProof bool, fetcher PublicKeyFetcher) ([]byte, *rawPresentation, error) {
+func decodeVPFromJWS(vpJWT string, vpOpts *presentationOpts) ([]byte, *rawPresentation, error) {
+	checkProof := !vpOpts.disabledProofCheck
+
+	var keyID string
+
+	// remember the key which signed JWT to check it against the proof purpose
+	fetcher := func(issuerID, kid string) (*verifier.PublicKey, error) {
+		keyID = kid
+
+		return vpOpts.publicKeyFetcher(issuerID, kid)
+	}
+
 	return decodePresJWT(vpJWT, func(vpJWT string) (*JWTPresClaims, error) {
-		return unmarshalPresJWSClaims(vpJWT, checkProof, fetcher)
+		claims, err := unmarshalPresJWSClaims(vpJWT, checkProof, fetcher)
+		if err != nil || !checkProof {
+			return claims, err
+		}
+
+		if err := checkJWTPresClaims(claims, keyID, vpOpts); err != nil {
+			return nil, err
+		}
+
+		return claims, nil
 	})
 }
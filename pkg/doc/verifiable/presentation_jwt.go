@@ -7,6 +7,7 @@ package verifiable
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
@@ -17,6 +18,9 @@ type JWTPresClaims struct {
 	*jwt.Claims
 
 	Presentation *rawPresentation `json:"vp,omitempty"`
+
+	// Nonce is the challenge of the verifier the presentation is created for.
+	Nonce string `json:"nonce,omitempty"`
 }
 
 func (jpc *JWTPresClaims) refineFromJWTClaims() {
@@ -68,6 +72,25 @@ func newJWTPresClaims(vp *Presentation, audience []string, minimizeVP bool) (*JW
 	return presClaims, nil
 }
 
+// checkJWTPresClaims checks VP JWT against the challenge, domain and proof purpose requirements of the options:
+// "nonce" claim must be equal to the challenge, "aud" claim must contain the domain and the key which signed
+// JWT must be authorized for authentication by the holder's DID.
+func checkJWTPresClaims(claims *JWTPresClaims, keyID string, vpOpts *presentationOpts) error {
+	if vpOpts.challenge != "" && claims.Nonce != vpOpts.challenge {
+		return errors.New("JWT nonce does not match the expected challenge")
+	}
+
+	if vpOpts.domain != "" && !claims.Audience.Contains(vpOpts.domain) {
+		return errors.New("JWT audience does not contain the expected domain")
+	}
+
+	if vpOpts.vdriRegistry != nil {
+		return checkJWTProofPurpose(keyID, claims.Issuer, AuthenticationProofPurpose, vpOpts.vdriRegistry)
+	}
+
+	return nil
+}
+
 // JWTPresClaimsUnmarshaller parses JWT of certain type to JWT Claims containing "vp" (Presentation) claim.
 type JWTPresClaimsUnmarshaller func(vpJWT string) (*JWTPresClaims, error)
 
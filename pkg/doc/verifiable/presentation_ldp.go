@@ -11,8 +11,15 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 )
 
-// AddLinkedDataProof appends proof to the Verifiable Presentation.
+// AddLinkedDataProof appends proof to the Verifiable Presentation. The proofs which are already present are
+// kept (proof set). If PreviousProof of the context is set, the new proof also signs the referenced proof,
+// so the proofs form a proof chain. The JSON-LD context of the signature suite is added if it is missing
+// and there are no proofs yet.
 func (vp *Presentation) AddLinkedDataProof(context *LinkedDataProofContext, jsonldOpts ...jsonld.ProcessorOpts) error {
+	if len(vp.Proofs) == 0 {
+		vp.Context = withSuiteContext(vp.Context, context.SignatureType)
+	}
+
 	vcBytes, err := vp.MarshalJSON()
 	if err != nil {
 		return fmt.Errorf("add linked data proof to VP: %w", err)
@@ -0,0 +1,135 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ProofPolicy defines the embedded linked data proofs which must be present in Verifiable Credential
+// or Presentation. All the embedded proofs are verified anyway, so the policy effectively requires
+// "all of N" signatures of the given types and signers.
+type ProofPolicy struct {
+	// Chain requires the proofs to form a proof chain, i.e. every proof except the first one references
+	// the preceding proof by "previousProof". Otherwise, the proofs are treated as a proof set.
+	Chain bool
+
+	// MinProofs is the minimal number of the proofs.
+	MinProofs int
+
+	// RequiredTypes lists the proof types (e.g. "Ed25519Signature2018") each of which must be present.
+	RequiredTypes []string
+
+	// RequiredSigners lists the signers (e.g. DIDs of issuers) each of which must have created a proof.
+	// Signer is a verification method of the proof without the fragment.
+	RequiredSigners []string
+}
+
+// errProofPolicyForJWS is returned when the proof policy is defined for VC or VP secured by JWS, which has
+// a single external proof instead of the embedded ones.
+var errProofPolicyForJWS = errors.New("proof policy is not applicable to JWS")
+
+func (p *ProofPolicy) check(proofs []map[string]interface{}) error {
+	if len(proofs) < p.MinProofs {
+		return fmt.Errorf("at least %d proofs are required, got %d", p.MinProofs, len(proofs))
+	}
+
+	for _, t := range p.RequiredTypes {
+		if !hasProof(proofs, func(proof map[string]interface{}) bool {
+			return proofStringEntry(proof, "type") == t
+		}) {
+			return fmt.Errorf("proof of %s type is required", t)
+		}
+	}
+
+	for _, signer := range p.RequiredSigners {
+		if !hasProof(proofs, func(proof map[string]interface{}) bool {
+			return proofSigner(proof) == signer
+		}) {
+			return fmt.Errorf("proof signed by %s is required", signer)
+		}
+	}
+
+	if p.Chain {
+		return checkProofChain(proofs)
+	}
+
+	return nil
+}
+
+// checkProofChain checks that the proofs form a single chain starting with the only proof which has
+// no previousProof.
+func checkProofChain(proofs []map[string]interface{}) error {
+	if len(proofs) == 0 {
+		return nil
+	}
+
+	var head map[string]interface{}
+
+	next := make(map[string]map[string]interface{})
+
+	for _, proof := range proofs {
+		previousProof := proofStringEntry(proof, "previousProof")
+		if previousProof == "" {
+			if head != nil {
+				return errors.New("proof chain has more than one head")
+			}
+
+			head = proof
+
+			continue
+		}
+
+		if _, ok := next[previousProof]; ok {
+			return fmt.Errorf("proof chain forks at %s", previousProof)
+		}
+
+		next[previousProof] = proof
+	}
+
+	if head == nil {
+		return errors.New("proof chain has no head")
+	}
+
+	chainLen := 1
+
+	// the length is limited by the number of proofs in case of duplicate proof IDs
+	for proof := head; chainLen <= len(proofs); chainLen++ {
+		id := proofStringEntry(proof, "id")
+		if id == "" || next[id] == nil {
+			break
+		}
+
+		proof = next[id]
+	}
+
+	if chainLen != len(proofs) {
+		return errors.New("proofs do not form a single proof chain")
+	}
+
+	return nil
+}
+
+func hasProof(proofs []map[string]interface{}, match func(proof map[string]interface{}) bool) bool {
+	for _, proof := range proofs {
+		if match(proof) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func proofSigner(proof map[string]interface{}) string {
+	verificationMethod := proofStringEntry(proof, "verificationMethod")
+	if verificationMethod == "" {
+		verificationMethod = proofStringEntry(proof, "creator")
+	}
+
+	return strings.Split(verificationMethod, "#")[0]
+}
@@ -0,0 +1,154 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+)
+
+func TestProofPolicy(t *testing.T) {
+	newProof := func(id, previousProof, verificationMethod string) map[string]interface{} {
+		proof := map[string]interface{}{
+			"id":                 id,
+			"type":               "Ed25519Signature2018",
+			"verificationMethod": verificationMethod,
+		}
+
+		if previousProof != "" {
+			proof["previousProof"] = previousProof
+		}
+
+		return proof
+	}
+
+	first := newProof("urn:uuid:1", "", "did:example:issuer#key1")
+	second := newProof("urn:uuid:2", "urn:uuid:1", "did:example:notary#key1")
+	third := newProof("urn:uuid:3", "urn:uuid:2", "did:example:auditor#key1")
+
+	tests := []struct {
+		name   string
+		policy *ProofPolicy
+		proofs []map[string]interface{}
+		err    string
+	}{
+		{
+			name:   "empty policy",
+			policy: &ProofPolicy{},
+		},
+		{
+			name:   "proof set",
+			policy: &ProofPolicy{MinProofs: 2, RequiredSigners: []string{"did:example:notary", "did:example:issuer"}},
+			proofs: []map[string]interface{}{first, newProof("", "", "did:example:notary#key1")},
+		},
+		{
+			name:   "proof chain in any order",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{third, first, second},
+		},
+		{
+			name:   "not enough proofs",
+			policy: &ProofPolicy{MinProofs: 2},
+			proofs: []map[string]interface{}{first},
+			err:    "at least 2 proofs are required, got 1",
+		},
+		{
+			name:   "missing proof type",
+			policy: &ProofPolicy{RequiredTypes: []string{"JsonWebSignature2020"}},
+			proofs: []map[string]interface{}{first},
+			err:    "proof of JsonWebSignature2020 type is required",
+		},
+		{
+			name:   "missing signer",
+			policy: &ProofPolicy{RequiredSigners: []string{"did:example:notary"}},
+			proofs: []map[string]interface{}{first},
+			err:    "proof signed by did:example:notary is required",
+		},
+		{
+			name:   "proof set is not a chain",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{first, newProof("urn:uuid:2", "", "did:example:notary#key1")},
+			err:    "proof chain has more than one head",
+		},
+		{
+			name:   "proof chain forks",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{first, second, newProof("urn:uuid:3", "urn:uuid:1", "did:example:a#1")},
+			err:    "proof chain forks at urn:uuid:1",
+		},
+		{
+			name:   "proof chain has no head",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{second},
+			err:    "proof chain has no head",
+		},
+		{
+			name:   "proof chain is broken",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{first, third},
+			err:    "proofs do not form a single proof chain",
+		},
+		{
+			name:   "proof chain with duplicate IDs",
+			policy: &ProofPolicy{Chain: true},
+			proofs: []map[string]interface{}{first, newProof("urn:uuid:1", "urn:uuid:1", "did:example:a#1")},
+			err:    "proofs do not form a single proof chain",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.check(tc.proofs)
+			if tc.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestProofPolicy_JWS(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	keyFetcher := WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519))
+
+	vc, err := parseTestCredential([]byte(validCredential))
+	r.NoError(err)
+
+	vcClaims, err := vc.JWTClaims(false)
+	r.NoError(err)
+
+	vcJWS, err := vcClaims.MarshalJWS(EdDSA, signer, "#key-1")
+	r.NoError(err)
+
+	_, err = parseTestCredential([]byte(vcJWS), keyFetcher, WithProofPolicy(&ProofPolicy{MinProofs: 1}))
+	r.True(errors.Is(err, errProofPolicyForJWS))
+
+	vp, err := newTestPresentation([]byte(validPresentation))
+	r.NoError(err)
+
+	vpClaims, err := vp.JWTClaims(nil, false)
+	r.NoError(err)
+
+	vpJWS, err := vpClaims.MarshalJWS(EdDSA, signer, "#key-1")
+	r.NoError(err)
+
+	_, err = newTestPresentation([]byte(vpJWS),
+		WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+		WithPresProofPolicy(&ProofPolicy{MinProofs: 1}))
+	r.True(errors.Is(err, errProofPolicyForJWS))
+}
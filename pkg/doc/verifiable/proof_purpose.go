@@ -0,0 +1,144 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const (
+	// AssertionMethodProofPurpose is a proof purpose of issuing an assertion (e.g. Verifiable Credential).
+	AssertionMethodProofPurpose = "assertionMethod"
+
+	// AuthenticationProofPurpose is a proof purpose of authenticating the holder (e.g. Verifiable Presentation).
+	AuthenticationProofPurpose = "authentication"
+
+	// CapabilityInvocationProofPurpose is a proof purpose of invoking a capability.
+	CapabilityInvocationProofPurpose = "capabilityInvocation"
+
+	// CapabilityDelegationProofPurpose is a proof purpose of delegating a capability.
+	CapabilityDelegationProofPurpose = "capabilityDelegation"
+
+	// KeyAgreementProofPurpose is a proof purpose of key agreement.
+	KeyAgreementProofPurpose = "keyAgreement"
+)
+
+// VerificationRelationship returns DID verification relationship which corresponds to the given proof purpose.
+func VerificationRelationship(proofPurpose string) (did.VerificationRelationship, error) {
+	switch proofPurpose {
+	case AssertionMethodProofPurpose:
+		return did.AssertionMethod, nil
+	case AuthenticationProofPurpose:
+		return did.Authentication, nil
+	case CapabilityInvocationProofPurpose:
+		return did.CapabilityInvocation, nil
+	case CapabilityDelegationProofPurpose:
+		return did.CapabilityDelegation, nil
+	case KeyAgreementProofPurpose:
+		return did.KeyAgreement, nil
+	default:
+		return 0, fmt.Errorf("unsupported proof purpose: %s", proofPurpose)
+	}
+}
+
+// checkProofPurpose resolves DID of the proof's verification method and checks that the method is listed
+// under the verification relationship defined by the proof purpose. If signer DID (the issuer of VC or
+// the holder of VP) is defined, the verification method must belong to it.
+func checkProofPurpose(p map[string]interface{}, signerDID string, vdriRegistry vdri.Registry) error {
+	proofPurpose := proofStringEntry(p, "proofPurpose")
+	if proofPurpose == "" {
+		return errors.New("proof purpose is missing")
+	}
+
+	relationship, err := VerificationRelationship(proofPurpose)
+	if err != nil {
+		return err
+	}
+
+	verificationMethod := proofStringEntry(p, "verificationMethod")
+	if verificationMethod == "" {
+		verificationMethod = proofStringEntry(p, "creator")
+	}
+
+	didID := strings.Split(verificationMethod, "#")[0]
+	if !strings.HasPrefix(didID, "did:") {
+		return fmt.Errorf("verification method %s is not a DID URL", verificationMethod)
+	}
+
+	if signerDID != "" && didID != signerDID {
+		return fmt.Errorf("verification method %s does not belong to %s", verificationMethod, signerDID)
+	}
+
+	doc, err := vdriRegistry.Resolve(didID)
+	if err != nil {
+		return fmt.Errorf("resolve DID %s: %w", didID, err)
+	}
+
+	for _, vm := range doc.VerificationMethods(relationship)[relationship] {
+		if vm.PublicKey.ID == verificationMethod || doc.ID+vm.PublicKey.ID == verificationMethod {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("verification method %s is not authorized for proof purpose %s", verificationMethod, proofPurpose)
+}
+
+// checkJWTProofPurpose checks that the key which signed JWT belongs to DID of the signer (JWT "iss" claim)
+// and is listed under the verification relationship defined by the proof purpose.
+func checkJWTProofPurpose(keyID, signerDID, proofPurpose string, vdriRegistry vdri.Registry) error {
+	verificationMethod := keyID
+	if !strings.HasPrefix(keyID, "did:") {
+		verificationMethod = signerDID + "#" + strings.TrimPrefix(keyID, "#")
+	}
+
+	if strings.Split(verificationMethod, "#")[0] != signerDID {
+		return fmt.Errorf("key %s of JWT does not belong to %s", keyID, signerDID)
+	}
+
+	return checkProofPurpose(map[string]interface{}{
+		"proofPurpose":       proofPurpose,
+		"verificationMethod": verificationMethod,
+	}, signerDID, vdriRegistry)
+}
+
+// expectedProofSigner returns DID which is expected to sign the proofs of the document: the issuer of the credential
+// or the holder of the presentation.
+func expectedProofSigner(doc map[string]interface{}) string {
+	switch issuer := doc["issuer"].(type) {
+	case string:
+		return issuer
+	case map[string]interface{}:
+		return proofStringEntry(issuer, "id")
+	}
+
+	return proofStringEntry(doc, "holder")
+}
+
+// checkChallengeAndDomain checks that the proof was created for the expected challenge and domain (if defined).
+func checkChallengeAndDomain(p map[string]interface{}, challenge, domain string) error {
+	if challenge != "" && proofStringEntry(p, "challenge") != challenge {
+		return errors.New("proof challenge does not match the expected one")
+	}
+
+	if domain != "" && proofStringEntry(p, "domain") != domain {
+		return errors.New("proof domain does not match the expected one")
+	}
+
+	return nil
+}
+
+func proofStringEntry(p map[string]interface{}, key string) string {
+	if s, ok := p[key].(string); ok {
+		return s
+	}
+
+	return ""
+}
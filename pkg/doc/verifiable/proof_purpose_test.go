@@ -0,0 +1,347 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+)
+
+const proofPurposeTestDID = "did:example:76e12ec712ebc6f1c221ebfeb1f"
+
+func TestVerificationRelationship(t *testing.T) {
+	tests := []struct {
+		purpose      string
+		relationship did.VerificationRelationship
+	}{
+		{AssertionMethodProofPurpose, did.AssertionMethod},
+		{AuthenticationProofPurpose, did.Authentication},
+		{CapabilityInvocationProofPurpose, did.CapabilityInvocation},
+		{CapabilityDelegationProofPurpose, did.CapabilityDelegation},
+		{KeyAgreementProofPurpose, did.KeyAgreement},
+	}
+
+	for _, tc := range tests {
+		relationship, err := VerificationRelationship(tc.purpose)
+		require.NoError(t, err)
+		require.Equal(t, tc.relationship, relationship)
+	}
+
+	_, err := VerificationRelationship("unknown")
+	require.EqualError(t, err, "unsupported proof purpose: unknown")
+}
+
+func TestParseCredential_ProofPurposeCheck(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	pubKey := &did.PublicKey{
+		ID:         proofPurposeTestDID + "#key-1",
+		Type:       "Ed25519VerificationKey2018",
+		Controller: proofPurposeTestDID,
+		Value:      signer.PublicKeyBytes(),
+	}
+
+	didDoc := &did.Doc{
+		ID:        proofPurposeTestDID,
+		PublicKey: []did.PublicKey{*pubKey},
+		AssertionMethod: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pubKey, did.AssertionMethod, false),
+		},
+		CapabilityInvocation: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pubKey, did.CapabilityInvocation, false),
+		},
+	}
+
+	registry := &mockvdri.MockVDRIRegistry{ResolveValue: didDoc}
+
+	signVCWithKey := func(purpose, keyID string) []byte {
+		vc, err := parseTestCredential([]byte(validCredential))
+		r.NoError(err)
+
+		err = vc.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           "Ed25519Signature2018",
+			SignatureRepresentation: SignatureJWS,
+			Suite:                   ed25519signature2018.New(suite.WithSigner(signer)),
+			VerificationMethod:      keyID,
+			Purpose:                 purpose,
+		}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+		r.NoError(err)
+
+		vcBytes, err := json.Marshal(vc)
+		r.NoError(err)
+
+		return vcBytes
+	}
+
+	signVC := func(purpose string) []byte {
+		return signVCWithKey(purpose, pubKey.ID)
+	}
+
+	parse := func(vcBytes []byte, vdriRegistry *mockvdri.MockVDRIRegistry) error {
+		_, err := parseTestCredential(vcBytes,
+			WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+			WithProofPurposeCheck(vdriRegistry))
+
+		return err
+	}
+
+	t.Run("key is authorized for the proof purpose", func(t *testing.T) {
+		r.NoError(parse(signVC(""), registry))
+		r.NoError(parse(signVC(CapabilityInvocationProofPurpose), registry))
+	})
+
+	t.Run("key is not authorized for the proof purpose", func(t *testing.T) {
+		err := parse(signVC(AuthenticationProofPurpose), registry)
+		r.Error(err)
+		r.Contains(err.Error(), "is not authorized for proof purpose authentication")
+	})
+
+	t.Run("key does not belong to the issuer", func(t *testing.T) {
+		err := parse(signVCWithKey("", "did:example:other#key-1"), &mockvdri.MockVDRIRegistry{
+			ResolveValue: &did.Doc{ID: "did:example:other", AssertionMethod: didDoc.AssertionMethod},
+		})
+		r.Error(err)
+		r.Contains(err.Error(), "verification method did:example:other#key-1 does not belong to "+proofPurposeTestDID)
+	})
+
+	t.Run("unsupported proof purpose", func(t *testing.T) {
+		err := parse(signVC("unknown"), registry)
+		r.Error(err)
+		r.Contains(err.Error(), "unsupported proof purpose: unknown")
+	})
+
+	t.Run("DID resolution error", func(t *testing.T) {
+		err := parse(signVC(""), &mockvdri.MockVDRIRegistry{ResolveErr: errors.New("resolve error")})
+		r.Error(err)
+		r.Contains(err.Error(), "resolve DID "+proofPurposeTestDID+": resolve error")
+	})
+
+	t.Run("JWS", func(t *testing.T) {
+		signJWS := func(keyID string) []byte {
+			vc, err := parseTestCredential([]byte(validCredential))
+			r.NoError(err)
+
+			vc.Issuer.ID = proofPurposeTestDID
+
+			claims, err := vc.JWTClaims(false)
+			r.NoError(err)
+
+			vcJWS, err := claims.MarshalJWS(EdDSA, signer, keyID)
+			r.NoError(err)
+
+			return []byte(vcJWS)
+		}
+
+		r.NoError(parse(signJWS("#key-1"), registry))
+		r.NoError(parse(signJWS(pubKey.ID), registry))
+
+		err := parse(signJWS("did:example:other#key-1"), registry)
+		r.Error(err)
+		r.Contains(err.Error(), "key did:example:other#key-1 of JWT does not belong to "+proofPurposeTestDID)
+
+		err = parse(signJWS("#key-1"), &mockvdri.MockVDRIRegistry{ResolveValue: &did.Doc{
+			ID:        proofPurposeTestDID,
+			PublicKey: []did.PublicKey{*pubKey},
+		}})
+		r.Error(err)
+		r.Contains(err.Error(), "is not authorized for proof purpose assertionMethod")
+	})
+}
+
+func TestParsePresentation_ChallengeAndDomain(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	vp, err := newTestPresentation([]byte(validPresentation))
+	r.NoError(err)
+
+	err = vp.AddLinkedDataProof(&LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: SignatureJWS,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(signer)),
+		VerificationMethod:      "did:example:123456#key1",
+		Purpose:                 AuthenticationProofPurpose,
+		Challenge:               "challenge-1",
+		Domain:                  "example.com",
+	}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+	r.NoError(err)
+
+	vpBytes, err := json.Marshal(vp)
+	r.NoError(err)
+
+	parse := func(opts ...PresentationOpt) error {
+		_, err := newTestPresentation(vpBytes,
+			append([]PresentationOpt{
+				WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+			}, opts...)...)
+
+		return err
+	}
+
+	r.NoError(parse(WithPresChallenge("challenge-1"), WithPresDomain("example.com")))
+
+	err = parse(WithPresChallenge("challenge-2"))
+	r.Error(err)
+	r.Contains(err.Error(), "proof challenge does not match the expected one")
+
+	err = parse(WithPresDomain("other.example.com"))
+	r.Error(err)
+	r.Contains(err.Error(), "proof domain does not match the expected one")
+
+	_, err = newTestPresentation([]byte(validPresentation), WithPresChallenge("challenge-1"))
+	r.Error(err)
+	r.Contains(err.Error(), "proof with challenge or domain is expected")
+}
+
+func TestParsePresentation_ProofPurposeCheck(t *testing.T) {
+	r := require.New(t)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	pubKey := &did.PublicKey{
+		ID:         "#key-1",
+		Type:       "Ed25519VerificationKey2018",
+		Controller: proofPurposeTestDID,
+		Value:      signer.PublicKeyBytes(),
+	}
+
+	didDoc := &did.Doc{
+		ID:        proofPurposeTestDID,
+		PublicKey: []did.PublicKey{*pubKey},
+		Authentication: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pubKey, did.Authentication, true),
+		},
+	}
+
+	signVP := func(holder string) []byte {
+		vp, err := newTestPresentation([]byte(validPresentation))
+		r.NoError(err)
+
+		vp.Holder = holder
+
+		err = vp.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           "Ed25519Signature2018",
+			SignatureRepresentation: SignatureJWS,
+			Suite:                   ed25519signature2018.New(suite.WithSigner(signer)),
+			VerificationMethod:      proofPurposeTestDID + "#key-1",
+			Purpose:                 AuthenticationProofPurpose,
+		}, jsonld.WithDocumentLoader(createTestJSONLDDocumentLoader()))
+		r.NoError(err)
+
+		vpBytes, err := json.Marshal(vp)
+		r.NoError(err)
+
+		return vpBytes
+	}
+
+	vpBytes := signVP(proofPurposeTestDID)
+
+	_, err = newTestPresentation(vpBytes,
+		WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+		WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc}))
+	r.NoError(err)
+
+	// the key does not belong to the holder
+	_, err = newTestPresentation(signVP("did:example:other"),
+		WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+		WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc}))
+	r.Error(err)
+	r.Contains(err.Error(), "verification method "+proofPurposeTestDID+"#key-1 does not belong to did:example:other")
+
+	didDoc.Authentication = nil
+
+	_, err = newTestPresentation(vpBytes,
+		WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+		WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc}))
+	r.Error(err)
+	r.Contains(err.Error(), "is not authorized for proof purpose authentication")
+}
+
+func TestParsePresentation_JWTChallengeDomainAndProofPurpose(t *testing.T) {
+	r := require.New(t)
+
+	const holderDID = "did:example:ebfeb1f712ebc6f1c276e12ec21"
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	r.NoError(err)
+
+	vp, err := newTestPresentation([]byte(validPresentation))
+	r.NoError(err)
+
+	claims, err := vp.JWTClaims([]string{"example.com"}, false)
+	r.NoError(err)
+
+	claims.Nonce = "challenge-1"
+
+	vpJWS, err := claims.MarshalJWS(EdDSA, signer, "#key-1")
+	r.NoError(err)
+
+	pubKey := &did.PublicKey{
+		ID:         holderDID + "#key-1",
+		Type:       "Ed25519VerificationKey2018",
+		Controller: holderDID,
+		Value:      signer.PublicKeyBytes(),
+	}
+
+	didDoc := &did.Doc{
+		ID:        holderDID,
+		PublicKey: []did.PublicKey{*pubKey},
+		Authentication: []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(pubKey, did.Authentication, true),
+		},
+	}
+
+	parse := func(opts ...PresentationOpt) error {
+		_, err := newTestPresentation([]byte(vpJWS),
+			append([]PresentationOpt{
+				WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+			}, opts...)...)
+
+		return err
+	}
+
+	r.NoError(parse(WithPresChallenge("challenge-1"), WithPresDomain("example.com"),
+		WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc})))
+
+	err = parse(WithPresChallenge("challenge-2"))
+	r.Error(err)
+	r.Contains(err.Error(), "JWT nonce does not match the expected challenge")
+
+	err = parse(WithPresDomain("other.example.com"))
+	r.Error(err)
+	r.Contains(err.Error(), "JWT audience does not contain the expected domain")
+
+	didDoc.Authentication = nil
+
+	err = parse(WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc}))
+	r.Error(err)
+	r.Contains(err.Error(), "is not authorized for proof purpose authentication")
+
+	otherKeyJWS, err := claims.MarshalJWS(EdDSA, signer, "did:example:other#key-1")
+	r.NoError(err)
+
+	_, err = newTestPresentation([]byte(otherKeyJWS),
+		WithPresPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+		WithPresProofPurposeCheck(&mockvdri.MockVDRIRegistry{ResolveValue: didDoc}))
+	r.Error(err)
+	r.Contains(err.Error(), "does not belong to "+holderDID)
+}
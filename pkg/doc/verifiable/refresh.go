@@ -0,0 +1,203 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
+)
+
+const (
+	// RefreshService2021Context is the JSON-LD context of VC Refresh 2021.
+	RefreshService2021Context = "https://w3id.org/vc-refresh-service/v1"
+
+	// VerifiableCredentialRefreshService2021 is the type of the refresh service which issues the refreshed
+	// credential in exchange of a presentation proving control of the credential subject
+	// (https://w3c-ccg.github.io/vc-refresh-2021/).
+	VerifiableCredentialRefreshService2021 = "VerifiableCredentialRefreshService2021"
+
+	// MaxRefreshResponseSize is the maximum size of the refresh service response (in bytes)
+	// accepted by RefreshClient (10MB).
+	MaxRefreshResponseSize = 10485760
+
+	refreshServiceURLField = "url"
+)
+
+// ErrNoRefreshService is returned when the credential has no supported refresh service.
+var ErrNoRefreshService = errors.New("credential has no supported refresh service")
+
+// RefreshPresentationRequest is the verifiable presentation request returned by the refresh service
+// in response to the request without a presentation. The presentation sent to the refresh service must be
+// signed with the challenge of the request and the refresh service URL as a domain.
+type RefreshPresentationRequest struct {
+	Query     []map[string]interface{} `json:"query,omitempty"`
+	Challenge string                   `json:"challenge"`
+}
+
+// RefreshPresentationSigner creates the presentation of the credential sent to the refresh service
+// signed with the given challenge and domain.
+type RefreshPresentationSigner func(challenge, domain string) ([]byte, error)
+
+// RefreshServiceURL returns the endpoint of the credential's refresh service of
+// VerifiableCredentialRefreshService2021 type. The endpoint is taken from "url" field or "id" if "url" is not defined.
+func RefreshServiceURL(vc *Credential) (string, error) {
+	for _, service := range vc.RefreshService {
+		if service.Type != VerifiableCredentialRefreshService2021 {
+			continue
+		}
+
+		if url, ok := service.CustomFields[refreshServiceURLField].(string); ok && url != "" {
+			return url, nil
+		}
+
+		if service.ID != "" {
+			return service.ID, nil
+		}
+	}
+
+	return "", ErrNoRefreshService
+}
+
+// RefreshClient requests refreshed credentials from the refresh services of the credentials.
+type RefreshClient struct {
+	httpClient *http.Client
+	credOpts   []CredentialOpt
+}
+
+// RefreshClientOpt is the RefreshClient option.
+type RefreshClientOpt func(c *RefreshClient)
+
+// WithRefreshHTTPClient defines HTTP client used to call the refresh service.
+func WithRefreshHTTPClient(client *http.Client) RefreshClientOpt {
+	return func(c *RefreshClient) {
+		c.httpClient = client
+	}
+}
+
+// WithRefreshCredentialOpts defines options used to parse and verify the refreshed credentials.
+func WithRefreshCredentialOpts(opts ...CredentialOpt) RefreshClientOpt {
+	return func(c *RefreshClient) {
+		c.credOpts = opts
+	}
+}
+
+// NewRefreshClient creates a new RefreshClient.
+func NewRefreshClient(opts ...RefreshClientOpt) *RefreshClient {
+	c := &RefreshClient{httpClient: &http.Client{}}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Refresh requests the presentation request with a challenge from the refresh service of the credential,
+// sends the presentation signed by signPresentation to the refresh service and returns the refreshed credential.
+// The presentation is expected to contain the credential and to be signed by the credential subject.
+// The refreshed credential must be signed and have the same ID, issuer and subject as the original one.
+func (c *RefreshClient) Refresh(vc *Credential, signPresentation RefreshPresentationSigner) (*Credential, error) {
+	url, err := RefreshServiceURL(vc)
+	if err != nil {
+		return nil, err
+	}
+
+	requestBytes, err := c.post(url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var request RefreshPresentationRequest
+
+	if err = json.Unmarshal(requestBytes, &request); err != nil {
+		return nil, fmt.Errorf("decode refresh service %s presentation request: %w", url, err)
+	}
+
+	if request.Challenge == "" {
+		return nil, fmt.Errorf("presentation request of refresh service %s has no challenge", url)
+	}
+
+	vp, err := signPresentation(request.Challenge, url)
+	if err != nil {
+		return nil, fmt.Errorf("sign presentation: %w", err)
+	}
+
+	vcBytes, err := c.post(url, vp)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed, err := ParseCredential(vcBytes, c.credOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("parse refreshed credential: %w", err)
+	}
+
+	if len(refreshed.Proofs) == 0 && !jwt.IsJWS(string(vcBytes)) {
+		return nil, errors.New("refreshed credential is not signed")
+	}
+
+	if err := checkRefreshedCredential(vc, refreshed); err != nil {
+		return nil, fmt.Errorf("refreshed credential: %w", err)
+	}
+
+	return refreshed, nil
+}
+
+func (c *RefreshClient) post(url string, vp []byte) ([]byte, error) {
+	resp, err := c.httpClient.Post(url, "application/json", bytes.NewReader(vp)) //nolint:noctx
+	if err != nil {
+		return nil, fmt.Errorf("call refresh service %s: %w", url, err)
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Errorf("failed to close response body: %s", e)
+		}
+	}()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, MaxRefreshResponseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read refresh service %s response: %w", url, err)
+	}
+
+	if len(body) > MaxRefreshResponseSize {
+		return nil, fmt.Errorf("read refresh service %s response: response exceeds max size of %d bytes",
+			url, MaxRefreshResponseSize)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("call refresh service %s: status code %d: %s", url, resp.StatusCode, body)
+	}
+
+	return body, nil
+}
+
+func checkRefreshedCredential(vc, refreshed *Credential) error {
+	if refreshed.ID != vc.ID {
+		return fmt.Errorf("id %s does not match %s", refreshed.ID, vc.ID)
+	}
+
+	if refreshed.Issuer.ID != vc.Issuer.ID {
+		return fmt.Errorf("issuer %s does not match %s", refreshed.Issuer.ID, vc.Issuer.ID)
+	}
+
+	// subject without ID can't be compared
+	if subjectID, err := SubjectID(vc.Subject); err == nil {
+		refreshedSubjectID, err := SubjectID(refreshed.Subject)
+		if err != nil || refreshedSubjectID != subjectID {
+			return fmt.Errorf("subject does not match %s", subjectID)
+		}
+	}
+
+	return nil
+}
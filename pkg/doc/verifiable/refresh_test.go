@@ -0,0 +1,237 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+)
+
+func TestRefreshServiceURL(t *testing.T) {
+	url, err := RefreshServiceURL(&Credential{RefreshService: []TypedID{
+		{ID: "https://example.edu/refresh/manual", Type: "ManualRefreshService2018"},
+		{ID: "https://example.edu/refresh/1", Type: VerifiableCredentialRefreshService2021},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.edu/refresh/1", url)
+
+	url, err = RefreshServiceURL(&Credential{RefreshService: []TypedID{{
+		Type:         VerifiableCredentialRefreshService2021,
+		CustomFields: CustomFields{"url": "https://example.edu/refresh/2"},
+	}}})
+	require.NoError(t, err)
+	require.Equal(t, "https://example.edu/refresh/2", url)
+
+	_, err = RefreshServiceURL(&Credential{RefreshService: []TypedID{
+		{ID: "https://example.edu/refresh/manual", Type: "ManualRefreshService2018"},
+	}})
+	require.Equal(t, ErrNoRefreshService, err)
+
+	_, err = RefreshServiceURL(&Credential{})
+	require.Equal(t, ErrNoRefreshService, err)
+}
+
+func TestRefreshClient_Refresh(t *testing.T) {
+	const (
+		testRefreshChallenge = "d4d0a3cd-8a4c-4a5f-9d54-3d0f1b8b6a8e"
+
+		issuerID  = "did:example:76e12ec712ebc6f1c221ebfeb1f"
+		subjectID = "did:example:ebfeb1f712ebc6f1c276e12ec21"
+		vpContent = `{"type":"VerifiablePresentation"}`
+	)
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	require.NoError(t, err)
+
+	newVC := func(refreshURL, subject string, issued time.Time, signed bool) *Credential {
+		vc := &Credential{
+			Context: []string{baseContext, RefreshService2021Context},
+			ID:      "http://example.edu/credentials/1872",
+			Types:   []string{vcType},
+			Subject: subject,
+			Issuer:  Issuer{ID: issuerID},
+			Issued:  util.NewTime(issued),
+			RefreshService: []TypedID{
+				{ID: refreshURL, Type: VerifiableCredentialRefreshService2021},
+			},
+		}
+
+		if signed {
+			require.NoError(t, vc.AddLinkedDataProof(&LinkedDataProofContext{
+				SignatureType:           "Ed25519Signature2018",
+				SignatureRepresentation: SignatureProofValue,
+				Suite:                   ed25519signature2018.New(suite.WithSigner(signer)),
+				VerificationMethod:      issuerID + "#key1",
+			}, jsonld.WithDocumentLoader(testDocumentLoader)))
+		}
+
+		return vc
+	}
+
+	var received []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		if len(body) == 0 && r.URL.Path != "/unknown" {
+			challenge := testRefreshChallenge
+			if r.URL.Path == "/no-challenge" {
+				challenge = ""
+			}
+
+			_, err = w.Write([]byte(`{"query":[{"type":"DIDAuthentication"}],"challenge":"` + challenge + `"}`))
+			require.NoError(t, err)
+
+			return
+		}
+
+		received = body
+
+		var vc *Credential
+
+		switch r.URL.Path {
+		case "/refresh":
+			vc = newVC("http://"+r.Host+"/refresh", subjectID, time.Now(), true)
+		case "/unsigned":
+			vc = newVC("http://"+r.Host+"/unsigned", subjectID, time.Now(), false)
+		case "/other-subject":
+			vc = newVC("http://"+r.Host+"/other-subject", "did:example:other", time.Now(), true)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		vcBytes, err := json.Marshal(vc)
+		require.NoError(t, err)
+
+		_, err = w.Write(vcBytes)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	client := NewRefreshClient(
+		WithRefreshHTTPClient(server.Client()),
+		WithRefreshCredentialOpts(
+			WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+			WithJSONLDDocumentLoader(testDocumentLoader)))
+
+	issued := time.Now().Add(-time.Hour)
+
+	var signedWith []string
+
+	signVP := func(challenge, domain string) ([]byte, error) {
+		signedWith = []string{challenge, domain}
+
+		return []byte(vpContent), nil
+	}
+
+	t.Run("success", func(t *testing.T) {
+		refreshed, err := client.Refresh(newVC(server.URL+"/refresh", subjectID, issued, true), signVP)
+		require.NoError(t, err)
+		require.Equal(t, vpContent, string(received))
+		require.Equal(t, []string{testRefreshChallenge, server.URL + "/refresh"}, signedWith)
+		require.True(t, refreshed.Issued.After(issued))
+		require.Len(t, refreshed.Proofs, 1)
+	})
+
+	t.Run("presentation request has no challenge", func(t *testing.T) {
+		_, err := client.Refresh(newVC(server.URL+"/no-challenge", subjectID, issued, true), signVP)
+		require.EqualError(t, err, "presentation request of refresh service "+server.URL+
+			"/no-challenge has no challenge")
+	})
+
+	t.Run("presentation signing error", func(t *testing.T) {
+		_, err := client.Refresh(newVC(server.URL+"/refresh", subjectID, issued, true),
+			func(challenge, domain string) ([]byte, error) {
+				return nil, errors.New("signing error")
+			})
+		require.EqualError(t, err, "sign presentation: signing error")
+	})
+
+	t.Run("no refresh service", func(t *testing.T) {
+		_, err := client.Refresh(&Credential{}, signVP)
+		require.Equal(t, ErrNoRefreshService, err)
+	})
+
+	t.Run("refresh service error", func(t *testing.T) {
+		_, err := client.Refresh(newVC(server.URL+"/unknown", subjectID, issued, true), signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status code 404")
+
+		_, err = NewRefreshClient().Refresh(newVC("http://localhost:0/refresh", subjectID, issued, true), signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "call refresh service")
+	})
+
+	t.Run("refresh service response is too large", func(t *testing.T) {
+		largeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write(bytes.Repeat([]byte(" "), MaxRefreshResponseSize+1))
+			require.NoError(t, err)
+		}))
+		defer largeServer.Close()
+
+		_, err := client.Refresh(newVC(largeServer.URL+"/refresh", subjectID, issued, true), signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "response exceeds max size")
+	})
+
+	t.Run("refreshed credential is not signed", func(t *testing.T) {
+		_, err := NewRefreshClient(WithRefreshCredentialOpts(WithDisabledProofCheck(),
+			WithJSONLDDocumentLoader(testDocumentLoader))).
+			Refresh(newVC(server.URL+"/unsigned", subjectID, issued, true), signVP)
+		require.EqualError(t, err, "refreshed credential is not signed")
+	})
+
+	t.Run("refreshed credential has invalid proof", func(t *testing.T) {
+		otherSigner, err := newCryptoSigner(kms.ED25519Type)
+		require.NoError(t, err)
+
+		_, err = NewRefreshClient(WithRefreshCredentialOpts(
+			WithPublicKeyFetcher(SingleKey(otherSigner.PublicKeyBytes(), kms.ED25519)),
+			WithJSONLDDocumentLoader(testDocumentLoader))).
+			Refresh(newVC(server.URL+"/refresh", subjectID, issued, true), signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse refreshed credential")
+	})
+
+	t.Run("refreshed credential subject does not match", func(t *testing.T) {
+		_, err := client.Refresh(newVC(server.URL+"/other-subject", subjectID, issued, true), signVP)
+		require.EqualError(t, err, "refreshed credential: subject does not match "+subjectID)
+	})
+
+	t.Run("refreshed credential id or issuer does not match", func(t *testing.T) {
+		vc := newVC(server.URL+"/refresh", subjectID, issued, true)
+		vc.ID = "http://example.edu/credentials/other"
+
+		_, err := client.Refresh(vc, signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "refreshed credential: id http://example.edu/credentials/1872 does not match")
+
+		vc = newVC(server.URL+"/refresh", subjectID, issued, true)
+		vc.Issuer.ID = "did:example:other"
+
+		_, err = client.Refresh(vc, signVP)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "refreshed credential: issuer "+issuerID+" does not match")
+	})
+}
@@ -0,0 +1,67 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+// SchemaStoreName is the name of the store holding credential schemas cached by StoreSchemaCache.
+const SchemaStoreName = "credentialschema"
+
+// StoreSchemaCache is an implementation of SchemaCache based on storage.Store with expirable elements.
+// Unlike ExpirableSchemaCache, the cached schemas survive restarts of the agent and it is also available
+// in JS/WASM build (e.g. with jsindexeddb storage).
+type StoreSchemaCache struct {
+	store      storage.Store
+	expiration time.Duration
+}
+
+// NewStoreSchemaCache creates new instance of StoreSchemaCache.
+func NewStoreSchemaCache(storageProvider storage.Provider, expiration time.Duration) (*StoreSchemaCache, error) {
+	store, err := storageProvider.OpenStore(SchemaStoreName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open credential schema store: %w", err)
+	}
+
+	return &StoreSchemaCache{
+		store:      store,
+		expiration: expiration,
+	}, nil
+}
+
+// Put element to the cache. It also adds a mark of when the element will expire.
+func (sc *StoreSchemaCache) Put(k string, v []byte) {
+	err := sc.store.Put(k, withExpiration(v, sc.expiration))
+	if err != nil {
+		logger.Warnf("failed to put credential schema %s into the store: %s", k, err)
+	}
+}
+
+// Get element from the cache. If element is present, it checks if the element is expired.
+// If yes, it clears the element from the cache and indicates that the key is not found.
+func (sc *StoreSchemaCache) Get(k string) ([]byte, bool) {
+	b, err := sc.store.Get(k)
+	if err != nil {
+		return nil, false
+	}
+
+	v, ok := notExpired(b)
+	if !ok {
+		// cache expires
+		if err := sc.store.Delete(k); err != nil {
+			logger.Warnf("failed to delete expired credential schema %s from the store: %s", k, err)
+		}
+
+		return nil, false
+	}
+
+	return v, true
+}
@@ -0,0 +1,80 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	mockstorage "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/storage/mem"
+)
+
+func TestStoreSchemaCache(t *testing.T) {
+	t.Run("put and get schema", func(t *testing.T) {
+		provider := mem.NewProvider()
+
+		cache, err := NewStoreSchemaCache(provider, time.Hour)
+		require.NoError(t, err)
+
+		_, ok := cache.Get("https://example.com/schema.json")
+		require.False(t, ok)
+
+		cache.Put("https://example.com/schema.json", []byte("custom schema"))
+
+		schema, ok := cache.Get("https://example.com/schema.json")
+		require.True(t, ok)
+		require.Equal(t, []byte("custom schema"), schema)
+
+		// schema survives re-creation of the cache
+		cache, err = NewStoreSchemaCache(provider, time.Hour)
+		require.NoError(t, err)
+
+		schema, ok = cache.Get("https://example.com/schema.json")
+		require.True(t, ok)
+		require.Equal(t, []byte("custom schema"), schema)
+	})
+
+	t.Run("schema expires", func(t *testing.T) {
+		store := mockstorage.NewMockStoreProvider()
+
+		cache, err := NewStoreSchemaCache(store, -time.Hour)
+		require.NoError(t, err)
+
+		cache.Put("https://example.com/schema.json", []byte("custom schema"))
+		require.Len(t, store.Store.Store, 1)
+
+		_, ok := cache.Get("https://example.com/schema.json")
+		require.False(t, ok)
+		require.Empty(t, store.Store.Store)
+	})
+
+	t.Run("error on open store", func(t *testing.T) {
+		cache, err := NewStoreSchemaCache(&mockstorage.MockStoreProvider{
+			ErrOpenStoreHandle: errors.New("open error"),
+		}, time.Hour)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "failed to open credential schema store")
+		require.Nil(t, cache)
+	})
+
+	t.Run("store errors are not propagated", func(t *testing.T) {
+		store := mockstorage.NewMockStoreProvider()
+		store.Store.ErrPut = errors.New("put error")
+
+		cache, err := NewStoreSchemaCache(store, time.Hour)
+		require.NoError(t, err)
+
+		cache.Put("https://example.com/schema.json", []byte("custom schema"))
+
+		_, ok := cache.Get("https://example.com/schema.json")
+		require.False(t, ok)
+	})
+}
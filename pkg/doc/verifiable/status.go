@@ -0,0 +1,522 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jwt"
+)
+
+const (
+	// StatusList2021Context is the JSON-LD context of Status List 2021
+	// (https://w3c-ccg.github.io/vc-status-list-2021/).
+	StatusList2021Context = "https://w3id.org/vc/status-list/2021/v1"
+
+	// StatusList2021Entry is the type of credentialStatus which refers to a Status List 2021 credential.
+	StatusList2021Entry = "StatusList2021Entry"
+
+	// StatusList2021Credential is the type of Status List 2021 credential.
+	StatusList2021Credential = "StatusList2021Credential"
+
+	// StatusList2021 is the type of credentialSubject of Status List 2021 credential.
+	StatusList2021 = "StatusList2021"
+
+	// RevocationList2020Context is the JSON-LD context of Revocation List 2020
+	// (https://w3c-ccg.github.io/vc-status-rl-2020/).
+	RevocationList2020Context = "https://w3id.org/vc-revocation-list-2020/v1"
+
+	// RevocationList2020Status is the type of credentialStatus which refers to a Revocation List 2020 credential.
+	RevocationList2020Status = "RevocationList2020Status"
+
+	// RevocationList2020Credential is the type of Revocation List 2020 credential.
+	RevocationList2020Credential = "RevocationList2020Credential"
+
+	// RevocationList2020 is the type of credentialSubject of Revocation List 2020 credential.
+	RevocationList2020 = "RevocationList2020"
+
+	// StatusPurposeRevocation is a status purpose of the credential status entry used for revocation.
+	StatusPurposeRevocation = "revocation"
+
+	// StatusPurposeSuspension is a status purpose of the credential status entry used for suspension.
+	StatusPurposeSuspension = "suspension"
+
+	// MinStatusListSize is the minimum size of the status list (in bits) which provides group privacy
+	// (16KB of uncompressed bitstring).
+	MinStatusListSize = 131072
+
+	// MaxStatusListSize is the maximum size of the status list (in bits) accepted by DecodeStatusList
+	// (16MB of uncompressed bitstring). It protects against decompression bombs.
+	MaxStatusListSize = 134217728
+
+	// MaxStatusListCredentialSize is the maximum size of the downloaded status list credential (in bytes)
+	// accepted by StatusListVerifier (32MB). It is enough for base64 encoded status list of MaxStatusListSize.
+	MaxStatusListCredentialSize = 33554432
+
+	statusListIndexField          = "statusListIndex"
+	statusListCredentialField     = "statusListCredential"
+	statusPurposeField            = "statusPurpose"
+	revocationListIndexField      = "revocationListIndex"
+	revocationListCredentialField = "revocationListCredential"
+	encodedListField              = "encodedList"
+
+	bitsPerByte = 8
+
+	defaultStatusListCacheTTL = 5 * time.Minute
+)
+
+// ErrCredentialRevoked is returned when status check of the credential shows that the credential
+// is revoked (or suspended, depending on status purpose).
+var ErrCredentialRevoked = errors.New("credential is revoked")
+
+// StatusList is an uncompressed bitstring of the credential statuses. The bit at index i is
+// the status of the credential which has i as status list index; a bit set to 1 means the credential
+// is revoked (or suspended).
+type StatusList struct {
+	bits []byte
+}
+
+// NewStatusList creates a new StatusList of the given size (in bits) with all the statuses unset.
+func NewStatusList(size int) (*StatusList, error) {
+	if size <= 0 || size%bitsPerByte != 0 {
+		return nil, fmt.Errorf("invalid status list size %d: must be a positive multiple of %d", size, bitsPerByte)
+	}
+
+	return &StatusList{bits: make([]byte, size/bitsPerByte)}, nil
+}
+
+// DecodeStatusList decodes the GZIP-compressed and base64url-encoded bitstring
+// (e.g. "encodedList" of StatusList2021 credential).
+func DecodeStatusList(encodedList string) (*StatusList, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encodedList, "="))
+	if err != nil {
+		compressed, err = base64.StdEncoding.DecodeString(encodedList)
+		if err != nil {
+			return nil, fmt.Errorf("decode base64 status list: %w", err)
+		}
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("decompress status list: %w", err)
+	}
+
+	bits, err := ioutil.ReadAll(io.LimitReader(reader, MaxStatusListSize/bitsPerByte+1))
+	if err != nil {
+		return nil, fmt.Errorf("decompress status list: %w", err)
+	}
+
+	if len(bits) > MaxStatusListSize/bitsPerByte {
+		return nil, fmt.Errorf("decompress status list: status list exceeds max size of %d bits", MaxStatusListSize)
+	}
+
+	return &StatusList{bits: bits}, nil
+}
+
+// Size returns the number of statuses kept by the list.
+func (l *StatusList) Size() int {
+	return len(l.bits) * bitsPerByte
+}
+
+// Set sets the status at the given index.
+func (l *StatusList) Set(index int, status bool) error {
+	if index < 0 || index >= l.Size() {
+		return fmt.Errorf("status list index %d is out of range [0, %d)", index, l.Size())
+	}
+
+	// The first index is the left-most bit of the bitstring.
+	mask := byte(1 << (bitsPerByte - 1 - index%bitsPerByte))
+
+	if status {
+		l.bits[index/bitsPerByte] |= mask
+	} else {
+		l.bits[index/bitsPerByte] &^= mask
+	}
+
+	return nil
+}
+
+// Get returns the status at the given index.
+func (l *StatusList) Get(index int) (bool, error) {
+	if index < 0 || index >= l.Size() {
+		return false, fmt.Errorf("status list index %d is out of range [0, %d)", index, l.Size())
+	}
+
+	mask := byte(1 << (bitsPerByte - 1 - index%bitsPerByte))
+
+	return l.bits[index/bitsPerByte]&mask != 0, nil
+}
+
+// Encode compresses the bitstring using GZIP and encodes it using base64url with no padding.
+func (l *StatusList) Encode() (string, error) {
+	var buf bytes.Buffer
+
+	writer := gzip.NewWriter(&buf)
+
+	if _, err := writer.Write(l.bits); err != nil {
+		return "", fmt.Errorf("compress status list: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("compress status list: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// StatusListEntry is a parsed credentialStatus which refers to a position in a status list credential.
+type StatusListEntry struct {
+	// Type is either StatusList2021Entry or RevocationList2020Status.
+	Type string
+
+	// ListCredential is URL of the status list credential.
+	ListCredential string
+
+	// Index is a position of the credential status in the list.
+	Index int
+
+	// Purpose is a status purpose (StatusList2021Entry only).
+	Purpose string
+}
+
+// ParseStatusListEntry parses credentialStatus of StatusList2021Entry or RevocationList2020Status type.
+func ParseStatusListEntry(status *TypedID) (*StatusListEntry, error) {
+	if status == nil {
+		return nil, errors.New("credential status is not defined")
+	}
+
+	entry := &StatusListEntry{Type: status.Type}
+
+	var indexField, listField string
+
+	switch status.Type {
+	case StatusList2021Entry:
+		indexField, listField = statusListIndexField, statusListCredentialField
+
+		entry.Purpose = stringEntry(status.CustomFields[statusPurposeField])
+		if entry.Purpose == "" {
+			return nil, fmt.Errorf("%s is not defined in credential status", statusPurposeField)
+		}
+	case RevocationList2020Status:
+		indexField, listField = revocationListIndexField, revocationListCredentialField
+		entry.Purpose = StatusPurposeRevocation
+	default:
+		return nil, fmt.Errorf("unsupported credential status type: %s", status.Type)
+	}
+
+	entry.ListCredential = stringEntry(status.CustomFields[listField])
+	if entry.ListCredential == "" {
+		return nil, fmt.Errorf("%s is not defined in credential status", listField)
+	}
+
+	index, err := indexEntry(status.CustomFields[indexField])
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s of credential status: %w", indexField, err)
+	}
+
+	entry.Index = index
+
+	return entry, nil
+}
+
+// TypedID creates credentialStatus from the status list entry.
+func (e *StatusListEntry) TypedID() *TypedID {
+	status := &TypedID{
+		ID:           fmt.Sprintf("%s#%d", e.ListCredential, e.Index),
+		Type:         e.Type,
+		CustomFields: make(CustomFields),
+	}
+
+	switch e.Type {
+	case RevocationList2020Status:
+		status.CustomFields[revocationListIndexField] = strconv.Itoa(e.Index)
+		status.CustomFields[revocationListCredentialField] = e.ListCredential
+	default:
+		status.CustomFields[statusListIndexField] = strconv.Itoa(e.Index)
+		status.CustomFields[statusListCredentialField] = e.ListCredential
+		status.CustomFields[statusPurposeField] = e.Purpose
+	}
+
+	return status
+}
+
+func stringEntry(v interface{}) string {
+	s, ok := v.(string)
+	if !ok {
+		return ""
+	}
+
+	return s
+}
+
+func indexEntry(v interface{}) (int, error) {
+	switch index := v.(type) {
+	case string:
+		return strconv.Atoi(index)
+	case float64:
+		if index != math.Trunc(index) {
+			return 0, fmt.Errorf("index %v is not an integer", index)
+		}
+
+		return int(index), nil
+	case int:
+		return index, nil
+	default:
+		return 0, errors.New("index is not defined")
+	}
+}
+
+// StatusListVerifier checks the status of Verifiable Credentials using status list credentials
+// (StatusList2021 and RevocationList2020). It downloads the status list credentials, verifies their proofs
+// and keeps decoded status lists in the cache.
+type StatusListVerifier struct {
+	httpClient *http.Client
+	cacheTTL   time.Duration
+	credOpts   []CredentialOpt
+
+	mu    sync.Mutex
+	cache map[string]*cachedStatusList
+}
+
+type cachedStatusList struct {
+	issuer  string
+	purpose string
+	list    *StatusList
+	expires time.Time
+}
+
+// StatusListVerifierOpt is the StatusListVerifier option.
+type StatusListVerifierOpt func(v *StatusListVerifier)
+
+// WithStatusListHTTPClient defines HTTP client used to download status list credentials.
+func WithStatusListHTTPClient(client *http.Client) StatusListVerifierOpt {
+	return func(v *StatusListVerifier) {
+		v.httpClient = client
+	}
+}
+
+// WithStatusListCacheTTL defines how long the downloaded status list is kept in the cache.
+// Zero value disables caching.
+func WithStatusListCacheTTL(ttl time.Duration) StatusListVerifierOpt {
+	return func(v *StatusListVerifier) {
+		v.cacheTTL = ttl
+	}
+}
+
+// WithStatusListCredentialOpts defines options used to parse and verify status list credentials.
+// If not defined, the options of the credential being checked are used.
+func WithStatusListCredentialOpts(opts ...CredentialOpt) StatusListVerifierOpt {
+	return func(v *StatusListVerifier) {
+		v.credOpts = opts
+	}
+}
+
+// NewStatusListVerifier creates a new StatusListVerifier.
+func NewStatusListVerifier(opts ...StatusListVerifierOpt) *StatusListVerifier {
+	v := &StatusListVerifier{
+		httpClient: &http.Client{},
+		cacheTTL:   defaultStatusListCacheTTL,
+		cache:      make(map[string]*cachedStatusList),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// Check checks the status of the credential. It returns ErrCredentialRevoked if the credential status bit is set.
+// The credential without credentialStatus is considered valid.
+func (v *StatusListVerifier) Check(vc *Credential) error {
+	return v.check(vc, nil)
+}
+
+func (v *StatusListVerifier) check(vc *Credential, vcOpts *credentialOpts) error {
+	if vc.Status == nil {
+		return nil
+	}
+
+	entry, err := ParseStatusListEntry(vc.Status)
+	if err != nil {
+		return fmt.Errorf("check credential status: %w", err)
+	}
+
+	statusList, err := v.getStatusList(entry, vcOpts)
+	if err != nil {
+		return fmt.Errorf("check credential status: %w", err)
+	}
+
+	if statusList.issuer != vc.Issuer.ID {
+		return fmt.Errorf("check credential status: issuer of status list credential %s does not match %s",
+			statusList.issuer, vc.Issuer.ID)
+	}
+
+	if statusList.purpose != "" && statusList.purpose != entry.Purpose {
+		return fmt.Errorf("check credential status: status purpose %s does not match status list purpose %s",
+			entry.Purpose, statusList.purpose)
+	}
+
+	revoked, err := statusList.list.Get(entry.Index)
+	if err != nil {
+		return fmt.Errorf("check credential status: %w", err)
+	}
+
+	if revoked {
+		return fmt.Errorf("check credential status (%s): %w", entry.Purpose, ErrCredentialRevoked)
+	}
+
+	return nil
+}
+
+func (v *StatusListVerifier) getStatusList(entry *StatusListEntry, vcOpts *credentialOpts) (*cachedStatusList, error) {
+	v.mu.Lock()
+	cached, ok := v.cache[entry.ListCredential]
+	v.mu.Unlock()
+
+	if ok && time.Now().Before(cached.expires) {
+		return cached, nil
+	}
+
+	listVCBytes, err := v.download(entry.ListCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	listVC, err := v.parseListCredential(listVCBytes, vcOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	statusList, err := newCachedStatusList(listVC, entry.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.cacheTTL > 0 {
+		statusList.expires = time.Now().Add(v.cacheTTL)
+
+		v.mu.Lock()
+		v.cache[entry.ListCredential] = statusList
+		v.mu.Unlock()
+	}
+
+	return statusList, nil
+}
+
+func (v *StatusListVerifier) download(url string) ([]byte, error) {
+	resp, err := v.httpClient.Get(url) //nolint:noctx
+	if err != nil {
+		return nil, fmt.Errorf("download status list credential %s: %w", url, err)
+	}
+
+	defer func() {
+		if e := resp.Body.Close(); e != nil {
+			logger.Errorf("failed to close response body: %s", e)
+		}
+	}()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, MaxStatusListCredentialSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read status list credential %s: %w", url, err)
+	}
+
+	if len(body) > MaxStatusListCredentialSize {
+		return nil, fmt.Errorf("read status list credential %s: credential exceeds max size of %d bytes",
+			url, MaxStatusListCredentialSize)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("download status list credential %s: status code %d", url, resp.StatusCode)
+	}
+
+	return body, nil
+}
+
+func (v *StatusListVerifier) parseListCredential(listVCBytes []byte, vcOpts *credentialOpts) (*Credential, error) {
+	var opts []CredentialOpt
+
+	switch {
+	case len(v.credOpts) > 0:
+		opts = v.credOpts
+	case vcOpts != nil:
+		opts = []CredentialOpt{func(opts *credentialOpts) {
+			*opts = *vcOpts
+			opts.statusVerifier = nil
+		}}
+	}
+
+	listVC, err := ParseCredential(listVCBytes, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("parse status list credential: %w", err)
+	}
+
+	if len(listVC.Proofs) == 0 && !jwt.IsJWS(string(listVCBytes)) {
+		return nil, errors.New("status list credential is not signed")
+	}
+
+	return listVC, nil
+}
+
+func newCachedStatusList(listVC *Credential, entryType string) (*cachedStatusList, error) {
+	listVCType, listSubjectType := StatusList2021Credential, StatusList2021
+	if entryType == RevocationList2020Status {
+		listVCType, listSubjectType = RevocationList2020Credential, RevocationList2020
+	}
+
+	if !hasType(listVC.Types, listVCType) {
+		return nil, fmt.Errorf("status list credential is not of %s type", listVCType)
+	}
+
+	subjects, ok := listVC.Subject.([]Subject)
+	if !ok || len(subjects) != 1 {
+		return nil, errors.New("status list credential must have a single subject")
+	}
+
+	subject := subjects[0]
+
+	if t := stringEntry(subject.CustomFields["type"]); t != listSubjectType {
+		return nil, fmt.Errorf("status list credential subject is not of %s type", listSubjectType)
+	}
+
+	encodedList := stringEntry(subject.CustomFields[encodedListField])
+	if encodedList == "" {
+		return nil, fmt.Errorf("%s is not defined in status list credential", encodedListField)
+	}
+
+	list, err := DecodeStatusList(encodedList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cachedStatusList{
+		issuer:  listVC.Issuer.ID,
+		purpose: stringEntry(subject.CustomFields[statusPurposeField]),
+		list:    list,
+	}, nil
+}
+
+func hasType(types []string, t string) bool {
+	for _, vcType := range types {
+		if vcType == t {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,352 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+)
+
+func TestStatusList(t *testing.T) {
+	t.Run("set, get, encode and decode", func(t *testing.T) {
+		list, err := NewStatusList(MinStatusListSize)
+		require.NoError(t, err)
+		require.Equal(t, MinStatusListSize, list.Size())
+
+		require.NoError(t, list.Set(0, true))
+		require.NoError(t, list.Set(94567, true))
+		require.NoError(t, list.Set(MinStatusListSize-1, true))
+		require.NoError(t, list.Set(MinStatusListSize-1, false))
+
+		encoded, err := list.Encode()
+		require.NoError(t, err)
+
+		decoded, err := DecodeStatusList(encoded)
+		require.NoError(t, err)
+		require.Equal(t, list.Size(), decoded.Size())
+
+		for index, expected := range map[int]bool{0: true, 1: false, 94567: true, MinStatusListSize - 1: false} {
+			status, err := decoded.Get(index)
+			require.NoError(t, err)
+			require.Equal(t, expected, status, "index %d", index)
+		}
+
+		// the first index is the left-most bit
+		require.Equal(t, byte(0x80), decoded.bits[0])
+	})
+
+	t.Run("invalid size", func(t *testing.T) {
+		_, err := NewStatusList(7)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid status list size")
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		list, err := NewStatusList(8)
+		require.NoError(t, err)
+
+		require.Error(t, list.Set(8, true))
+		require.Error(t, list.Set(-1, true))
+
+		_, err = list.Get(8)
+		require.Error(t, err)
+	})
+
+	t.Run("decode invalid list", func(t *testing.T) {
+		_, err := DecodeStatusList("!!!")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "decode base64 status list")
+
+		_, err = DecodeStatusList("bm90IGd6aXA")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "decompress status list")
+	})
+
+	t.Run("decode too large list", func(t *testing.T) {
+		list, err := NewStatusList(MaxStatusListSize + bitsPerByte)
+		require.NoError(t, err)
+
+		encoded, err := list.Encode()
+		require.NoError(t, err)
+
+		_, err = DecodeStatusList(encoded)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "exceeds max size")
+	})
+}
+
+func TestParseStatusListEntry(t *testing.T) {
+	t.Run("StatusList2021Entry", func(t *testing.T) {
+		entry, err := ParseStatusListEntry(&TypedID{
+			ID:   "https://example.com/credentials/status/3#94567",
+			Type: StatusList2021Entry,
+			CustomFields: CustomFields{
+				"statusPurpose":        "revocation",
+				"statusListIndex":      "94567",
+				"statusListCredential": "https://example.com/credentials/status/3",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &StatusListEntry{
+			Type:           StatusList2021Entry,
+			ListCredential: "https://example.com/credentials/status/3",
+			Index:          94567,
+			Purpose:        StatusPurposeRevocation,
+		}, entry)
+
+		require.Equal(t, "https://example.com/credentials/status/3#94567", entry.TypedID().ID)
+
+		reparsed, err := ParseStatusListEntry(entry.TypedID())
+		require.NoError(t, err)
+		require.Equal(t, entry, reparsed)
+	})
+
+	t.Run("RevocationList2020Status", func(t *testing.T) {
+		entry, err := ParseStatusListEntry(&TypedID{
+			ID:   "https://example.com/credentials/status/3#94567",
+			Type: RevocationList2020Status,
+			CustomFields: CustomFields{
+				"revocationListIndex":      94567.0,
+				"revocationListCredential": "https://example.com/credentials/status/3",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 94567, entry.Index)
+		require.Equal(t, StatusPurposeRevocation, entry.Purpose)
+
+		reparsed, err := ParseStatusListEntry(entry.TypedID())
+		require.NoError(t, err)
+		require.Equal(t, entry, reparsed)
+	})
+
+	t.Run("invalid entries", func(t *testing.T) {
+		_, err := ParseStatusListEntry(nil)
+		require.Error(t, err)
+
+		_, err = ParseStatusListEntry(&TypedID{Type: "CredentialStatusList2017"})
+		require.EqualError(t, err, "unsupported credential status type: CredentialStatusList2017")
+
+		_, err = ParseStatusListEntry(&TypedID{Type: StatusList2021Entry, CustomFields: CustomFields{}})
+		require.EqualError(t, err, "statusPurpose is not defined in credential status")
+
+		_, err = ParseStatusListEntry(&TypedID{
+			Type:         StatusList2021Entry,
+			CustomFields: CustomFields{"statusPurpose": "revocation"},
+		})
+		require.EqualError(t, err, "statusListCredential is not defined in credential status")
+
+		_, err = ParseStatusListEntry(&TypedID{
+			Type: RevocationList2020Status,
+			CustomFields: CustomFields{
+				"revocationListCredential": "https://example.com/credentials/status/3",
+				"revocationListIndex":      "not a number",
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid revocationListIndex of credential status")
+
+		_, err = ParseStatusListEntry(&TypedID{
+			Type: RevocationList2020Status,
+			CustomFields: CustomFields{
+				"revocationListCredential": "https://example.com/credentials/status/3",
+				"revocationListIndex":      94567.5,
+			},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "index 94567.5 is not an integer")
+	})
+}
+
+func TestParseCredentialWithStatusCheck(t *testing.T) {
+	const issuerID = "did:example:76e12ec712ebc6f1c221ebfeb1f"
+
+	signer, err := newCryptoSigner(kms.ED25519Type)
+	require.NoError(t, err)
+
+	sigSuite := ed25519signature2018.New(
+		suite.WithSigner(signer),
+		suite.WithVerifier(ed25519signature2018.NewPublicKeyVerifier()))
+
+	list, err := NewStatusList(MinStatusListSize)
+	require.NoError(t, err)
+	require.NoError(t, list.Set(42, true))
+
+	listVCBytes := createTestStatusListCredential(t, list, issuerID, sigSuite, true)
+
+	var hits int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+
+		switch r.URL.Path {
+		case "/status/1":
+			_, err := w.Write(listVCBytes)
+			require.NoError(t, err)
+		case "/status/large":
+			_, err := w.Write(bytes.Repeat([]byte(" "), MaxStatusListCredentialSize+1))
+			require.NoError(t, err)
+		case "/status/unsigned":
+			_, err := w.Write(createTestStatusListCredential(t, list, issuerID, sigSuite, false))
+			require.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	vcOpts := []CredentialOpt{
+		WithEmbeddedSignatureSuites(sigSuite),
+		WithPublicKeyFetcher(SingleKey(signer.PublicKeyBytes(), kms.ED25519)),
+	}
+
+	vcWithStatus := func(index int, listURL, issuer string) []byte {
+		entry := &StatusListEntry{
+			Type:           StatusList2021Entry,
+			ListCredential: listURL,
+			Index:          index,
+			Purpose:        StatusPurposeRevocation,
+		}
+
+		vc := &Credential{
+			Context: []string{baseContext, StatusList2021Context},
+			ID:      "http://example.edu/credentials/1872",
+			Types:   []string{vcType},
+			Subject: "did:example:ebfeb1f712ebc6f1c276e12ec21",
+			Issuer:  Issuer{ID: issuer},
+			Issued:  util.NewTime(time.Now()),
+			Status:  entry.TypedID(),
+		}
+
+		require.NoError(t, vc.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           "Ed25519Signature2018",
+			SignatureRepresentation: SignatureProofValue,
+			Suite:                   sigSuite,
+			VerificationMethod:      issuer + "#key1",
+		}, jsonld.WithDocumentLoader(testDocumentLoader)))
+
+		vcBytes, err := json.Marshal(vc)
+		require.NoError(t, err)
+
+		return vcBytes
+	}
+
+	t.Run("valid status and cached status list", func(t *testing.T) {
+		atomic.StoreInt32(&hits, 0)
+
+		statusVerifier := NewStatusListVerifier()
+
+		for i := 0; i < 2; i++ {
+			vc, err := parseTestCredential(vcWithStatus(41, server.URL+"/status/1", issuerID),
+				append(vcOpts, WithStatusCheck(statusVerifier))...)
+			require.NoError(t, err)
+			require.NotNil(t, vc.Status)
+		}
+
+		require.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	})
+
+	t.Run("revoked credential", func(t *testing.T) {
+		_, err := parseTestCredential(vcWithStatus(42, server.URL+"/status/1", issuerID),
+			append(vcOpts, WithStatusCheck(NewStatusListVerifier()))...)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, ErrCredentialRevoked))
+	})
+
+	t.Run("check with explicit status list credential options", func(t *testing.T) {
+		vc, err := parseTestCredential(vcWithStatus(42, server.URL+"/status/1", issuerID), vcOpts...)
+		require.NoError(t, err)
+
+		statusVerifier := NewStatusListVerifier(
+			WithStatusListHTTPClient(server.Client()),
+			WithStatusListCacheTTL(0),
+			WithStatusListCredentialOpts(append(vcOpts, WithJSONLDDocumentLoader(testDocumentLoader))...))
+
+		err = statusVerifier.Check(vc)
+		require.True(t, errors.Is(err, ErrCredentialRevoked))
+	})
+
+	t.Run("no credential status", func(t *testing.T) {
+		require.NoError(t, NewStatusListVerifier().Check(&Credential{}))
+	})
+
+	t.Run("issuer mismatch", func(t *testing.T) {
+		_, err := parseTestCredential(vcWithStatus(41, server.URL+"/status/1", "did:example:other"),
+			append(vcOpts, WithStatusCheck(NewStatusListVerifier()))...)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not match did:example:other")
+	})
+
+	t.Run("status list credential is not signed", func(t *testing.T) {
+		_, err := parseTestCredential(vcWithStatus(41, server.URL+"/status/unsigned", issuerID),
+			append(vcOpts, WithStatusCheck(NewStatusListVerifier()))...)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status list credential is not signed")
+	})
+
+	t.Run("status list credential is not found", func(t *testing.T) {
+		_, err := parseTestCredential(vcWithStatus(41, server.URL+"/status/2", issuerID),
+			append(vcOpts, WithStatusCheck(NewStatusListVerifier()))...)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "status code 404")
+	})
+
+	t.Run("status list credential is too large", func(t *testing.T) {
+		_, err := parseTestCredential(vcWithStatus(41, server.URL+"/status/large", issuerID),
+			append(vcOpts, WithStatusCheck(NewStatusListVerifier()))...)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credential exceeds max size")
+	})
+}
+
+func createTestStatusListCredential(t *testing.T, list *StatusList, issuerID string,
+	sigSuite *ed25519signature2018.Suite, sign bool) []byte {
+	encodedList, err := list.Encode()
+	require.NoError(t, err)
+
+	listVC := &Credential{
+		Context: []string{baseContext, StatusList2021Context},
+		ID:      "https://example.com/status/1",
+		Types:   []string{vcType, StatusList2021Credential},
+		Subject: []Subject{{
+			ID: "https://example.com/status/1#list",
+			CustomFields: CustomFields{
+				"type":          StatusList2021,
+				"statusPurpose": StatusPurposeRevocation,
+				"encodedList":   encodedList,
+			},
+		}},
+		Issuer: Issuer{ID: issuerID},
+		Issued: util.NewTime(time.Now()),
+	}
+
+	if sign {
+		require.NoError(t, listVC.AddLinkedDataProof(&LinkedDataProofContext{
+			SignatureType:           "Ed25519Signature2018",
+			SignatureRepresentation: SignatureProofValue,
+			Suite:                   sigSuite,
+			VerificationMethod:      issuerID + "#key1",
+		}, jsonld.WithDocumentLoader(testDocumentLoader)))
+	}
+
+	listVCBytes, err := json.Marshal(listVC)
+	require.NoError(t, err)
+
+	return listVCBytes
+}
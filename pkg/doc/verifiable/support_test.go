@@ -246,7 +246,7 @@ func newCryptoSigner(keyType kmsapi.KeyType) (signature.Signer, error) {
 var testDocumentLoader = createTestJSONLDDocumentLoader()
 
 func createTestJSONLDDocumentLoader() *ld.CachingDocumentLoader {
-	loader := CachingJSONLDLoader()
+	loader := createTestRemoteJSONLDDocumentLoader()
 
 	addJSONLDCachedContextFromFile(loader,
 		"https://www.w3.org/2018/credentials/examples/v1", "vc_example.jsonld")
@@ -263,10 +263,24 @@ func createTestJSONLDDocumentLoader() *ld.CachingDocumentLoader {
 	addJSONLDCachedContextFromFile(loader,
 		"https://trustbloc.github.io/context/vc/presentation-exchange-submission-v1.jsonld",
 		"presentation_submission_v1.jsonld")
+	addJSONLDCachedContextFromFile(loader, StatusList2021Context, "status_list_2021.jsonld")
+	addJSONLDCachedContextFromFile(loader, RevocationList2020Context, "revocation_list_2020.jsonld")
 
 	return loader
 }
 
+// createTestRemoteJSONLDDocumentLoader creates JSON-LD document loader which fetches the contexts
+// which are not embedded from the network (e.g. from the test servers).
+func createTestRemoteJSONLDDocumentLoader() *ld.CachingDocumentLoader {
+	loader, err := jsonld.NewDocumentLoader(storage.NewMockStoreProvider(),
+		jsonld.WithRemoteDocumentLoader(ld.NewDefaultDocumentLoader(nil)))
+	if err != nil {
+		panic(err)
+	}
+
+	return ld.NewCachingDocumentLoader(loader)
+}
+
 func addJSONLDCachedContextFromFile(loader *ld.CachingDocumentLoader, contextURL, contextFile string) {
 	contextContent, err := ioutil.ReadFile(filepath.Clean(filepath.Join(
 		jsonldContextPrefix, contextFile)))
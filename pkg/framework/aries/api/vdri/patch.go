@@ -0,0 +1,217 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+)
+
+// ErrOperationNotSupported is returned when the VDRI does not support DID update or deactivation.
+var ErrOperationNotSupported = errors.New("operation not supported")
+
+// ErrDeactivated is returned when resolving, updating or deactivating already deactivated DID.
+var ErrDeactivated = errors.New("DID is deactivated")
+
+// Patch is the typed DID document update.
+type Patch interface {
+	// Apply applies the patch to the DID document.
+	Apply(doc *did.Doc) error
+}
+
+// AddPublicKeysPatch adds public keys to the DID document. Added keys are referenced by verification
+// methods of the given relationships (e.g. authentication).
+type AddPublicKeysPatch struct {
+	PublicKeys    []did.PublicKey
+	Relationships []did.VerificationRelationship
+}
+
+// Apply adds public keys to the DID document.
+func (p *AddPublicKeysPatch) Apply(doc *did.Doc) error {
+	for i := range p.PublicKeys {
+		pk := p.PublicKeys[i]
+
+		if pk.ID == "" {
+			return errors.New("public key ID is mandatory")
+		}
+
+		for _, existing := range doc.PublicKey {
+			if sameID(doc.ID, existing.ID, pk.ID) {
+				return fmt.Errorf("public key %s already exists", pk.ID)
+			}
+		}
+
+		doc.PublicKey = append(doc.PublicKey, pk)
+
+		for _, r := range p.Relationships {
+			vm := did.VerificationMethod{PublicKey: pk, Relationship: r}
+
+			switch r {
+			case did.Authentication:
+				doc.Authentication = append(doc.Authentication, vm)
+			case did.AssertionMethod:
+				doc.AssertionMethod = append(doc.AssertionMethod, vm)
+			case did.CapabilityDelegation:
+				doc.CapabilityDelegation = append(doc.CapabilityDelegation, vm)
+			case did.CapabilityInvocation:
+				doc.CapabilityInvocation = append(doc.CapabilityInvocation, vm)
+			case did.KeyAgreement:
+				doc.KeyAgreement = append(doc.KeyAgreement, vm)
+			case did.VerificationRelationshipGeneral:
+			default:
+				return fmt.Errorf("unsupported verification relationship: %d", r)
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemovePublicKeysPatch removes public keys and the verification methods referencing them
+// from the DID document.
+type RemovePublicKeysPatch struct {
+	IDs []string
+}
+
+// Apply removes public keys from the DID document.
+func (p *RemovePublicKeysPatch) Apply(doc *did.Doc) error {
+	for _, id := range p.IDs {
+		found := false
+
+		for i := range doc.PublicKey {
+			if sameID(doc.ID, doc.PublicKey[i].ID, id) {
+				doc.PublicKey = append(doc.PublicKey[:i], doc.PublicKey[i+1:]...)
+				found = true
+
+				break
+			}
+		}
+
+		doc.Authentication, found = removeVerificationMethod(doc.ID, doc.Authentication, id, found)
+		doc.AssertionMethod, found = removeVerificationMethod(doc.ID, doc.AssertionMethod, id, found)
+		doc.CapabilityDelegation, found = removeVerificationMethod(doc.ID, doc.CapabilityDelegation, id, found)
+		doc.CapabilityInvocation, found = removeVerificationMethod(doc.ID, doc.CapabilityInvocation, id, found)
+		doc.KeyAgreement, found = removeVerificationMethod(doc.ID, doc.KeyAgreement, id, found)
+
+		if !found {
+			return fmt.Errorf("public key %s not found", id)
+		}
+	}
+
+	return nil
+}
+
+// AddServicesPatch adds services to the DID document.
+type AddServicesPatch struct {
+	Services []did.Service
+}
+
+// Apply adds services to the DID document.
+func (p *AddServicesPatch) Apply(doc *did.Doc) error {
+	for _, svc := range p.Services {
+		if svc.ID == "" {
+			return errors.New("service ID is mandatory")
+		}
+
+		for _, existing := range doc.Service {
+			if sameID(doc.ID, existing.ID, svc.ID) {
+				return fmt.Errorf("service %s already exists", svc.ID)
+			}
+		}
+
+		doc.Service = append(doc.Service, svc)
+	}
+
+	return nil
+}
+
+// RemoveServicesPatch removes services from the DID document.
+type RemoveServicesPatch struct {
+	IDs []string
+}
+
+// Apply removes services from the DID document.
+func (p *RemoveServicesPatch) Apply(doc *did.Doc) error {
+	for _, id := range p.IDs {
+		found := false
+
+		for i := range doc.Service {
+			if sameID(doc.ID, doc.Service[i].ID, id) {
+				doc.Service = append(doc.Service[:i], doc.Service[i+1:]...)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("service %s not found", id)
+		}
+	}
+
+	return nil
+}
+
+// ReplacePatch replaces public keys, verification methods and services of the DID document
+// with the ones of the given document. The DID and the context are kept.
+type ReplacePatch struct {
+	Document *did.Doc
+}
+
+// Apply replaces the content of the DID document.
+func (p *ReplacePatch) Apply(doc *did.Doc) error {
+	if p.Document == nil {
+		return errors.New("replacement document is mandatory")
+	}
+
+	if p.Document.ID != "" && p.Document.ID != doc.ID {
+		return fmt.Errorf("replacement document ID %s does not match DID %s", p.Document.ID, doc.ID)
+	}
+
+	doc.PublicKey = p.Document.PublicKey
+	doc.Service = p.Document.Service
+	doc.Authentication = p.Document.Authentication
+	doc.AssertionMethod = p.Document.AssertionMethod
+	doc.CapabilityDelegation = p.Document.CapabilityDelegation
+	doc.CapabilityInvocation = p.Document.CapabilityInvocation
+	doc.KeyAgreement = p.Document.KeyAgreement
+
+	return nil
+}
+
+func removeVerificationMethod(docID string, methods []did.VerificationMethod, id string,
+	found bool) ([]did.VerificationMethod, bool) {
+	var result []did.VerificationMethod
+
+	for _, vm := range methods {
+		if sameID(docID, vm.PublicKey.ID, id) {
+			found = true
+
+			continue
+		}
+
+		result = append(result, vm)
+	}
+
+	return result, found
+}
+
+// sameID compares (relative or absolute) IDs of the DID document elements.
+func sameID(docID, id1, id2 string) bool {
+	abs := func(id string) string {
+		if strings.HasPrefix(id, "#") {
+			return docID + id
+		}
+
+		return id
+	}
+
+	return abs(id1) == abs(id2)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+)
+
+const testDID = "did:example:123"
+
+func TestAddPublicKeysPatch(t *testing.T) {
+	pk := did.PublicKey{ID: testDID + "#key-1", Type: "Ed25519VerificationKey2018", Value: []byte("key")}
+
+	t.Run("test success", func(t *testing.T) {
+		doc := &did.Doc{ID: testDID}
+
+		err := (&AddPublicKeysPatch{
+			PublicKeys: []did.PublicKey{pk},
+			Relationships: []did.VerificationRelationship{
+				did.Authentication, did.AssertionMethod, did.CapabilityDelegation,
+				did.CapabilityInvocation, did.KeyAgreement, did.VerificationRelationshipGeneral,
+			},
+		}).Apply(doc)
+		require.NoError(t, err)
+		require.Equal(t, []did.PublicKey{pk}, doc.PublicKey)
+		require.Len(t, doc.Authentication, 1)
+		require.Len(t, doc.AssertionMethod, 1)
+		require.Len(t, doc.CapabilityDelegation, 1)
+		require.Len(t, doc.CapabilityInvocation, 1)
+		require.Len(t, doc.KeyAgreement, 1)
+		require.Equal(t, did.Authentication, doc.Authentication[0].Relationship)
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		doc := &did.Doc{ID: testDID, PublicKey: []did.PublicKey{pk}}
+
+		err := (&AddPublicKeysPatch{PublicKeys: []did.PublicKey{{ID: "#key-1"}}}).Apply(doc)
+		require.EqualError(t, err, "public key #key-1 already exists")
+
+		err = (&AddPublicKeysPatch{PublicKeys: []did.PublicKey{{}}}).Apply(doc)
+		require.EqualError(t, err, "public key ID is mandatory")
+
+		err = (&AddPublicKeysPatch{
+			PublicKeys:    []did.PublicKey{{ID: "#key-2"}},
+			Relationships: []did.VerificationRelationship{100},
+		}).Apply(doc)
+		require.EqualError(t, err, "unsupported verification relationship: 100")
+	})
+}
+
+func TestRemovePublicKeysPatch(t *testing.T) {
+	pk1 := did.PublicKey{ID: testDID + "#key-1"}
+	pk2 := did.PublicKey{ID: "#key-2"}
+
+	doc := &did.Doc{
+		ID:             testDID,
+		PublicKey:      []did.PublicKey{pk1},
+		Authentication: []did.VerificationMethod{{PublicKey: pk1}, {PublicKey: pk2, Embedded: true}},
+		KeyAgreement:   []did.VerificationMethod{{PublicKey: pk2, Embedded: true}},
+	}
+
+	require.NoError(t, (&RemovePublicKeysPatch{IDs: []string{"#key-1", testDID + "#key-2"}}).Apply(doc))
+	require.Empty(t, doc.PublicKey)
+	require.Empty(t, doc.Authentication)
+	require.Empty(t, doc.KeyAgreement)
+
+	err := (&RemovePublicKeysPatch{IDs: []string{"#key-1"}}).Apply(doc)
+	require.EqualError(t, err, "public key #key-1 not found")
+}
+
+func TestServicesPatches(t *testing.T) {
+	svc := did.Service{ID: testDID + "#svc", Type: "did-communication", ServiceEndpoint: "https://example.com"}
+	doc := &did.Doc{ID: testDID}
+
+	require.NoError(t, (&AddServicesPatch{Services: []did.Service{svc}}).Apply(doc))
+	require.Equal(t, []did.Service{svc}, doc.Service)
+
+	err := (&AddServicesPatch{Services: []did.Service{{ID: "#svc"}}}).Apply(doc)
+	require.EqualError(t, err, "service #svc already exists")
+
+	err = (&AddServicesPatch{Services: []did.Service{{}}}).Apply(doc)
+	require.EqualError(t, err, "service ID is mandatory")
+
+	require.NoError(t, (&RemoveServicesPatch{IDs: []string{"#svc"}}).Apply(doc))
+	require.Empty(t, doc.Service)
+
+	err = (&RemoveServicesPatch{IDs: []string{"#svc"}}).Apply(doc)
+	require.EqualError(t, err, "service #svc not found")
+}
+
+func TestReplacePatch(t *testing.T) {
+	doc := &did.Doc{
+		Context:   []string{"https://w3id.org/did/v1"},
+		ID:        testDID,
+		PublicKey: []did.PublicKey{{ID: "#key-1"}},
+	}
+
+	replacement := &did.Doc{
+		PublicKey: []did.PublicKey{{ID: "#key-2"}},
+		Service:   []did.Service{{ID: "#svc"}},
+	}
+
+	require.NoError(t, (&ReplacePatch{Document: replacement}).Apply(doc))
+	require.Equal(t, testDID, doc.ID)
+	require.Equal(t, []string{"https://w3id.org/did/v1"}, doc.Context)
+	require.Equal(t, replacement.PublicKey, doc.PublicKey)
+	require.Equal(t, replacement.Service, doc.Service)
+
+	err := (&ReplacePatch{}).Apply(doc)
+	require.EqualError(t, err, "replacement document is mandatory")
+
+	err = (&ReplacePatch{Document: &did.Doc{ID: "did:example:other"}}).Apply(doc)
+	require.EqualError(t, err, "replacement document ID did:example:other does not match DID "+testDID)
+}
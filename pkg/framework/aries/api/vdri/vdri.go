@@ -23,16 +23,21 @@ const DIDCommServiceType = "did-communication"
 // Registry vdri registry.
 type Registry interface {
 	Resolve(did string, opts ...ResolveOpts) (*did.Doc, error)
+	ResolveWithMetadata(did string, opts ...ResolveOpts) (*did.DocResolution, error)
 	Store(doc *did.Doc) error
 	Create(method string, opts ...DocOpts) (*did.Doc, error)
+	Update(did string, patches ...Patch) (*did.Doc, error)
+	Deactivate(did string) error
 	Close() error
 }
 
 // VDRI verifiable data registry interface.
 type VDRI interface {
-	Read(did string, opts ...ResolveOpts) (*did.Doc, error)
+	Read(did string, opts ...ResolveOpts) (*did.DocResolution, error)
 	Store(doc *did.Doc, by *[]ModifiedBy) error
 	Build(pubKey *PubKey, opts ...DocOpts) (*did.Doc, error)
+	Update(did string, patches []Patch, by *[]ModifiedBy) (*did.Doc, error)
+	Deactivate(did string, by *[]ModifiedBy) error
 	Accept(method string) bool
 	Close() error
 }
@@ -43,7 +48,7 @@ type ResultType int
 const (
 	// DidDocumentResult Request a DID Document as output.
 	DidDocumentResult ResultType = iota
-	// ResolutionResult Request a DID Resolution Result.
+	// ResolutionResult Request a DID Resolution Result (use Registry.ResolveWithMetadata).
 	ResolutionResult
 )
 
@@ -59,6 +64,9 @@ type ResolveDIDOpts struct {
 type ResolveOpts func(opts *ResolveDIDOpts)
 
 // WithResultType the result type input option can be used to request a certain type of result.
+//
+// Deprecated: Registry.Resolve returns DID Document only, use Registry.ResolveWithMetadata
+// to obtain DID Resolution Result.
 func WithResultType(resultType ResultType) ResolveOpts {
 	return func(opts *ResolveDIDOpts) {
 		opts.ResultType = resultType
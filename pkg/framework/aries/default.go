@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/crypto/tinkcrypto"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
@@ -29,6 +30,8 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/presentproof"
 	didcommtransport "github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
 	arieshttp "github.com/hyperledger/aries-framework-go/pkg/didcomm/transport/http"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	docverifiable "github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/context"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
@@ -39,6 +42,9 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 )
 
+// credentialSchemaCacheExpiration is how long the downloaded custom credential schemas are cached.
+const credentialSchemaCacheExpiration = 24 * time.Hour
+
 // defFrameworkOpts provides default framework options.
 func defFrameworkOpts(frameworkOpts *Aries) error {
 	// TODO https://github.com/hyperledger/aries-framework-go/issues/209 Move default providers to the sub-package
@@ -60,7 +66,17 @@ func defFrameworkOpts(frameworkOpts *Aries) error {
 		frameworkOpts.storeProvider = storeProv
 	}
 
-	err := assignVerifiableStoreIfNeeded(frameworkOpts, frameworkOpts.storeProvider)
+	err := assignJSONLDDocumentLoaderIfNeeded(frameworkOpts, frameworkOpts.storeProvider)
+	if err != nil {
+		return err
+	}
+
+	err = assignVerifiableStoreIfNeeded(frameworkOpts, frameworkOpts.storeProvider)
+	if err != nil {
+		return err
+	}
+
+	err = assignCredentialSchemaLoaderIfNeeded(frameworkOpts, frameworkOpts.storeProvider)
 	if err != nil {
 		return err
 	}
@@ -212,7 +228,8 @@ func assignVerifiableStoreIfNeeded(aries *Aries, storeProvider storage.Provider)
 		return nil
 	}
 
-	provider, err := context.New(context.WithStorageProvider(storeProvider))
+	provider, err := context.New(context.WithStorageProvider(storeProvider),
+		context.WithJSONLDDocumentLoader(aries.jsonldDocumentLoader))
 	if err != nil {
 		return fmt.Errorf("verifiable store initialization failed : %w", err)
 	}
@@ -225,6 +242,36 @@ func assignVerifiableStoreIfNeeded(aries *Aries, storeProvider storage.Provider)
 	return nil
 }
 
+func assignJSONLDDocumentLoaderIfNeeded(aries *Aries, storeProvider storage.Provider) error {
+	if aries.jsonldDocumentLoader != nil {
+		return nil
+	}
+
+	var err error
+
+	aries.jsonldDocumentLoader, err = jsonld.NewDocumentLoader(storeProvider)
+	if err != nil {
+		return fmt.Errorf("JSON-LD document loader initialization failed : %w", err)
+	}
+
+	return nil
+}
+
+func assignCredentialSchemaLoaderIfNeeded(aries *Aries, storeProvider storage.Provider) error {
+	if aries.credentialSchemaLoader != nil {
+		return nil
+	}
+
+	cache, err := docverifiable.NewStoreSchemaCache(storeProvider, credentialSchemaCacheExpiration)
+	if err != nil {
+		return fmt.Errorf("credential schema loader initialization failed : %w", err)
+	}
+
+	aries.credentialSchemaLoader = docverifiable.NewCredentialSchemaLoaderBuilder().SetCache(cache).Build()
+
+	return nil
+}
+
 func createDefSecretLock(opts *Aries) error {
 	// default lock is noop, ie keys are not secure by default.
 	// users of the framework must pre-build a secure lock and pass it in as an option
@@ -34,6 +34,10 @@ func TestDefaultFramework(t *testing.T) {
 	})
 
 	t.Run("test with provided store - success", func(t *testing.T) {
+		path, cleanup := generateTempDir(t)
+		defer cleanup()
+		dbPath = path
+
 		mockStore := verifiableStoreMocks.NewMockStore(ctrl)
 		aries := &Aries{verifiableStore: mockStore}
 		err := defFrameworkOpts(aries)
@@ -53,3 +57,29 @@ func TestCreateVerifiableStore(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestCreateJSONLDDocumentLoader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("test with store provider - error", func(t *testing.T) {
+		storeProvider := mocks.NewMockProvider(ctrl)
+		storeProvider.EXPECT().OpenStore(gomock.Any()).Return(nil, errors.New("some error"))
+		err := assignJSONLDDocumentLoaderIfNeeded(&Aries{}, storeProvider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "JSON-LD document loader initialization failed")
+	})
+}
+
+func TestCreateCredentialSchemaLoader(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	t.Run("test with store provider - error", func(t *testing.T) {
+		storeProvider := mocks.NewMockProvider(ctrl)
+		storeProvider.EXPECT().OpenStore(gomock.Any()).Return(nil, errors.New("some error"))
+		err := assignCredentialSchemaLoaderIfNeeded(&Aries{}, storeProvider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "credential schema loader initialization failed")
+	})
+}
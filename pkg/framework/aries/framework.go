@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package aries
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -21,6 +22,8 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/packer"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/decorator"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	docverifiable "github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/context"
@@ -30,6 +33,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/vdri/peer"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/web"
 )
 
 const (
@@ -62,7 +66,11 @@ type Aries struct {
 	packers                    []packer.Packer
 	vdriRegistry               vdriapi.Registry
 	vdri                       []vdriapi.VDRI
+	vdriCache                  *vdri.ResolutionCache
+	peerVDRIOpts               []peer.Option
 	verifiableStore            verifiable.Store
+	jsonldDocumentLoader       *jsonld.DocumentLoader
+	credentialSchemaLoader     *docverifiable.CredentialSchemaLoader
 	transportReturnRoute       string
 	id                         string
 }
@@ -243,7 +251,8 @@ func WithCrypto(c crypto.Crypto) Option {
 	}
 }
 
-// WithVDRI injects a VDRI service to the Aries framework.
+// WithVDRI injects a VDRI service to the Aries framework. The injected VDRIs take precedence over
+// the default did:peer and did:web VDRIs.
 func WithVDRI(v vdriapi.VDRI) Option {
 	return func(opts *Aries) error {
 		opts.vdri = append(opts.vdri, v)
@@ -251,6 +260,29 @@ func WithVDRI(v vdriapi.VDRI) Option {
 	}
 }
 
+// WithVDRIResolutionCache enables caching of DID resolution results in the VDRI registry.
+// The cache can be used to invalidate cached DIDs and to collect cache metrics.
+func WithVDRIResolutionCache(cache *vdri.ResolutionCache) Option {
+	return func(opts *Aries) error {
+		opts.vdriCache = cache
+		return nil
+	}
+}
+
+// WithPeerDIDNumAlgo sets the numalgo (1 or 2) of the peer DIDs created by the framework, e.g. for
+// DID exchange. Numalgo 2 DIDs are resolved statelessly without exchanging the DID document.
+// Numalgo 0 is not supported as its DIDs have no DIDComm service, which the framework's DIDs require.
+func WithPeerDIDNumAlgo(numAlgo int) Option {
+	return func(opts *Aries) error {
+		if numAlgo == 0 {
+			return errors.New("peer DID numalgo 0 has no DIDComm service required by the framework")
+		}
+
+		opts.peerVDRIOpts = append(opts.peerVDRIOpts, peer.WithNumAlgo(numAlgo))
+		return nil
+	}
+}
+
 // WithMessageServiceProvider injects a message service provider to the Aries framework.
 // Message service provider returns list of message services which can be used to provide custom handle
 // functionality based on incoming messages type and purpose.
@@ -281,6 +313,25 @@ func WithVerifiableStore(store verifiable.Store) Option {
 	}
 }
 
+// WithJSONLDDocumentLoader injects a JSON-LD document loader. By default, the loader serves the embedded contexts
+// and the contexts added to the context store of the framework's storage provider only. Fetching other contexts
+// from the network is enabled by injecting a loader created with jsonld.WithRemoteDocumentLoader option.
+func WithJSONLDDocumentLoader(loader *jsonld.DocumentLoader) Option {
+	return func(opts *Aries) error {
+		opts.jsonldDocumentLoader = loader
+		return nil
+	}
+}
+
+// WithCredentialSchemaLoader injects a loader of the custom credential schemas. By default, the downloaded
+// schemas are cached in the framework's storage provider, so they survive restarts of the agent.
+func WithCredentialSchemaLoader(loader *docverifiable.CredentialSchemaLoader) Option {
+	return func(opts *Aries) error {
+		opts.credentialSchemaLoader = loader
+		return nil
+	}
+}
+
 // Context provides a handle to the framework context.
 func (a *Aries) Context() (*context.Provider, error) {
 	return context.New(
@@ -303,6 +354,8 @@ func (a *Aries) Context() (*context.Provider, error) {
 		context.WithAriesFrameworkID(a.id),
 		context.WithMessageServiceProvider(a.msgSvcProvider),
 		context.WithVerifiableStore(a.verifiableStore),
+		context.WithJSONLDDocumentLoader(a.jsonldDocumentLoader),
+		context.WithCredentialSchemaLoader(a.credentialSchemaLoader),
 	)
 }
 
@@ -406,17 +459,22 @@ func createVDRI(frameworkOpts *Aries) error {
 		opts = append(opts, vdri.WithVDRI(v))
 	}
 
-	p, err := peer.New(ctx.StorageProvider())
+	p, err := peer.New(ctx.StorageProvider(), frameworkOpts.peerVDRIOpts...)
 	if err != nil {
 		return fmt.Errorf("create new vdri peer failed: %w", err)
 	}
 
 	opts = append(opts,
 		vdri.WithVDRI(p),
+		vdri.WithVDRI(web.New()),
 		vdri.WithDefaultServiceType(vdriapi.DIDCommServiceType),
 		vdri.WithDefaultServiceEndpoint(ctx.ServiceEndpoint()),
 	)
 
+	if frameworkOpts.vdriCache != nil {
+		opts = append(opts, vdri.WithResolutionCache(frameworkOpts.vdriCache))
+	}
+
 	frameworkOpts.vdriRegistry = vdri.New(ctx, opts...)
 
 	return nil
@@ -502,6 +560,8 @@ func loadServices(frameworkOpts *Aries) error {
 		context.WithRouterEndpoint(routingEndpoint(frameworkOpts)),
 		context.WithVDRIRegistry(frameworkOpts.vdriRegistry),
 		context.WithVerifiableStore(frameworkOpts.verifiableStore),
+		context.WithJSONLDDocumentLoader(frameworkOpts.jsonldDocumentLoader),
+		context.WithCredentialSchemaLoader(frameworkOpts.credentialSchemaLoader),
 		context.WithMessageServiceProvider(frameworkOpts.msgSvcProvider),
 	)
 
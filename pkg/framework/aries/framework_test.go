@@ -31,7 +31,10 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/didexchange"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	docverifiable "github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/context"
 	mocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/didcomm/common/service"
 	verifiableStoreMocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/store/verifiable"
@@ -50,6 +53,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/secretlock/local/masterlock/hkdf"
 	"github.com/hyperledger/aries-framework-go/pkg/secretlock/noop"
 	"github.com/hyperledger/aries-framework-go/pkg/storage/leveldb"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/vdri/peer"
 )
 
@@ -149,9 +153,60 @@ func TestFramework(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("test vdri - with resolution cache", func(t *testing.T) {
+		path, cleanup := generateTempDir(t)
+		defer cleanup()
+		dbPath = path
+
+		cache, err := vdri.NewResolutionCache()
+		require.NoError(t, err)
+
+		aries, err := New(WithVDRI(&mockvdri.MockVDRI{AcceptValue: true}), WithVDRIResolutionCache(cache),
+			WithInboundTransport(&mockInboundTransport{}))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = aries.vdriRegistry.Resolve("did:example:123")
+			require.NoError(t, err)
+		}
+
+		require.Equal(t, uint64(1), cache.Metrics().Hits)
+		require.NoError(t, aries.Close())
+	})
+
+	t.Run("test vdri - with peer DID numalgo", func(t *testing.T) {
+		path, cleanup := generateTempDir(t)
+		defer cleanup()
+		dbPath = path
+
+		aries, err := New(WithPeerDIDNumAlgo(2), WithInboundTransport(&mockInboundTransport{}))
+		require.NoError(t, err)
+
+		doc, err := aries.vdriRegistry.Create("peer")
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(doc.ID, "did:peer:2."))
+
+		svc, ok := did.LookupService(doc, vdriapi.DIDCommServiceType)
+		require.True(t, ok)
+		require.Len(t, svc.RecipientKeys, 1)
+
+		resolved, err := aries.vdriRegistry.Resolve(doc.ID)
+		require.NoError(t, err)
+		require.Equal(t, doc.ID, resolved.ID)
+		require.NoError(t, aries.Close())
+
+		// numalgo 0 DIDs have no DIDComm service
+		_, err = New(WithPeerDIDNumAlgo(0), WithInboundTransport(&mockInboundTransport{}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "peer DID numalgo 0 has no DIDComm service")
+	})
+
 	t.Run("test error create vdri", func(t *testing.T) {
 		_, err := New(
-			WithStoreProvider(&storage.MockStoreProvider{FailNamespace: peer.StoreNamespace}),
+			WithStoreProvider(&storage.MockStoreProvider{
+				Store:         &storage.MockStore{Store: make(map[string][]byte)},
+				FailNamespace: peer.StoreNamespace,
+			}),
 			WithInboundTransport(&mockInboundTransport{}))
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "create new vdri peer failed")
@@ -198,6 +253,18 @@ func TestFramework(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("test vdri - with default did:web vdri", func(t *testing.T) {
+		aries, err := New(WithStoreProvider(storage.NewMockStoreProvider()),
+			WithInboundTransport(&mockInboundTransport{}))
+		require.NoError(t, err)
+
+		_, err = aries.vdriRegistry.Resolve("did:web:localhost%3A0")
+		require.Error(t, err)
+		require.NotContains(t, err.Error(), "not supported")
+		require.Contains(t, err.Error(), "https://localhost:0/.well-known/did.json")
+		require.NoError(t, aries.Close())
+	})
+
 	t.Run("test protocol svc - with default protocol", func(t *testing.T) {
 		aries, err := New(WithInboundTransport(&mockInboundTransport{}))
 		require.NoError(t, err)
@@ -652,6 +719,44 @@ func TestFramework(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, mockStore, aries.verifiableStore)
 	})
+
+	t.Run("test JSON-LD document loader option", func(t *testing.T) {
+		path, cleanup := generateTempDir(t)
+		defer cleanup()
+		dbPath = path
+
+		loader, err := jsonld.NewDocumentLoader(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		aries, err := New(WithJSONLDDocumentLoader(loader))
+		require.NoError(t, err)
+		require.Equal(t, loader, aries.jsonldDocumentLoader)
+
+		ctx, err := aries.Context()
+		require.NoError(t, err)
+		require.Equal(t, loader, ctx.JSONLDDocumentLoader())
+	})
+
+	t.Run("test credential schema loader option", func(t *testing.T) {
+		path, cleanup := generateTempDir(t)
+		defer cleanup()
+		dbPath = path
+
+		// the schemas are cached in the storage provider by default
+		aries, err := New(WithStoreProvider(storage.NewMockStoreProvider()))
+		require.NoError(t, err)
+		require.NotNil(t, aries.credentialSchemaLoader)
+
+		loader := docverifiable.NewCredentialSchemaLoaderBuilder().Build()
+
+		aries, err = New(WithStoreProvider(storage.NewMockStoreProvider()), WithCredentialSchemaLoader(loader))
+		require.NoError(t, err)
+		require.Equal(t, loader, aries.credentialSchemaLoader)
+
+		ctx, err := aries.Context()
+		require.NoError(t, err)
+		require.Equal(t, loader, ctx.CredentialSchemaLoader())
+	})
 }
 
 func Test_Packager(t *testing.T) {
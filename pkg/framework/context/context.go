@@ -15,6 +15,8 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/dispatcher"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/packer"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/transport"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	docverifiable "github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
@@ -47,6 +49,8 @@ type Provider struct {
 	outboundTransports         []transport.OutboundTransport
 	vdriRegistry               vdriapi.Registry
 	verifiableStore            verifiable.Store
+	jsonldDocumentLoader       *jsonld.DocumentLoader
+	credentialSchemaLoader     *docverifiable.CredentialSchemaLoader
 	transportReturnRoute       string
 	frameworkID                string
 }
@@ -243,6 +247,16 @@ func (p *Provider) VerifiableStore() verifiable.Store {
 	return p.verifiableStore
 }
 
+// JSONLDDocumentLoader returns JSON-LD document loader with the embedded contexts and the persistent context store.
+func (p *Provider) JSONLDDocumentLoader() *jsonld.DocumentLoader {
+	return p.jsonldDocumentLoader
+}
+
+// CredentialSchemaLoader returns the loader of the custom credential schemas.
+func (p *Provider) CredentialSchemaLoader() *docverifiable.CredentialSchemaLoader {
+	return p.credentialSchemaLoader
+}
+
 // ProviderOption configures the framework.
 type ProviderOption func(opts *Provider) error
 
@@ -403,3 +417,19 @@ func WithVerifiableStore(store verifiable.Store) ProviderOption {
 		return nil
 	}
 }
+
+// WithJSONLDDocumentLoader injects a JSON-LD document loader.
+func WithJSONLDDocumentLoader(loader *jsonld.DocumentLoader) ProviderOption {
+	return func(opts *Provider) error {
+		opts.jsonldDocumentLoader = loader
+		return nil
+	}
+}
+
+// WithCredentialSchemaLoader injects a loader of the custom credential schemas.
+func WithCredentialSchemaLoader(loader *docverifiable.CredentialSchemaLoader) ProviderOption {
+	return func(opts *Provider) error {
+		opts.credentialSchemaLoader = loader
+		return nil
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/common/transport"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/didexchange"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	serviceMocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/didcomm/common/service"
 	verifiableStoreMocks "github.com/hyperledger/aries-framework-go/pkg/internal/gomocks/store/verifiable"
 	mockcrypto "github.com/hyperledger/aries-framework-go/pkg/mock/crypto"
@@ -381,6 +382,13 @@ func TestNewProvider(t *testing.T) {
 		require.Equal(t, verifiableStore, prov.VerifiableStore())
 	})
 
+	t.Run("test new with credential schema loader", func(t *testing.T) {
+		loader := verifiable.NewCredentialSchemaLoaderBuilder().Build()
+		prov, err := New(WithCredentialSchemaLoader(loader))
+		require.NoError(t, err)
+		require.Equal(t, loader, prov.CredentialSchemaLoader())
+	})
+
 	t.Run("test new with bad (fake) option", func(t *testing.T) {
 		prov, err := New(func(opts *Provider) error {
 			return fmt.Errorf("bad option")
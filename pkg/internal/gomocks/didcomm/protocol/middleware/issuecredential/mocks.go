@@ -8,6 +8,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	service "github.com/hyperledger/aries-framework-go/pkg/didcomm/common/service"
 	issuecredential "github.com/hyperledger/aries-framework-go/pkg/didcomm/protocol/issuecredential"
+	jsonld "github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	vdri "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	verifiable "github.com/hyperledger/aries-framework-go/pkg/store/verifiable"
 	reflect "reflect"
@@ -36,6 +37,20 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// JSONLDDocumentLoader mocks base method
+func (m *MockProvider) JSONLDDocumentLoader() *jsonld.DocumentLoader {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JSONLDDocumentLoader")
+	ret0, _ := ret[0].(*jsonld.DocumentLoader)
+	return ret0
+}
+
+// JSONLDDocumentLoader indicates an expected call of JSONLDDocumentLoader
+func (mr *MockProviderMockRecorder) JSONLDDocumentLoader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JSONLDDocumentLoader", reflect.TypeOf((*MockProvider)(nil).JSONLDDocumentLoader))
+}
+
 // VDRIRegistry mocks base method
 func (m *MockProvider) VDRIRegistry() vdri.Registry {
 	m.ctrl.T.Helper()
@@ -68,6 +68,20 @@ func (mr *MockRegistryMockRecorder) Create(arg0 interface{}, arg1 ...interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRegistry)(nil).Create), varargs...)
 }
 
+// Deactivate mocks base method
+func (m *MockRegistry) Deactivate(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Deactivate indicates an expected call of Deactivate
+func (mr *MockRegistryMockRecorder) Deactivate(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockRegistry)(nil).Deactivate), arg0)
+}
+
 // Resolve mocks base method
 func (m *MockRegistry) Resolve(arg0 string, arg1 ...vdri.ResolveOpts) (*did.Doc, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +102,26 @@ func (mr *MockRegistryMockRecorder) Resolve(arg0 interface{}, arg1 ...interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolve", reflect.TypeOf((*MockRegistry)(nil).Resolve), varargs...)
 }
 
+// ResolveWithMetadata mocks base method
+func (m *MockRegistry) ResolveWithMetadata(arg0 string, arg1 ...vdri.ResolveOpts) (*did.DocResolution, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveWithMetadata", varargs...)
+	ret0, _ := ret[0].(*did.DocResolution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveWithMetadata indicates an expected call of ResolveWithMetadata
+func (mr *MockRegistryMockRecorder) ResolveWithMetadata(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWithMetadata", reflect.TypeOf((*MockRegistry)(nil).ResolveWithMetadata), varargs...)
+}
+
 // Store mocks base method
 func (m *MockRegistry) Store(arg0 *did.Doc) error {
 	m.ctrl.T.Helper()
@@ -101,3 +135,23 @@ func (mr *MockRegistryMockRecorder) Store(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockRegistry)(nil).Store), arg0)
 }
+
+// Update mocks base method
+func (m *MockRegistry) Update(arg0 string, arg1 ...vdri.Patch) (*did.Doc, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Update", varargs...)
+	ret0, _ := ret[0].(*did.Doc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockRegistryMockRecorder) Update(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0}, arg1...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRegistry)(nil).Update), varargs...)
+}
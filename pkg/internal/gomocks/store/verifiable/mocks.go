@@ -124,6 +124,21 @@ func (mr *MockStoreMockRecorder) GetPresentations() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresentations", reflect.TypeOf((*MockStore)(nil).GetPresentations))
 }
 
+// QueryCredentials mocks base method
+func (m *MockStore) QueryCredentials(arg0 *verifiable0.CredentialQuery) (*verifiable0.QueryResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryCredentials", arg0)
+	ret0, _ := ret[0].(*verifiable0.QueryResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryCredentials indicates an expected call of QueryCredentials
+func (mr *MockStoreMockRecorder) QueryCredentials(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryCredentials", reflect.TypeOf((*MockStore)(nil).QueryCredentials), arg0)
+}
+
 // RemoveCredentialByName mocks base method
 func (m *MockStore) RemoveCredentialByName(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -179,3 +194,17 @@ func (mr *MockStoreMockRecorder) SavePresentation(arg0, arg1 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePresentation", reflect.TypeOf((*MockStore)(nil).SavePresentation), arg0, arg1)
 }
+
+// UpdateCredential mocks base method
+func (m *MockStore) UpdateCredential(arg0 string, arg1 *verifiable.Credential) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCredential", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCredential indicates an expected call of UpdateCredential
+func (mr *MockStoreMockRecorder) UpdateCredential(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCredential", reflect.TypeOf((*MockStore)(nil).UpdateCredential), arg0, arg1)
+}
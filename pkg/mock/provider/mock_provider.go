@@ -9,6 +9,7 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/crypto"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/dispatcher"
 	"github.com/hyperledger/aries-framework-go/pkg/didcomm/packer"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/kms"
 	"github.com/hyperledger/aries-framework-go/pkg/kms/legacykms"
@@ -30,6 +31,7 @@ type Provider struct {
 	OutboundDispatcherValue           dispatcher.Outbound
 	VDRIRegistryValue                 vdriapi.Registry
 	CryptoValue                       crypto.Crypto
+	JSONLDDocumentLoaderValue         *jsonld.DocumentLoader
 }
 
 // Service return service.
@@ -60,6 +62,11 @@ func (p *Provider) Crypto() crypto.Crypto {
 	return p.CryptoValue
 }
 
+// JSONLDDocumentLoader returns the JSON-LD document loader.
+func (p *Provider) JSONLDDocumentLoader() *jsonld.DocumentLoader {
+	return p.JSONLDDocumentLoaderValue
+}
+
 // ServiceEndpoint returns the service endpoint.
 func (p *Provider) ServiceEndpoint() string {
 	return p.ServiceEndpointValue
@@ -9,6 +9,7 @@ package vdri
 import (
 	"crypto/ed25519"
 	"crypto/rand"
+	"errors"
 	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
@@ -27,6 +28,10 @@ type MockVDRIRegistry struct {
 	ResolveErr   error
 	ResolveValue *did.Doc
 	ResolveFunc  func(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error)
+	// ResolveWithMetadataFunc overrides ResolveWithMetadata, otherwise the result of Resolve is returned.
+	ResolveWithMetadataFunc func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error)
+	UpdateFunc              func(didID string, patches ...vdriapi.Patch) (*did.Doc, error)
+	DeactivateErr           error
 }
 
 // Store stores the key and the record.
@@ -81,6 +86,50 @@ func (m *MockVDRIRegistry) Resolve(didID string, opts ...vdriapi.ResolveOpts) (*
 	return m.ResolveValue, nil
 }
 
+// ResolveWithMetadata resolves did document along with its metadata.
+func (m *MockVDRIRegistry) ResolveWithMetadata(didID string,
+	opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+	if m.ResolveWithMetadataFunc != nil {
+		return m.ResolveWithMetadataFunc(didID, opts...)
+	}
+
+	doc, err := m.Resolve(didID, opts...)
+	if errors.Is(err, vdriapi.ErrNotFound) {
+		return did.NewResolutionError(did.ResolutionErrorNotFound, err), err
+	}
+
+	if err != nil {
+		return did.NewResolutionError(did.ResolutionErrorInternal, err), err
+	}
+
+	return did.NewDocResolution(doc), nil
+}
+
+// Update applies patches to the did document.
+func (m *MockVDRIRegistry) Update(didID string, patches ...vdriapi.Patch) (*did.Doc, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(didID, patches...)
+	}
+
+	doc, err := m.Resolve(didID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, patch := range patches {
+		if err := patch.Apply(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// Deactivate deactivates did.
+func (m *MockVDRIRegistry) Deactivate(didID string) error {
+	return m.DeactivateErr
+}
+
 // Close frees resources being maintained by vdri.
 func (m *MockVDRIRegistry) Close() error {
 	return nil
@@ -14,20 +14,22 @@ import (
 // MockVDRI mock implementation of vdri
 // to be used only for unit tests.
 type MockVDRI struct {
-	AcceptValue bool
-	StoreErr    error
-	ReadFunc    func(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error)
-	BuildFunc   func(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error)
-	CloseErr    error
+	AcceptValue   bool
+	StoreErr      error
+	ReadFunc      func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error)
+	BuildFunc     func(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error)
+	UpdateFunc    func(didID string, patches []vdriapi.Patch, by *[]vdriapi.ModifiedBy) (*did.Doc, error)
+	DeactivateErr error
+	CloseErr      error
 }
 
 // Read did.
-func (m *MockVDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error) {
+func (m *MockVDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
 	if m.ReadFunc != nil {
 		return m.ReadFunc(didID, opts...)
 	}
 
-	return nil, nil
+	return did.NewDocResolution(&did.Doc{ID: didID}), nil
 }
 
 // Store did.
@@ -44,6 +46,20 @@ func (m *MockVDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.
 	return nil, nil
 }
 
+// Update did.
+func (m *MockVDRI) Update(didID string, patches []vdriapi.Patch, by *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(didID, patches, by)
+	}
+
+	return &did.Doc{ID: didID}, nil
+}
+
+// Deactivate did.
+func (m *MockVDRI) Deactivate(didID string, by *[]vdriapi.ModifiedBy) error {
+	return m.DeactivateErr
+}
+
 // Accept did.
 func (m *MockVDRI) Accept(method string) bool {
 	return m.AcceptValue
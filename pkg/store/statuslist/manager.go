@@ -0,0 +1,378 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package statuslist implements the issuer side of credential status lists (StatusList2021 and
+// RevocationList2020). Manager allocates status list indexes for the issued credentials, keeps the
+// status lists in the storage and publishes them as signed status list credentials.
+package statuslist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	// NameSpace for status list store.
+	NameSpace = "statuslist"
+
+	currentListKey       = "current"
+	listCredentialKey    = "listvc_"
+	listCredentialKeyFmt = listCredentialKey + "%s"
+
+	vcContext = "https://www.w3.org/2018/credentials/v1"
+	vcType    = "VerifiableCredential"
+
+	jsonContentType = "application/json"
+)
+
+var logger = log.New("aries-framework/store/statuslist")
+
+type provider interface {
+	StorageProvider() storage.Provider
+}
+
+// currentList is a record of the status list which is used to allocate new indexes.
+type currentList struct {
+	ListID    int `json:"listId"`
+	NextIndex int `json:"nextIndex"`
+}
+
+// Manager allocates status list indexes for credentials being issued and manages statuses in the status lists.
+type Manager struct {
+	store      storage.Store
+	issuer     string
+	baseURL    string
+	ldpContext *verifiable.LinkedDataProofContext
+	jsonldOpts []jsonld.ProcessorOpts
+	listSize   int
+	purpose    string
+	entryType  string
+
+	mu sync.Mutex
+}
+
+// Opt is the Manager option.
+type Opt func(m *Manager)
+
+// WithListSize defines the size of the status list (in bits). It defaults to verifiable.MinStatusListSize.
+func WithListSize(size int) Opt {
+	return func(m *Manager) {
+		m.listSize = size
+	}
+}
+
+// WithStatusPurpose defines the status purpose of StatusList2021 lists (e.g. revocation or suspension).
+// It defaults to verifiable.StatusPurposeRevocation.
+func WithStatusPurpose(purpose string) Opt {
+	return func(m *Manager) {
+		m.purpose = purpose
+	}
+}
+
+// WithRevocationList2020 makes Manager to issue RevocationList2020 lists instead of StatusList2021.
+func WithRevocationList2020() Opt {
+	return func(m *Manager) {
+		m.entryType = verifiable.RevocationList2020Status
+	}
+}
+
+// WithJSONLDProcessorOpts defines JSON-LD processor options used to sign status list credentials.
+func WithJSONLDProcessorOpts(opts ...jsonld.ProcessorOpts) Opt {
+	return func(m *Manager) {
+		m.jsonldOpts = opts
+	}
+}
+
+// New returns a new status list manager. Status list credentials are issued by the given issuer and signed using
+// ldpContext. They are published under baseURL (i.e. list credential ID is baseURL/<list ID>).
+func New(ctx provider, issuer, baseURL string, ldpContext *verifiable.LinkedDataProofContext,
+	opts ...Opt) (*Manager, error) {
+	if issuer == "" {
+		return nil, errors.New("issuer is mandatory")
+	}
+
+	if ldpContext == nil {
+		return nil, errors.New("linked data proof context is mandatory")
+	}
+
+	m := &Manager{
+		issuer:     issuer,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		ldpContext: ldpContext,
+		listSize:   verifiable.MinStatusListSize,
+		purpose:    verifiable.StatusPurposeRevocation,
+		entryType:  verifiable.StatusList2021Entry,
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if _, err := verifiable.NewStatusList(m.listSize); err != nil {
+		return nil, err
+	}
+
+	store, err := ctx.StorageProvider().OpenStore(NameSpace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open status list store: %w", err)
+	}
+
+	m.store = store
+
+	return m, nil
+}
+
+// CreateStatusEntry allocates a new index in the status list and returns credentialStatus to be put into
+// the credential being issued. A new status list credential is created when the current one is full.
+func (m *Manager) CreateStatusEntry() (*verifiable.TypedID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, err := m.getCurrentList()
+	if err != nil {
+		return nil, err
+	}
+
+	if current.ListID == 0 || current.NextIndex >= m.listSize {
+		current = &currentList{ListID: current.ListID + 1}
+
+		list, err := verifiable.NewStatusList(m.listSize)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = m.saveListCredential(strconv.Itoa(current.ListID), list); err != nil {
+			return nil, err
+		}
+	}
+
+	entry := &verifiable.StatusListEntry{
+		Type:           m.entryType,
+		ListCredential: m.listURL(strconv.Itoa(current.ListID)),
+		Index:          current.NextIndex,
+		Purpose:        m.purpose,
+	}
+
+	current.NextIndex++
+
+	if err = m.putCurrentList(current); err != nil {
+		return nil, err
+	}
+
+	return entry.TypedID(), nil
+}
+
+// Revoke sets the status of the credential, i.e. revokes or suspends it depending on the status purpose.
+func (m *Manager) Revoke(vc *verifiable.Credential) error {
+	return m.UpdateStatus(vc.Status, true)
+}
+
+// UpdateStatus sets (or unsets) the status defined by credentialStatus and re-signs the status list credential.
+func (m *Manager) UpdateStatus(status *verifiable.TypedID, value bool) error {
+	entry, err := verifiable.ParseStatusListEntry(status)
+	if err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	listID := path.Base(entry.ListCredential)
+	if m.listURL(listID) != entry.ListCredential {
+		return fmt.Errorf("update status: status list credential %s is not managed by the issuer",
+			entry.ListCredential)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	listVC, err := m.getListCredential(listID)
+	if err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	list, err := decodeList(listVC)
+	if err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	if err = list.Set(entry.Index, value); err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	return m.saveListCredential(listID, list)
+}
+
+// GetStatusListCredential returns signed status list credential.
+func (m *Manager) GetStatusListCredential(listID string) ([]byte, error) {
+	listVCBytes, err := m.store.Get(fmt.Sprintf(listCredentialKeyFmt, listID))
+	if err != nil {
+		return nil, fmt.Errorf("get status list credential %s: %w", listID, err)
+	}
+
+	return listVCBytes, nil
+}
+
+// ServeHTTP publishes status list credentials, the last segment of the request path is a list ID.
+func (m *Manager) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		rw.WriteHeader(http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	listVCBytes, err := m.GetStatusListCredential(path.Base(req.URL.Path))
+	if err != nil {
+		if errors.Is(err, storage.ErrDataNotFound) {
+			rw.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		logger.Errorf("failed to get status list credential: %s", err)
+		rw.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	rw.Header().Set("Content-Type", jsonContentType)
+
+	if _, err := rw.Write(listVCBytes); err != nil {
+		logger.Errorf("failed to write status list credential: %s", err)
+	}
+}
+
+func (m *Manager) listURL(listID string) string {
+	return m.baseURL + "/" + listID
+}
+
+func (m *Manager) getCurrentList() (*currentList, error) {
+	currentBytes, err := m.store.Get(currentListKey)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return &currentList{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get current status list: %w", err)
+	}
+
+	var current currentList
+
+	if err := json.Unmarshal(currentBytes, &current); err != nil {
+		return nil, fmt.Errorf("unmarshal current status list: %w", err)
+	}
+
+	return &current, nil
+}
+
+func (m *Manager) putCurrentList(current *currentList) error {
+	currentBytes, err := json.Marshal(current)
+	if err != nil {
+		return fmt.Errorf("marshal current status list: %w", err)
+	}
+
+	if err := m.store.Put(currentListKey, currentBytes); err != nil {
+		return fmt.Errorf("put current status list: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manager) getListCredential(listID string) (*verifiable.Credential, error) {
+	listVCBytes, err := m.GetStatusListCredential(listID)
+	if err != nil {
+		return nil, err
+	}
+
+	listVC, err := verifiable.ParseUnverifiedCredential(listVCBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse status list credential: %w", err)
+	}
+
+	return listVC, nil
+}
+
+func (m *Manager) saveListCredential(listID string, list *verifiable.StatusList) error {
+	listVC, err := m.createListCredential(listID, list)
+	if err != nil {
+		return err
+	}
+
+	err = listVC.AddLinkedDataProof(m.ldpContext, m.jsonldOpts...)
+	if err != nil {
+		return fmt.Errorf("sign status list credential: %w", err)
+	}
+
+	listVCBytes, err := listVC.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("marshal status list credential: %w", err)
+	}
+
+	if err := m.store.Put(fmt.Sprintf(listCredentialKeyFmt, listID), listVCBytes); err != nil {
+		return fmt.Errorf("put status list credential: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manager) createListCredential(listID string, list *verifiable.StatusList) (*verifiable.Credential, error) {
+	encodedList, err := list.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	listURL := m.listURL(listID)
+
+	subject := verifiable.Subject{
+		ID:           listURL + "#list",
+		CustomFields: verifiable.CustomFields{"encodedList": encodedList},
+	}
+
+	listVC := &verifiable.Credential{
+		ID:      listURL,
+		Subject: []verifiable.Subject{subject},
+		Issuer:  verifiable.Issuer{ID: m.issuer},
+		Issued:  util.NewTime(time.Now()),
+	}
+
+	switch m.entryType {
+	case verifiable.RevocationList2020Status:
+		listVC.Context = []string{vcContext, verifiable.RevocationList2020Context}
+		listVC.Types = []string{vcType, verifiable.RevocationList2020Credential}
+		subject.CustomFields["type"] = verifiable.RevocationList2020
+	default:
+		listVC.Context = []string{vcContext, verifiable.StatusList2021Context}
+		listVC.Types = []string{vcType, verifiable.StatusList2021Credential}
+		subject.CustomFields["type"] = verifiable.StatusList2021
+		subject.CustomFields["statusPurpose"] = m.purpose
+	}
+
+	return listVC, nil
+}
+
+func decodeList(listVC *verifiable.Credential) (*verifiable.StatusList, error) {
+	subjects, ok := listVC.Subject.([]verifiable.Subject)
+	if !ok || len(subjects) != 1 {
+		return nil, errors.New("status list credential must have a single subject")
+	}
+
+	encodedList, ok := subjects[0].CustomFields["encodedList"].(string)
+	if !ok {
+		return nil, errors.New("encodedList is not defined in status list credential")
+	}
+
+	return verifiable.DecodeStatusList(encodedList)
+}
@@ -0,0 +1,293 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package statuslist
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/crypto/tinkcrypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util/signature"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
+	mockkms "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/secretlock/noop"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	issuerID            = "did:example:76e12ec712ebc6f1c221ebfeb1f"
+	jsonldContextPrefix = "../../doc/verifiable/testdata/context"
+)
+
+func TestNew(t *testing.T) {
+	ldpContext, _ := newTestLDPContext(t)
+
+	t.Run("success", func(t *testing.T) {
+		m, err := New(newTestProvider(), issuerID, "https://example.com/status/", ldpContext)
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/status", m.baseURL)
+	})
+
+	t.Run("missing issuer", func(t *testing.T) {
+		_, err := New(newTestProvider(), "", "https://example.com/status", ldpContext)
+		require.EqualError(t, err, "issuer is mandatory")
+	})
+
+	t.Run("missing linked data proof context", func(t *testing.T) {
+		_, err := New(newTestProvider(), issuerID, "https://example.com/status", nil)
+		require.EqualError(t, err, "linked data proof context is mandatory")
+	})
+
+	t.Run("invalid list size", func(t *testing.T) {
+		_, err := New(newTestProvider(), issuerID, "https://example.com/status", ldpContext, WithListSize(10))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid status list size")
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{ErrOpenStoreHandle: errors.New("open error")},
+		}, issuerID, "https://example.com/status", ldpContext)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open error")
+	})
+}
+
+func TestManager_CreateStatusEntry(t *testing.T) {
+	ldpContext, _ := newTestLDPContext(t)
+
+	t.Run("allocates indexes and creates new list when the current one is full", func(t *testing.T) {
+		m, err := New(newTestProvider(), issuerID, "https://example.com/status", ldpContext,
+			WithListSize(8), WithJSONLDProcessorOpts(jsonld.WithDocumentLoader(newTestDocumentLoader(t))))
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			status, err := m.CreateStatusEntry()
+			require.NoError(t, err)
+
+			entry, err := verifiable.ParseStatusListEntry(status)
+			require.NoError(t, err)
+			require.Equal(t, verifiable.StatusList2021Entry, entry.Type)
+			require.Equal(t, verifiable.StatusPurposeRevocation, entry.Purpose)
+			require.Equal(t, i%8, entry.Index)
+			require.Equal(t, fmt.Sprintf("https://example.com/status/%d", i/8+1), entry.ListCredential)
+		}
+
+		_, err = m.GetStatusListCredential("2")
+		require.NoError(t, err)
+
+		_, err = m.GetStatusListCredential("3")
+		require.True(t, errors.Is(err, storage.ErrDataNotFound))
+	})
+
+	t.Run("RevocationList2020", func(t *testing.T) {
+		m, err := New(newTestProvider(), issuerID, "https://example.com/status", ldpContext,
+			WithRevocationList2020(), WithJSONLDProcessorOpts(jsonld.WithDocumentLoader(newTestDocumentLoader(t))))
+		require.NoError(t, err)
+
+		status, err := m.CreateStatusEntry()
+		require.NoError(t, err)
+		require.Equal(t, verifiable.RevocationList2020Status, status.Type)
+		require.Equal(t, "0", status.CustomFields["revocationListIndex"])
+
+		listVCBytes, err := m.GetStatusListCredential("1")
+		require.NoError(t, err)
+
+		listVC, err := verifiable.ParseUnverifiedCredential(listVCBytes)
+		require.NoError(t, err)
+		require.Equal(t, []string{"VerifiableCredential", verifiable.RevocationList2020Credential}, listVC.Types)
+		require.Len(t, listVC.Proofs, 1)
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		m, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewCustomMockStoreProvider(&mockstore.MockStore{
+				Store:  make(map[string][]byte),
+				ErrGet: errors.New("get error"),
+			}),
+		}, issuerID, "https://example.com/status", ldpContext)
+		require.NoError(t, err)
+
+		_, err = m.CreateStatusEntry()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get error")
+	})
+}
+
+func TestManager_RevokeAndVerify(t *testing.T) {
+	ldpContext, pubKey := newTestLDPContext(t)
+	loader := newTestDocumentLoader(t)
+
+	m, err := New(newTestProvider(), issuerID, "http://placeholder", ldpContext,
+		WithStatusPurpose(verifiable.StatusPurposeSuspension),
+		WithJSONLDProcessorOpts(jsonld.WithDocumentLoader(loader)))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(m)
+	defer server.Close()
+
+	m.baseURL = server.URL + "/status"
+
+	issue := func() []byte {
+		status, err := m.CreateStatusEntry()
+		require.NoError(t, err)
+
+		vc := &verifiable.Credential{
+			Context: []string{vcContext, verifiable.StatusList2021Context},
+			ID:      "http://example.edu/credentials/1872",
+			Types:   []string{vcType},
+			Subject: "did:example:ebfeb1f712ebc6f1c276e12ec21",
+			Issuer:  verifiable.Issuer{ID: issuerID},
+			Issued:  util.NewTime(time.Now()),
+			Status:  status,
+		}
+
+		require.NoError(t, vc.AddLinkedDataProof(ldpContext, jsonld.WithDocumentLoader(loader)))
+
+		vcBytes, err := vc.MarshalJSON()
+		require.NoError(t, err)
+
+		return vcBytes
+	}
+
+	parse := func(vcBytes []byte) (*verifiable.Credential, error) {
+		return verifiable.ParseCredential(vcBytes,
+			verifiable.WithJSONLDDocumentLoader(loader),
+			verifiable.WithPublicKeyFetcher(verifiable.SingleKey(pubKey, kms.ED25519)),
+			verifiable.WithStatusCheck(verifiable.NewStatusListVerifier(verifiable.WithStatusListCacheTTL(0))))
+	}
+
+	vc1Bytes, vc2Bytes := issue(), issue()
+
+	vc1, err := parse(vc1Bytes)
+	require.NoError(t, err)
+
+	_, err = parse(vc2Bytes)
+	require.NoError(t, err)
+
+	require.NoError(t, m.Revoke(vc1))
+
+	_, err = parse(vc1Bytes)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, verifiable.ErrCredentialRevoked))
+	require.Contains(t, err.Error(), verifiable.StatusPurposeSuspension)
+
+	_, err = parse(vc2Bytes)
+	require.NoError(t, err)
+
+	require.NoError(t, m.UpdateStatus(vc1.Status, false))
+
+	_, err = parse(vc1Bytes)
+	require.NoError(t, err)
+
+	t.Run("not managed list", func(t *testing.T) {
+		entry := &verifiable.StatusListEntry{
+			Type:           verifiable.StatusList2021Entry,
+			ListCredential: "https://other.example.com/status/1",
+			Purpose:        verifiable.StatusPurposeRevocation,
+		}
+
+		err := m.UpdateStatus(entry.TypedID(), true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "is not managed by the issuer")
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		err := m.UpdateStatus(&verifiable.TypedID{Type: "CredentialStatusList2017"}, true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported credential status type")
+	})
+
+	t.Run("index out of range", func(t *testing.T) {
+		entry := &verifiable.StatusListEntry{
+			Type:           verifiable.StatusList2021Entry,
+			ListCredential: server.URL + "/status/1",
+			Index:          verifiable.MinStatusListSize,
+			Purpose:        verifiable.StatusPurposeRevocation,
+		}
+
+		err := m.UpdateStatus(entry.TypedID(), true)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "out of range")
+	})
+
+	t.Run("publishing", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/status/2") //nolint:noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, err = http.Post(server.URL+"/status/1", "application/json", nil) //nolint:noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func newTestProvider() *mockprovider.Provider {
+	return &mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()}
+}
+
+func newTestLDPContext(t *testing.T) (*verifiable.LinkedDataProofContext, []byte) {
+	localKMS, err := localkms.New("local-lock://custom/master/key/",
+		mockkms.NewProviderForKMS(mockstore.NewMockStoreProvider(), &noop.NoLock{}))
+	require.NoError(t, err)
+
+	tinkCrypto, err := tinkcrypto.New()
+	require.NoError(t, err)
+
+	signer, err := signature.NewCryptoSigner(tinkCrypto, localKMS, kms.ED25519Type)
+	require.NoError(t, err)
+
+	return &verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite: ed25519signature2018.New(
+			suite.WithSigner(signer),
+			suite.WithVerifier(ed25519signature2018.NewPublicKeyVerifier())),
+		VerificationMethod: issuerID + "#key1",
+	}, signer.PublicKeyBytes()
+}
+
+func newTestDocumentLoader(t *testing.T) *ld.CachingDocumentLoader {
+	loader := verifiable.CachingJSONLDLoader()
+
+	for contextURL, contextFile := range map[string]string{
+		"https://w3id.org/security/v1":       "security_v1.jsonld",
+		"https://w3id.org/security/v2":       "security_v2.jsonld",
+		verifiable.StatusList2021Context:     "status_list_2021.jsonld",
+		verifiable.RevocationList2020Context: "revocation_list_2020.jsonld",
+	} {
+		content, err := ioutil.ReadFile(filepath.Clean(filepath.Join(jsonldContextPrefix, contextFile)))
+		require.NoError(t, err)
+
+		doc, err := ld.DocumentFromReader(bytes.NewReader(content))
+		require.NoError(t, err)
+
+		loader.AddDocument(contextURL, doc)
+	}
+
+	return loader
+}
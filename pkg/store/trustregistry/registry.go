@@ -0,0 +1,389 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package trustregistry implements a local issuer trust registry. The registry keeps signed trust list
+// credentials which describe the issuer DIDs allowed to issue credentials of the given types and contexts.
+// Registry implements verifiable.IssuerTrustChecker, so it can be consulted during credential parsing.
+package trustregistry
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	// NameSpace for trust registry store.
+	NameSpace = "trustregistry"
+
+	// TrustListContext is the JSON-LD context of the trust list credential.
+	TrustListContext = "https://trustbloc.github.io/context/vc/trust-list-v1.jsonld"
+	// TrustListCredentialType is the type of the trust list credential.
+	TrustListCredentialType = "TrustListCredential"
+	// TrustListType is the type of the trust list credential subject.
+	TrustListType = "TrustList"
+
+	trustListKey      = "trustlist_"
+	trustListKeyFmt   = trustListKey + "%s"
+	trustListLimitFmt = "%s" + storage.EndKeySuffix
+
+	vcContext = "https://www.w3.org/2018/credentials/v1"
+	vcType    = "VerifiableCredential"
+)
+
+var logger = log.New("aries-framework/store/trustregistry")
+
+// ErrUntrustedIssuer is returned when the credential issuer is not trusted by any trust list.
+var ErrUntrustedIssuer = errors.New("untrusted issuer")
+
+type provider interface {
+	StorageProvider() storage.Provider
+}
+
+// TrustedIssuer is the trust list entry. Empty CredentialTypes (Contexts) means that the issuer is trusted
+// to issue credentials of any type (with any context).
+type TrustedIssuer struct {
+	DID             string   `json:"id"`
+	CredentialTypes []string `json:"credentialTypes,omitempty"`
+	Contexts        []string `json:"contexts,omitempty"`
+}
+
+// TrustList is the list of trusted issuers published by the trust list issuer (e.g. governance authority).
+// Expires is the expiration date of the trust list credential; expired trust lists are not consulted.
+type TrustList struct {
+	ID      string           `json:"id"`
+	Name    string           `json:"name,omitempty"`
+	Issuer  string           `json:"issuer"`
+	Issuers []*TrustedIssuer `json:"trustedIssuers"`
+	Expires *time.Time       `json:"expires,omitempty"`
+}
+
+// NewTrustListCredential creates unsigned trust list credential issued by the given issuer.
+func NewTrustListCredential(issuer string, list *TrustList) (*verifiable.Credential, error) {
+	if list.ID == "" {
+		return nil, errors.New("trust list ID is mandatory")
+	}
+
+	issuersBytes, err := json.Marshal(list.Issuers)
+	if err != nil {
+		return nil, fmt.Errorf("marshal trusted issuers: %w", err)
+	}
+
+	var issuers []interface{}
+
+	if err = json.Unmarshal(issuersBytes, &issuers); err != nil {
+		return nil, fmt.Errorf("unmarshal trusted issuers: %w", err)
+	}
+
+	subject := verifiable.Subject{
+		ID: list.ID + "#list",
+		CustomFields: verifiable.CustomFields{
+			"type":           TrustListType,
+			"trustedIssuers": issuers,
+		},
+	}
+
+	if list.Name != "" {
+		subject.CustomFields["name"] = list.Name
+	}
+
+	return &verifiable.Credential{
+		Context: []string{vcContext, TrustListContext},
+		ID:      list.ID,
+		Types:   []string{vcType, TrustListCredentialType},
+		Subject: []verifiable.Subject{subject},
+		Issuer:  verifiable.Issuer{ID: issuer},
+		Issued:  util.NewTime(time.Now()),
+	}, nil
+}
+
+// Registry keeps trust lists and checks whether the credential issuer is trusted.
+type Registry struct {
+	store        storage.Store
+	credOpts     []verifiable.CredentialOpt
+	trustAnchors []string
+
+	mu    sync.RWMutex
+	lists map[string]*TrustList
+}
+
+// Opt is the Registry option.
+type Opt func(r *Registry)
+
+// WithTrustListCredentialOpts defines options used to parse and verify trust list credentials
+// (e.g. public key fetcher and JSON-LD document loader).
+func WithTrustListCredentialOpts(opts ...verifiable.CredentialOpt) Opt {
+	return func(r *Registry) {
+		r.credOpts = opts
+	}
+}
+
+// WithTrustAnchors restricts trust list issuers to the given DIDs. If not set, no trust lists
+// are accepted.
+func WithTrustAnchors(dids ...string) Opt {
+	return func(r *Registry) {
+		r.trustAnchors = dids
+	}
+}
+
+// New returns a new trust registry and loads the stored trust lists.
+func New(ctx provider, opts ...Opt) (*Registry, error) {
+	store, err := ctx.StorageProvider().OpenStore(NameSpace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trust registry store: %w", err)
+	}
+
+	r := &Registry{
+		store: store,
+		lists: make(map[string]*TrustList),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if err := r.loadTrustLists(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AddTrustList verifies the signed trust list credential and adds (or replaces) the trust list.
+func (r *Registry) AddTrustList(vcBytes []byte) (*TrustList, error) {
+	list, err := r.verifyTrustList(vcBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.store.Put(fmt.Sprintf(trustListKeyFmt, list.ID), vcBytes); err != nil {
+		return nil, fmt.Errorf("put trust list: %w", err)
+	}
+
+	r.mu.Lock()
+	r.lists[list.ID] = list
+	r.mu.Unlock()
+
+	return list, nil
+}
+
+// RemoveTrustList removes the trust list with the given ID.
+func (r *Registry) RemoveTrustList(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.lists[id]; !ok {
+		return fmt.Errorf("remove trust list %s: %w", id, storage.ErrDataNotFound)
+	}
+
+	if err := r.store.Delete(fmt.Sprintf(trustListKeyFmt, id)); err != nil {
+		return fmt.Errorf("remove trust list %s: %w", id, err)
+	}
+
+	delete(r.lists, id)
+
+	return nil
+}
+
+// GetTrustListCredential returns signed trust list credential.
+func (r *Registry) GetTrustListCredential(id string) ([]byte, error) {
+	vcBytes, err := r.store.Get(fmt.Sprintf(trustListKeyFmt, id))
+	if err != nil {
+		return nil, fmt.Errorf("get trust list %s: %w", id, err)
+	}
+
+	return vcBytes, nil
+}
+
+// TrustLists returns all trust lists of the registry.
+func (r *Registry) TrustLists() []*TrustList {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	lists := make([]*TrustList, 0, len(r.lists))
+
+	for _, list := range r.lists {
+		lists = append(lists, list)
+	}
+
+	return lists
+}
+
+// CheckIssuerTrust checks that the credential issuer is trusted to issue credentials of all VC types
+// and contexts by at least one trust list.
+func (r *Registry) CheckIssuerTrust(vc *verifiable.Credential) error {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := time.Now()
+
+	for _, list := range r.lists {
+		if list.expired(now) {
+			continue
+		}
+
+		for _, issuer := range list.Issuers {
+			if issuer.DID == vc.Issuer.ID && issuer.trusts(vc) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrUntrustedIssuer, vc.Issuer.ID)
+}
+
+func (l *TrustList) expired(now time.Time) bool {
+	return l.Expires != nil && now.After(*l.Expires)
+}
+
+func (i *TrustedIssuer) trusts(vc *verifiable.Credential) bool {
+	if len(i.CredentialTypes) > 0 {
+		for _, t := range vc.Types {
+			if t != vcType && !stringsContain(i.CredentialTypes, t) {
+				return false
+			}
+		}
+	}
+
+	if len(i.Contexts) > 0 {
+		for _, ctx := range vc.Context {
+			if ctx != vcContext && !stringsContain(i.Contexts, ctx) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// verifyTrustList parses and verifies the signed trust list credential.
+func (r *Registry) verifyTrustList(vcBytes []byte) (*TrustList, error) {
+	vc, err := verifiable.ParseCredential(vcBytes, r.credOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("parse trust list credential: %w", err)
+	}
+
+	if len(vc.Proofs) == 0 {
+		return nil, errors.New("trust list credential must be signed")
+	}
+
+	if err = r.checkTrustAnchor(vc); err != nil {
+		return nil, err
+	}
+
+	list, err := decodeTrustList(vc)
+	if err != nil {
+		return nil, err
+	}
+
+	if list.expired(time.Now()) {
+		return nil, fmt.Errorf("trust list credential expired at %s", list.Expires.Format(time.RFC3339))
+	}
+
+	return list, nil
+}
+
+func (r *Registry) checkTrustAnchor(vc *verifiable.Credential) error {
+	if len(r.trustAnchors) == 0 {
+		return errors.New("no trust anchors are configured")
+	}
+
+	if !stringsContain(r.trustAnchors, vc.Issuer.ID) {
+		return fmt.Errorf("trust list issuer %s is not a trust anchor", vc.Issuer.ID)
+	}
+
+	// Trust list must be signed by the key of its issuer.
+	for _, proof := range vc.Proofs {
+		vm, _ := proof["verificationMethod"].(string) // nolint: errcheck
+		if vm != vc.Issuer.ID && !strings.HasPrefix(vm, vc.Issuer.ID+"#") {
+			return fmt.Errorf("trust list proof verification method %s does not belong to issuer %s",
+				vm, vc.Issuer.ID)
+		}
+	}
+
+	return nil
+}
+
+func (r *Registry) loadTrustLists() error {
+	itr := r.store.Iterator(trustListKey, fmt.Sprintf(trustListLimitFmt, trustListKey))
+	defer itr.Release()
+
+	for itr.Next() {
+		// Stored trust lists are verified again as trust anchors and issuer keys may have changed since.
+		list, err := r.verifyTrustList(itr.Value())
+		if err != nil {
+			logger.Warnf("skip invalid trust list %s: %s", string(itr.Key()), err)
+
+			continue
+		}
+
+		r.lists[list.ID] = list
+	}
+
+	if itr.Error() != nil {
+		return fmt.Errorf("load trust lists: %w", itr.Error())
+	}
+
+	return nil
+}
+
+func decodeTrustList(vc *verifiable.Credential) (*TrustList, error) {
+	if !stringsContain(vc.Types, TrustListCredentialType) {
+		return nil, fmt.Errorf("credential is not of %s type", TrustListCredentialType)
+	}
+
+	if vc.ID == "" {
+		return nil, errors.New("trust list credential ID is mandatory")
+	}
+
+	subjects, ok := vc.Subject.([]verifiable.Subject)
+	if !ok || len(subjects) != 1 {
+		return nil, errors.New("trust list credential must have a single subject")
+	}
+
+	list := &TrustList{ID: vc.ID, Issuer: vc.Issuer.ID}
+
+	if vc.Expired != nil {
+		list.Expires = &vc.Expired.Time
+	}
+
+	list.Name, _ = subjects[0].CustomFields["name"].(string) // nolint: errcheck
+
+	issuersBytes, err := json.Marshal(subjects[0].CustomFields["trustedIssuers"])
+	if err != nil {
+		return nil, fmt.Errorf("marshal trusted issuers: %w", err)
+	}
+
+	if err = json.Unmarshal(issuersBytes, &list.Issuers); err != nil {
+		return nil, fmt.Errorf("decode trusted issuers: %w", err)
+	}
+
+	for _, issuer := range list.Issuers {
+		if issuer == nil || issuer.DID == "" {
+			return nil, errors.New("trusted issuer DID is mandatory")
+		}
+	}
+
+	return list, nil
+}
+
+func stringsContain(s []string, str string) bool {
+	for _, v := range s {
+		if v == str {
+			return true
+		}
+	}
+
+	return false
+}
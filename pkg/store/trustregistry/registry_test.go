@@ -0,0 +1,387 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package trustregistry
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/crypto/tinkcrypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/ed25519signature2018"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util/signature"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
+	mockkms "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/secretlock/noop"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	anchorDID = "did:example:governance"
+	issuerDID = "did:example:university"
+	listID    = "https://example.com/trust-lists/education"
+)
+
+func TestNew(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		r, err := New(newTestProvider())
+		require.NoError(t, err)
+		require.Empty(t, r.TrustLists())
+	})
+
+	t.Run("open store error", func(t *testing.T) {
+		_, err := New(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{ErrOpenStoreHandle: errors.New("open error")},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open error")
+	})
+
+	t.Run("iterator error", func(t *testing.T) {
+		_, err := New(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{Store: &mockstore.MockStore{
+				Store:  map[string][]byte{},
+				ErrItr: errors.New("iterator error"),
+			}},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "iterator error")
+	})
+
+	t.Run("loads stored trust lists", func(t *testing.T) {
+		signer := newTestSigner(t)
+		p := newTestProvider()
+
+		r, err := New(p, WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.NoError(t, err)
+
+		r, err = New(p, WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+		require.Len(t, r.TrustLists(), 1)
+		require.Equal(t, educationList().Issuers, r.TrustLists()[0].Issuers)
+	})
+
+	t.Run("verifies stored trust lists", func(t *testing.T) {
+		signer := newTestSigner(t)
+		p := newTestProvider()
+
+		r, err := New(p, WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.NoError(t, err)
+
+		r, err = New(p, WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors("did:example:other"))
+		require.NoError(t, err)
+		require.Empty(t, r.TrustLists())
+
+		r, err = New(p, WithTrustListCredentialOpts(newTestSigner(t).credOpts()...), WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+		require.Empty(t, r.TrustLists())
+
+		r, err = New(p, WithTrustListCredentialOpts(signer.credOpts()...))
+		require.NoError(t, err)
+		require.Empty(t, r.TrustLists())
+	})
+
+	t.Run("skips invalid stored trust list", func(t *testing.T) {
+		p := newTestProvider()
+
+		store, err := p.StorageProvider().OpenStore(NameSpace)
+		require.NoError(t, err)
+		require.NoError(t, store.Put(trustListKey+"invalid", []byte("{")))
+
+		r, err := New(p)
+		require.NoError(t, err)
+		require.Empty(t, r.TrustLists())
+	})
+}
+
+func TestRegistry_AddTrustList(t *testing.T) {
+	signer := newTestSigner(t)
+
+	t.Run("success", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		vcBytes := signer.trustList(t, anchorDID, educationList())
+
+		list, err := r.AddTrustList(vcBytes)
+		require.NoError(t, err)
+		require.Equal(t, listID, list.ID)
+		require.Equal(t, "Education", list.Name)
+		require.Equal(t, anchorDID, list.Issuer)
+
+		stored, err := r.GetTrustListCredential(listID)
+		require.NoError(t, err)
+		require.Equal(t, vcBytes, stored)
+	})
+
+	t.Run("unsigned trust list", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		vc, err := NewTrustListCredential(anchorDID, educationList())
+		require.NoError(t, err)
+
+		vcBytes, err := vc.MarshalJSON()
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(vcBytes)
+		require.EqualError(t, err, "trust list credential must be signed")
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(
+			verifiable.WithPublicKeyFetcher(verifiable.SingleKey(make([]byte, ed25519.PublicKeySize), kms.ED25519))))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse trust list credential")
+	})
+
+	t.Run("no trust anchors", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.EqualError(t, err, "no trust anchors are configured")
+	})
+
+	t.Run("expired trust list", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		vc, err := NewTrustListCredential(anchorDID, educationList())
+		require.NoError(t, err)
+
+		vc.Expired = util.NewTime(time.Now().Add(-time.Hour))
+
+		_, err = r.AddTrustList(signer.sign(t, vc))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "trust list credential expired at")
+	})
+
+	t.Run("not a trust anchor", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors("did:example:other"))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.EqualError(t, err, "trust list issuer did:example:governance is not a trust anchor")
+	})
+
+	t.Run("signed by other party", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors(anchorDID, "did:example:other"))
+		require.NoError(t, err)
+
+		vc, err := NewTrustListCredential("did:example:other", educationList())
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.sign(t, vc))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not belong to issuer did:example:other")
+	})
+
+	t.Run("not a trust list", func(t *testing.T) {
+		r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...),
+			WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		vc, err := NewTrustListCredential(anchorDID, educationList())
+		require.NoError(t, err)
+
+		vc.Types = []string{vcType}
+		vc.Context = []string{vcContext}
+		vc.Subject = "did:example:subject"
+
+		_, err = r.AddTrustList(signer.sign(t, vc))
+		require.EqualError(t, err, "credential is not of TrustListCredential type")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		r, err := New(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{Store: &mockstore.MockStore{
+				Store:  map[string][]byte{},
+				ErrPut: errors.New("put error"),
+			}},
+		}, WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+		require.NoError(t, err)
+
+		_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "put error")
+	})
+}
+
+func TestRegistry_RemoveTrustList(t *testing.T) {
+	signer := newTestSigner(t)
+
+	r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+	require.NoError(t, err)
+
+	_, err = r.AddTrustList(signer.trustList(t, anchorDID, educationList()))
+	require.NoError(t, err)
+
+	require.NoError(t, r.RemoveTrustList(listID))
+	require.Empty(t, r.TrustLists())
+
+	_, err = r.GetTrustListCredential(listID)
+	require.True(t, errors.Is(err, storage.ErrDataNotFound))
+
+	err = r.RemoveTrustList(listID)
+	require.True(t, errors.Is(err, storage.ErrDataNotFound))
+}
+
+func TestRegistry_CheckIssuerTrust(t *testing.T) {
+	signer := newTestSigner(t)
+
+	r, err := New(newTestProvider(), WithTrustListCredentialOpts(signer.credOpts()...), WithTrustAnchors(anchorDID))
+	require.NoError(t, err)
+
+	list := educationList()
+	list.Issuers = append(list.Issuers, &TrustedIssuer{DID: "did:example:any"})
+
+	_, err = r.AddTrustList(signer.trustList(t, anchorDID, list))
+	require.NoError(t, err)
+
+	newVC := func(issuer string, types ...string) *verifiable.Credential {
+		return &verifiable.Credential{
+			Context: []string{vcContext, "https://www.w3.org/2018/credentials/examples/v1"},
+			Types:   append([]string{vcType}, types...),
+			Issuer:  verifiable.Issuer{ID: issuer},
+		}
+	}
+
+	require.NoError(t, r.CheckIssuerTrust(newVC(issuerDID, "UniversityDegreeCredential")))
+	require.NoError(t, r.CheckIssuerTrust(newVC("did:example:any", "DriversLicenseCredential")))
+
+	err = r.CheckIssuerTrust(newVC(issuerDID, "DriversLicenseCredential"))
+	require.True(t, errors.Is(err, ErrUntrustedIssuer))
+
+	err = r.CheckIssuerTrust(newVC("did:example:unknown", "UniversityDegreeCredential"))
+	require.True(t, errors.Is(err, ErrUntrustedIssuer))
+
+	vc := newVC(issuerDID, "UniversityDegreeCredential")
+	vc.Context = append(vc.Context, "https://example.com/other-context")
+	require.True(t, errors.Is(r.CheckIssuerTrust(vc), ErrUntrustedIssuer))
+
+	t.Run("consulted from credential parsing", func(t *testing.T) {
+		vc := newVC(issuerDID, "DriversLicenseCredential")
+		vc.Subject = "did:example:subject"
+		vc.Issued = util.NewTime(time.Now())
+
+		vcBytes, err := vc.MarshalJSON()
+		require.NoError(t, err)
+
+		_, err = verifiable.ParseCredential(vcBytes, verifiable.WithIssuerTrustCheck(r),
+			verifiable.WithDisabledProofCheck())
+		require.Error(t, err)
+		require.True(t, errors.Is(err, ErrUntrustedIssuer))
+
+		vc.Types = []string{vcType, "UniversityDegreeCredential"}
+
+		vcBytes, err = vc.MarshalJSON()
+		require.NoError(t, err)
+
+		_, err = verifiable.ParseCredential(vcBytes, verifiable.WithIssuerTrustCheck(r),
+			verifiable.WithDisabledProofCheck())
+		require.NoError(t, err)
+	})
+
+	t.Run("expired trust list is not consulted", func(t *testing.T) {
+		expires := time.Now().Add(-time.Minute)
+		r.lists[listID].Expires = &expires
+
+		err := r.CheckIssuerTrust(newVC(issuerDID, "UniversityDegreeCredential"))
+		require.True(t, errors.Is(err, ErrUntrustedIssuer))
+	})
+}
+
+func TestNewTrustListCredential(t *testing.T) {
+	_, err := NewTrustListCredential(anchorDID, &TrustList{})
+	require.EqualError(t, err, "trust list ID is mandatory")
+}
+
+func educationList() *TrustList {
+	return &TrustList{
+		ID:   listID,
+		Name: "Education",
+		Issuers: []*TrustedIssuer{{
+			DID:             issuerDID,
+			CredentialTypes: []string{"UniversityDegreeCredential"},
+			Contexts:        []string{"https://www.w3.org/2018/credentials/examples/v1"},
+		}},
+	}
+}
+
+type testSigner struct {
+	signer signature.Signer
+}
+
+func newTestSigner(t *testing.T) *testSigner {
+	localKMS, err := localkms.New("local-lock://custom/master/key/",
+		mockkms.NewProviderForKMS(mockstore.NewMockStoreProvider(), &noop.NoLock{}))
+	require.NoError(t, err)
+
+	tinkCrypto, err := tinkcrypto.New()
+	require.NoError(t, err)
+
+	signer, err := signature.NewCryptoSigner(tinkCrypto, localKMS, kms.ED25519Type)
+	require.NoError(t, err)
+
+	return &testSigner{signer: signer}
+}
+
+func (s *testSigner) credOpts() []verifiable.CredentialOpt {
+	return []verifiable.CredentialOpt{
+		verifiable.WithPublicKeyFetcher(verifiable.SingleKey(s.signer.PublicKeyBytes(), kms.ED25519)),
+	}
+}
+
+func (s *testSigner) sign(t *testing.T, vc *verifiable.Credential) []byte {
+	err := vc.AddLinkedDataProof(&verifiable.LinkedDataProofContext{
+		SignatureType:           "Ed25519Signature2018",
+		SignatureRepresentation: verifiable.SignatureProofValue,
+		Suite:                   ed25519signature2018.New(suite.WithSigner(s.signer)),
+		VerificationMethod:      anchorDID + "#key1",
+	})
+	require.NoError(t, err)
+
+	vcBytes, err := vc.MarshalJSON()
+	require.NoError(t, err)
+
+	return vcBytes
+}
+
+func (s *testSigner) trustList(t *testing.T, issuer string, list *TrustList) []byte {
+	vc, err := NewTrustListCredential(issuer, list)
+	require.NoError(t, err)
+
+	return s.sign(t, vc)
+}
+
+func newTestProvider() *mockprovider.Provider {
+	return &mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()}
+}
@@ -0,0 +1,213 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+// types of the credential expiry events.
+const (
+	// CredentialExpiring is emitted once the credential expires within the notice period.
+	CredentialExpiring = "expiring"
+	// CredentialExpired is emitted once the credential has expired.
+	CredentialExpired = "expired"
+
+	// DefaultExpiryNotice is the default period before expiration to emit CredentialExpiring event.
+	DefaultExpiryNotice = 30 * 24 * time.Hour
+	// DefaultExpiryCheckInterval is the default interval between the checks of the expiration dates.
+	DefaultExpiryCheckInterval = time.Hour
+
+	expiryNotifiedKeyPattern = "vcexpiry_%s_%s"
+	expiryTrackedSinceKey    = "vcexpiry_tracked_since"
+)
+
+var logger = log.New("aries-framework/store/verifiable")
+
+// ExpiryEvent is emitted by the ExpiryTracker when the saved credential is about to expire or has expired.
+type ExpiryEvent struct {
+	Type   string  `json:"type"`
+	Record *Record `json:"record"`
+}
+
+// ExpiryTrackerOpt is the ExpiryTracker option.
+type ExpiryTrackerOpt func(t *ExpiryTracker)
+
+// WithExpiryNotice sets how long before the expiration the CredentialExpiring event is emitted.
+func WithExpiryNotice(notice time.Duration) ExpiryTrackerOpt {
+	return func(t *ExpiryTracker) {
+		t.notice = notice
+	}
+}
+
+// WithExpiryCheckInterval sets the interval between the checks of the expiration dates.
+func WithExpiryCheckInterval(interval time.Duration) ExpiryTrackerOpt {
+	return func(t *ExpiryTracker) {
+		t.interval = interval
+	}
+}
+
+// ExpiryTracker tracks the expiration dates of the saved credentials and emits ExpiryEvent
+// when the credential is about to expire and once again when it has expired. Emitted events
+// are recorded in the store, so every event is emitted once per credential expiration date.
+type ExpiryTracker struct {
+	vcStore  *StoreImplementation
+	handler  func(*ExpiryEvent)
+	notice   time.Duration
+	interval time.Duration
+	now      func() time.Time
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewExpiryTracker returns a new tracker of the expiring credentials, events are passed to the handler.
+func NewExpiryTracker(ctx provider, handler func(*ExpiryEvent), opts ...ExpiryTrackerOpt) (*ExpiryTracker, error) {
+	if handler == nil {
+		return nil, errors.New("expiry event handler is mandatory")
+	}
+
+	vcStore, err := New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &ExpiryTracker{
+		vcStore:  vcStore,
+		handler:  handler,
+		notice:   DefaultExpiryNotice,
+		interval: DefaultExpiryCheckInterval,
+		now:      time.Now,
+		stop:     make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	return t, nil
+}
+
+// Notice returns the period before the expiration when the CredentialExpiring event is emitted.
+func (t *ExpiryTracker) Notice() time.Duration {
+	return t.notice
+}
+
+// Start starts checking the expiration dates in the background, the first check is done immediately.
+func (t *ExpiryTracker) Start() {
+	go func() {
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			if err := t.Check(); err != nil {
+				logger.Errorf("check expiring credentials: %s", err)
+			}
+
+			select {
+			case <-ticker.C:
+			case <-t.stop:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the background checks.
+func (t *ExpiryTracker) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+}
+
+// Check emits events for the credentials which are expiring within the notice period or have expired
+// and were not notified yet. The credentials which had expired before the notice period of the first check
+// are not notified.
+func (t *ExpiryTracker) Check() error {
+	now := t.now()
+	deadline := now.Add(t.notice)
+
+	since, err := t.trackedSince(now)
+	if err != nil {
+		return err
+	}
+
+	expiresAfter := since.Add(-t.notice)
+
+	result, err := t.vcStore.QueryCredentials(&CredentialQuery{
+		ExpiresAfter:  &expiresAfter,
+		ExpiresBefore: &deadline,
+		SortBy:        SortByExpirationDate,
+	})
+	if err != nil {
+		return fmt.Errorf("query expiring credentials: %w", err)
+	}
+
+	for _, r := range result.Records {
+		eventType := CredentialExpiring
+		if !r.ExpirationDate.After(now) {
+			eventType = CredentialExpired
+		}
+
+		key := expiryNotifiedKey(eventType, r.Name)
+		expiration := []byte(r.ExpirationDate.UTC().Format(time.RFC3339Nano))
+
+		notified, err := t.vcStore.store.Get(key)
+		if err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+			return fmt.Errorf("get expiry notification state: %w", err)
+		}
+
+		if string(notified) == string(expiration) {
+			continue
+		}
+
+		t.handler(&ExpiryEvent{Type: eventType, Record: r})
+
+		if err := t.vcStore.store.Put(key, expiration); err != nil {
+			return fmt.Errorf("save expiry notification state: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// trackedSince returns the time of the first check, which is recorded in the store if there was no check yet.
+func (t *ExpiryTracker) trackedSince(now time.Time) (time.Time, error) {
+	sinceBytes, err := t.vcStore.store.Get(expiryTrackedSinceKey)
+	if err == nil {
+		since, e := time.Parse(time.RFC3339Nano, string(sinceBytes))
+		if e != nil {
+			return time.Time{}, fmt.Errorf("parse expiry tracking start: %w", e)
+		}
+
+		return since, nil
+	}
+
+	if !errors.Is(err, storage.ErrDataNotFound) {
+		return time.Time{}, fmt.Errorf("get expiry tracking start: %w", err)
+	}
+
+	if err := t.vcStore.store.Put(expiryTrackedSinceKey, []byte(now.UTC().Format(time.RFC3339Nano))); err != nil {
+		return time.Time{}, fmt.Errorf("save expiry tracking start: %w", err)
+	}
+
+	return now, nil
+}
+
+func expiryNotifiedKey(eventType, name string) string {
+	return fmt.Sprintf(expiryNotifiedKeyPattern, eventType, name)
+}
+
+// expiryNotifiedKeys returns the keys of the expiry notification state of the credential.
+func expiryNotifiedKeys(name string) []string {
+	return []string{expiryNotifiedKey(CredentialExpiring, name), expiryNotifiedKey(CredentialExpired, name)}
+}
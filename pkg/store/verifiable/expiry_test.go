@@ -0,0 +1,212 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+func TestNewExpiryTracker(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tracker, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		}, func(*ExpiryEvent) {}, WithExpiryNotice(time.Hour), WithExpiryCheckInterval(time.Minute))
+		require.NoError(t, err)
+		require.Equal(t, time.Hour, tracker.Notice())
+		require.Equal(t, time.Minute, tracker.interval)
+	})
+
+	t.Run("no handler", func(t *testing.T) {
+		_, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		}, nil)
+		require.EqualError(t, err, "expiry event handler is mandatory")
+	})
+
+	t.Run("store error", func(t *testing.T) {
+		_, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{ErrOpenStoreHandle: errors.New("open error")},
+		}, func(*ExpiryEvent) {})
+		require.EqualError(t, err, "failed to open vc store: open error")
+	})
+}
+
+func TestExpiryTracker_Check(t *testing.T) {
+	provider := &mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()}
+
+	s, err := New(provider)
+	require.NoError(t, err)
+
+	now := time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	saveVC := func(name string, expires time.Duration) {
+		vc := &verifiable.Credential{
+			ID:      "http://example.edu/credentials/" + name,
+			Context: []string{baseContext},
+			Types:   []string{"VerifiableCredential"},
+			Issuer:  verifiable.Issuer{ID: "did:example:issuer"},
+			Issued:  util.NewTime(now.Add(-365 * 24 * time.Hour)),
+		}
+
+		if expires != 0 {
+			vc.Expired = util.NewTime(now.Add(expires))
+		}
+
+		require.NoError(t, s.SaveCredential(name, vc))
+	}
+
+	saveVC("expiring", 2*24*time.Hour)
+	saveVC("valid", 60*24*time.Hour)
+	saveVC("no expiration", 0)
+	saveVC("expired long ago", -30*24*time.Hour)
+
+	var events []*ExpiryEvent
+
+	tracker, err := NewExpiryTracker(provider, func(e *ExpiryEvent) {
+		events = append(events, e)
+	}, WithExpiryNotice(7*24*time.Hour))
+	require.NoError(t, err)
+
+	tracker.now = func() time.Time { return now }
+
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 1)
+	require.Equal(t, CredentialExpiring, events[0].Type)
+	require.Equal(t, "expiring", events[0].Record.Name)
+
+	// event is emitted only once
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 1)
+
+	// the credential has expired
+	tracker.now = func() time.Time { return now.Add(3 * 24 * time.Hour) }
+
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 2)
+	require.Equal(t, CredentialExpired, events[1].Type)
+	require.Equal(t, "expiring", events[1].Record.Name)
+
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 2)
+
+	// the credential is renewed under the same name
+	require.NoError(t, s.RemoveCredentialByName("expiring"))
+
+	for _, key := range expiryNotifiedKeys("expiring") {
+		_, err = s.store.Get(key)
+		require.True(t, errors.Is(err, storage.ErrDataNotFound))
+	}
+
+	saveVC("expiring", 5*24*time.Hour)
+
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 3)
+	require.Equal(t, CredentialExpiring, events[2].Type)
+
+	// the start of the tracking is kept, so the credentials which expired since then are notified
+	// by the new tracker
+	saveVC("expired since start", 50*24*time.Hour)
+
+	tracker, err = NewExpiryTracker(provider, func(e *ExpiryEvent) {
+		events = append(events, e)
+	}, WithExpiryNotice(7*24*time.Hour))
+	require.NoError(t, err)
+
+	tracker.now = func() time.Time { return now.Add(100 * 24 * time.Hour) }
+
+	require.NoError(t, tracker.Check())
+	require.Len(t, events, 6)
+
+	for i, name := range []string{"expiring", "expired since start", "valid"} {
+		require.Equal(t, CredentialExpired, events[3+i].Type)
+		require.Equal(t, name, events[3+i].Record.Name)
+	}
+}
+
+func TestExpiryTracker_CheckTrackedSince(t *testing.T) {
+	t.Run("get error", func(t *testing.T) {
+		store := &mockstore.MockStore{Store: make(map[string][]byte)}
+
+		tracker, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{Store: store},
+		}, func(*ExpiryEvent) {})
+		require.NoError(t, err)
+
+		store.ErrGet = errors.New("get error")
+
+		err = tracker.Check()
+		require.EqualError(t, err, "get expiry tracking start: get error")
+	})
+
+	t.Run("put error", func(t *testing.T) {
+		store := &mockstore.MockStore{Store: make(map[string][]byte)}
+
+		tracker, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{Store: store},
+		}, func(*ExpiryEvent) {})
+		require.NoError(t, err)
+
+		store.ErrPut = errors.New("put error")
+
+		err = tracker.Check()
+		require.EqualError(t, err, "save expiry tracking start: put error")
+	})
+
+	t.Run("invalid tracking start", func(t *testing.T) {
+		store := &mockstore.MockStore{Store: map[string][]byte{expiryTrackedSinceKey: []byte("invalid")}}
+
+		tracker, err := NewExpiryTracker(&mockprovider.Provider{
+			StorageProviderValue: &mockstore.MockStoreProvider{Store: store},
+		}, func(*ExpiryEvent) {})
+		require.NoError(t, err)
+
+		err = tracker.Check()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse expiry tracking start")
+	})
+}
+
+func TestExpiryTracker_Start(t *testing.T) {
+	provider := &mockprovider.Provider{StorageProviderValue: mockstore.NewMockStoreProvider()}
+
+	s, err := New(provider)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SaveCredential("expired", &verifiable.Credential{
+		ID:      "http://example.edu/credentials/1",
+		Context: []string{baseContext},
+		Types:   []string{"VerifiableCredential"},
+		Expired: util.NewTime(time.Now().Add(-time.Hour)),
+	}))
+
+	events := make(chan *ExpiryEvent, 1)
+
+	tracker, err := NewExpiryTracker(provider, func(e *ExpiryEvent) {
+		events <- e
+	}, WithExpiryCheckInterval(time.Millisecond))
+	require.NoError(t, err)
+
+	tracker.Start()
+	defer tracker.Stop()
+
+	select {
+	case e := <-events:
+		require.Equal(t, CredentialExpired, e.Type)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for the expiry event")
+	}
+}
@@ -6,11 +6,56 @@ SPDX-License-Identifier: Apache-2.0
 
 package verifiable
 
+import "time"
+
 // Record model containing name, ID and other fields of interest.
 type Record struct {
-	Name      string   `json:"name,omitempty"`
-	ID        string   `json:"id,omitempty"`
-	Context   []string `json:"context,omitempty"`
-	Type      []string `json:"type,omitempty"`
-	SubjectID string   `json:"subjectId,omitempty"`
+	Name           string     `json:"name,omitempty"`
+	ID             string     `json:"id,omitempty"`
+	Context        []string   `json:"context,omitempty"`
+	Type           []string   `json:"type,omitempty"`
+	SubjectID      string     `json:"subjectId,omitempty"`
+	Issuer         string     `json:"issuer,omitempty"`
+	IssuanceDate   *time.Time `json:"issuanceDate,omitempty"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
+}
+
+// CredentialQuery defines criteria of the verifiable credential records query. Empty criteria match
+// any record, all the defined criteria must be matched by the record.
+type CredentialQuery struct {
+	// Type is a type which the credential must have.
+	Type string `json:"type,omitempty"`
+	// Issuer is ID of the credential issuer.
+	Issuer string `json:"issuer,omitempty"`
+	// SubjectID is ID of the credential subject.
+	SubjectID string `json:"subjectId,omitempty"`
+	// Context is a JSON-LD context which the credential must have.
+	Context string `json:"context,omitempty"`
+	// Name is a text which must be contained in the credential name (case insensitive).
+	Name string `json:"name,omitempty"`
+	// IssuedAfter and IssuedBefore define the range of the credential issuance date. The bounds are exclusive,
+	// i.e. the credential issued exactly at the bound does not match. Credentials without the issuance date
+	// do not match any range.
+	IssuedAfter  *time.Time `json:"issuedAfter,omitempty"`
+	IssuedBefore *time.Time `json:"issuedBefore,omitempty"`
+	// ExpiresAfter and ExpiresBefore define the range of the credential expiration date. The bounds are
+	// exclusive. Credentials without the expiration date do not match any range.
+	ExpiresAfter  *time.Time `json:"expiresAfter,omitempty"`
+	ExpiresBefore *time.Time `json:"expiresBefore,omitempty"`
+	// SortBy is a record field used for sorting: "name" (default), "issuanceDate" or "expirationDate".
+	SortBy string `json:"sortBy,omitempty"`
+	// Descending defines descending sort order.
+	Descending bool `json:"descending,omitempty"`
+	// Offset is the number of matching records to skip.
+	Offset int `json:"offset,omitempty"`
+	// Limit is the maximum number of records returned. Zero means no limit.
+	Limit int `json:"limit,omitempty"`
+}
+
+// QueryResult is a page of the records matching the query.
+type QueryResult struct {
+	// Records of the page.
+	Records []*Record `json:"records"`
+	// Total is the number of all the records matching the query.
+	Total int `json:"total"`
 }
@@ -0,0 +1,157 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sort fields of the credential query
+const (
+	SortByName           = "name"
+	SortByIssuanceDate   = "issuanceDate"
+	SortByExpirationDate = "expirationDate"
+)
+
+// QueryCredentials retrieves a page of the verifiable credential records matching the query. The records are
+// filtered and sorted in memory as the underlying storage does not support indexed queries.
+func (s *StoreImplementation) QueryCredentials(query *CredentialQuery) (*QueryResult, error) {
+	if query == nil {
+		query = &CredentialQuery{}
+	}
+
+	if err := validateQuery(query); err != nil {
+		return nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	records, err := s.GetCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*Record
+
+	for _, r := range records {
+		if query.matches(r) {
+			matched = append(matched, r)
+		}
+	}
+
+	sortRecords(matched, query.SortBy, query.Descending)
+
+	result := &QueryResult{Records: []*Record{}, Total: len(matched)}
+
+	if query.Offset >= len(matched) {
+		return result, nil
+	}
+
+	end := len(matched)
+	if query.Limit > 0 && query.Offset+query.Limit < end {
+		end = query.Offset + query.Limit
+	}
+
+	result.Records = matched[query.Offset:end]
+
+	return result, nil
+}
+
+func validateQuery(query *CredentialQuery) error {
+	switch query.SortBy {
+	case "", SortByName, SortByIssuanceDate, SortByExpirationDate:
+	default:
+		return fmt.Errorf("unsupported sort field: %s", query.SortBy)
+	}
+
+	if query.Offset < 0 || query.Limit < 0 {
+		return errors.New("offset and limit must not be negative")
+	}
+
+	return nil
+}
+
+func (q *CredentialQuery) matches(r *Record) bool {
+	switch {
+	case q.Type != "" && !contains(r.Type, q.Type),
+		q.Context != "" && !contains(r.Context, q.Context),
+		q.Issuer != "" && r.Issuer != q.Issuer,
+		q.SubjectID != "" && r.SubjectID != q.SubjectID,
+		q.Name != "" && !strings.Contains(strings.ToLower(r.Name), strings.ToLower(q.Name)):
+		return false
+	}
+
+	return inRange(r.IssuanceDate, q.IssuedAfter, q.IssuedBefore) &&
+		inRange(r.ExpirationDate, q.ExpiresAfter, q.ExpiresBefore)
+}
+
+// inRange checks that the time is within the range. The bounds are exclusive. The record without the time
+// does not match any range.
+func inRange(t, after, before *time.Time) bool {
+	if after == nil && before == nil {
+		return true
+	}
+
+	if t == nil {
+		return false
+	}
+
+	return (after == nil || t.After(*after)) && (before == nil || t.Before(*before))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortRecords sorts the records by the field. Records without the date are placed at the end.
+func sortRecords(records []*Record, sortBy string, descending bool) {
+	less := func(a, b *Record) bool {
+		return a.Name < b.Name
+	}
+
+	switch sortBy {
+	case SortByIssuanceDate:
+		less = byTime(func(r *Record) *time.Time { return r.IssuanceDate }, descending)
+	case SortByExpirationDate:
+		less = byTime(func(r *Record) *time.Time { return r.ExpirationDate }, descending)
+	default:
+		if descending {
+			less = func(a, b *Record) bool {
+				return a.Name > b.Name
+			}
+		}
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return less(records[i], records[j])
+	})
+}
+
+func byTime(field func(*Record) *time.Time, descending bool) func(a, b *Record) bool {
+	return func(a, b *Record) bool {
+		ta, tb := field(a), field(b)
+
+		switch {
+		case ta == nil:
+			return false
+		case tb == nil:
+			return true
+		case descending:
+			return ta.After(*tb)
+		default:
+			return ta.Before(*tb)
+		}
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package verifiable
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+const (
+	baseContext     = "https://www.w3.org/2018/credentials/v1"
+	examplesContext = "https://www.w3.org/2018/credentials/examples/v1"
+)
+
+func newQueryTestStore(t *testing.T) *StoreImplementation {
+	t.Helper()
+
+	s, err := New(&mockprovider.Provider{
+		StorageProviderValue: mockstore.NewMockStoreProvider(),
+	})
+	require.NoError(t, err)
+
+	date := func(year int) *util.TimeWithTrailingZeroMsec {
+		return util.NewTime(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	credentials := map[string]*verifiable.Credential{
+		"University Degree": {
+			ID:      "http://example.edu/credentials/1",
+			Context: []string{baseContext, examplesContext},
+			Types:   []string{"VerifiableCredential", "UniversityDegreeCredential"},
+			Issuer:  verifiable.Issuer{ID: "did:example:university"},
+			Subject: "did:example:alice",
+			Issued:  date(2010),
+			Expired: date(2030),
+		},
+		"Driving License": {
+			ID:      "http://example.gov/credentials/2",
+			Context: []string{baseContext},
+			Types:   []string{"VerifiableCredential", "DrivingLicenseCredential"},
+			Issuer:  verifiable.Issuer{ID: "did:example:gov"},
+			Subject: "did:example:alice",
+			Issued:  date(2015),
+			Expired: date(2025),
+		},
+		"Passport": {
+			ID:      "http://example.gov/credentials/3",
+			Context: []string{baseContext},
+			Types:   []string{"VerifiableCredential", "PassportCredential"},
+			Issuer:  verifiable.Issuer{ID: "did:example:gov"},
+			Subject: "did:example:bob",
+			Issued:  date(2020),
+		},
+	}
+
+	for name, vc := range credentials {
+		require.NoError(t, s.SaveCredential(name, vc))
+	}
+
+	return s
+}
+
+func recordNames(result *QueryResult) []string {
+	names := make([]string, len(result.Records))
+
+	for i, r := range result.Records {
+		names[i] = r.Name
+	}
+
+	return names
+}
+
+func TestQueryCredentials(t *testing.T) {
+	s := newQueryTestStore(t)
+
+	timeRef := func(year int) *time.Time {
+		t := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		query    *CredentialQuery
+		expected []string
+		total    int
+	}{
+		{
+			name:     "all records sorted by name",
+			query:    nil,
+			expected: []string{"Driving License", "Passport", "University Degree"},
+			total:    3,
+		},
+		{
+			name:     "by type",
+			query:    &CredentialQuery{Type: "PassportCredential"},
+			expected: []string{"Passport"},
+			total:    1,
+		},
+		{
+			name:     "by issuer and subject",
+			query:    &CredentialQuery{Issuer: "did:example:gov", SubjectID: "did:example:alice"},
+			expected: []string{"Driving License"},
+			total:    1,
+		},
+		{
+			name:     "by context",
+			query:    &CredentialQuery{Context: examplesContext},
+			expected: []string{"University Degree"},
+			total:    1,
+		},
+		{
+			name:     "by name text",
+			query:    &CredentialQuery{Name: "LICENSE"},
+			expected: []string{"Driving License"},
+			total:    1,
+		},
+		{
+			name:     "by issuance range",
+			query:    &CredentialQuery{IssuedAfter: timeRef(2011), IssuedBefore: timeRef(2021)},
+			expected: []string{"Driving License", "Passport"},
+			total:    2,
+		},
+		{
+			name:     "range bounds are exclusive",
+			query:    &CredentialQuery{IssuedAfter: timeRef(2015), IssuedBefore: timeRef(2020)},
+			expected: []string{},
+			total:    0,
+		},
+		{
+			name:     "by expiration range excludes records without expiration",
+			query:    &CredentialQuery{ExpiresBefore: timeRef(2040)},
+			expected: []string{"Driving License", "University Degree"},
+			total:    2,
+		},
+		{
+			name:     "sorted by issuance date descending",
+			query:    &CredentialQuery{SortBy: SortByIssuanceDate, Descending: true},
+			expected: []string{"Passport", "Driving License", "University Degree"},
+			total:    3,
+		},
+		{
+			name:     "sorted by expiration date, records without expiration are last",
+			query:    &CredentialQuery{SortBy: SortByExpirationDate},
+			expected: []string{"Driving License", "University Degree", "Passport"},
+			total:    3,
+		},
+		{
+			name:     "sorted by name descending",
+			query:    &CredentialQuery{Descending: true},
+			expected: []string{"University Degree", "Passport", "Driving License"},
+			total:    3,
+		},
+		{
+			name:     "pagination",
+			query:    &CredentialQuery{Offset: 1, Limit: 1},
+			expected: []string{"Passport"},
+			total:    3,
+		},
+		{
+			name:     "offset beyond the results",
+			query:    &CredentialQuery{Offset: 5},
+			expected: []string{},
+			total:    3,
+		},
+	}
+
+	for _, test := range tests {
+		tc := test
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := s.QueryCredentials(tc.query)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, recordNames(result))
+			require.Equal(t, tc.total, result.Total)
+		})
+	}
+
+	t.Run("record fields", func(t *testing.T) {
+		result, err := s.QueryCredentials(&CredentialQuery{Type: "UniversityDegreeCredential"})
+		require.NoError(t, err)
+		require.Len(t, result.Records, 1)
+
+		r := result.Records[0]
+		require.Equal(t, "http://example.edu/credentials/1", r.ID)
+		require.Equal(t, "did:example:university", r.Issuer)
+		require.Equal(t, "did:example:alice", r.SubjectID)
+		require.Equal(t, timeRef(2010).Unix(), r.IssuanceDate.Unix())
+		require.Equal(t, timeRef(2030).Unix(), r.ExpirationDate.Unix())
+	})
+
+	t.Run("invalid query", func(t *testing.T) {
+		_, err := s.QueryCredentials(&CredentialQuery{SortBy: "unknown"})
+		require.EqualError(t, err, "invalid query: unsupported sort field: unknown")
+
+		_, err = s.QueryCredentials(&CredentialQuery{Limit: -1})
+		require.EqualError(t, err, "invalid query: offset and limit must not be negative")
+	})
+}
+
+func TestQueryCredentials_MigratedRecords(t *testing.T) {
+	storeProvider := mockstore.NewMockStoreProvider()
+
+	s, err := New(&mockprovider.Provider{StorageProviderValue: storeProvider})
+	require.NoError(t, err)
+
+	vc := &verifiable.Credential{
+		ID:      "http://example.edu/credentials/1",
+		Context: []string{baseContext},
+		Types:   []string{"VerifiableCredential"},
+		Issuer:  verifiable.Issuer{ID: "did:example:university"},
+		Subject: "did:example:alice",
+		Issued:  util.NewTime(time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	require.NoError(t, s.SaveCredential("degree", vc))
+
+	// record saved before the query fields and the records version were introduced
+	storeProvider.Store.Store[credentialNameDataKey("degree")] = []byte(
+		`{"id":"http://example.edu/credentials/1","context":["` + baseContext + `"],"type":["VerifiableCredential"]}`)
+	delete(storeProvider.Store.Store, recordsVersionKey)
+
+	result, err := s.QueryCredentials(&CredentialQuery{Issuer: "did:example:university"})
+	require.NoError(t, err)
+	require.Empty(t, result.Records)
+
+	s, err = New(&mockprovider.Provider{StorageProviderValue: storeProvider})
+	require.NoError(t, err)
+
+	result, err = s.QueryCredentials(&CredentialQuery{Issuer: "did:example:university"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"degree"}, recordNames(result))
+	require.Equal(t, "did:example:alice", result.Records[0].SubjectID)
+	require.NotNil(t, result.Records[0].IssuanceDate)
+	require.Equal(t, []byte(recordsVersion), storeProvider.Store.Store[recordsVersionKey])
+
+	t.Run("records are migrated once", func(t *testing.T) {
+		migratedRecord := storeProvider.Store.Store[credentialNameDataKey("degree")]
+		legacyRecord := []byte(`{"id":"http://example.edu/credentials/1"}`)
+		storeProvider.Store.Store[credentialNameDataKey("degree")] = legacyRecord
+
+		_, err := New(&mockprovider.Provider{StorageProviderValue: storeProvider})
+		require.NoError(t, err)
+		require.Equal(t, legacyRecord, storeProvider.Store.Store[credentialNameDataKey("degree")])
+
+		storeProvider.Store.Store[credentialNameDataKey("degree")] = migratedRecord
+	})
+
+	t.Run("records which can't be migrated are left as is", func(t *testing.T) {
+		delete(storeProvider.Store.Store, recordsVersionKey)
+		storeProvider.Store.Store[credentialNameDataKey("missing")] = []byte(`{"id":"http://example.edu/credentials/2"}`)
+		storeProvider.Store.Store[credentialNameDataKey("invalid")] = []byte(`{"id":"http://example.edu/credentials/3"}`)
+		storeProvider.Store.Store["http://example.edu/credentials/3"] = []byte(`{}`)
+
+		s, err := New(&mockprovider.Provider{StorageProviderValue: storeProvider})
+		require.NoError(t, err)
+
+		result, err := s.QueryCredentials(&CredentialQuery{Issuer: "did:example:university"})
+		require.NoError(t, err)
+		require.Equal(t, []string{"degree"}, recordNames(result))
+
+		result, err = s.QueryCredentials(nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, result.Total)
+	})
+}
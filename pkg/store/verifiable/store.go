@@ -10,9 +10,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/piprate/json-gold/ld"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/hyperledger/aries-framework-go/pkg/storage"
 )
@@ -28,6 +32,11 @@ const (
 
 	// limitPattern for the iterator
 	limitPattern = "%s" + storage.EndKeySuffix
+
+	// recordsVersionKey stores the version of the credential records. The stores created before the version
+	// was introduced have no version, so their records are migrated once.
+	recordsVersionKey = "vcrecordsversion"
+	recordsVersion    = "1"
 )
 
 // ErrNotFound signals that the entry for the given DID and key is not present in the store.
@@ -43,24 +52,31 @@ type Store interface {
 	GetPresentationIDByName(name string) (string, error)
 	GetCredentials() ([]*Record, error)
 	GetPresentations() ([]*Record, error)
+	QueryCredentials(query *CredentialQuery) (*QueryResult, error)
+	UpdateCredential(name string, vc *verifiable.Credential) error
 	RemoveCredentialByName(name string) error
 	RemovePresentationByName(name string) error
 }
 
 type record struct {
-	ID        string   `json:"id,omitempty"`
-	Context   []string `json:"context,omitempty"`
-	Type      []string `json:"type,omitempty"`
-	SubjectID string   `json:"subjectId,omitempty"`
+	ID             string     `json:"id,omitempty"`
+	Context        []string   `json:"context,omitempty"`
+	Type           []string   `json:"type,omitempty"`
+	SubjectID      string     `json:"subjectId,omitempty"`
+	Issuer         string     `json:"issuer,omitempty"`
+	IssuanceDate   *time.Time `json:"issuanceDate,omitempty"`
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"`
 }
 
 // StoreImplementation stores vc.
 type StoreImplementation struct {
-	store storage.Store
+	store          storage.Store
+	documentLoader ld.DocumentLoader
 }
 
 type provider interface {
 	StorageProvider() storage.Provider
+	JSONLDDocumentLoader() *jsonld.DocumentLoader
 }
 
 // New returns a new vc store.
@@ -70,7 +86,65 @@ func New(ctx provider) (*StoreImplementation, error) {
 		return nil, fmt.Errorf("failed to open vc store: %w", err)
 	}
 
-	return &StoreImplementation{store: store}, nil
+	var documentLoader ld.DocumentLoader = jsonld.DefaultDocumentLoader()
+	if loader := ctx.JSONLDDocumentLoader(); loader != nil {
+		documentLoader = loader
+	}
+
+	s := &StoreImplementation{store: store, documentLoader: documentLoader}
+
+	if err := s.migrateCredentialRecords(); err != nil {
+		return nil, fmt.Errorf("migrate vc records: %w", err)
+	}
+
+	return s, nil
+}
+
+// migrateCredentialRecords adds the fields used by the credential query (issuer, issuance and expiration dates)
+// to the records saved before the fields were introduced. Such records are recognized by the missing issuer
+// which is mandatory for the credential. The records which can't be migrated are left as is.
+// The migration is skipped if the records have the current version already.
+func (s *StoreImplementation) migrateCredentialRecords() error {
+	version, err := s.store.Get(recordsVersionKey)
+	if err == nil && string(version) == recordsVersion {
+		return nil
+	}
+
+	// the version is recorded only if it was read successfully, otherwise the store is likely unusable
+	recordVersion := err == nil || errors.Is(err, storage.ErrDataNotFound)
+	if !recordVersion {
+		logger.Warnf("failed to get version of credential records: %s", err)
+	}
+
+	records, err := s.GetCredentials()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if r.Issuer != "" {
+			continue
+		}
+
+		vc, err := s.GetCredential(r.ID)
+		if err == nil {
+			err = s.putCredentialRecord(r.Name, r.ID, vc)
+		}
+
+		if err != nil {
+			logger.Warnf("failed to migrate record of credential %s: %s", r.Name, err)
+		}
+	}
+
+	if !recordVersion {
+		return nil
+	}
+
+	if err := s.store.Put(recordsVersionKey, []byte(recordsVersion)); err != nil {
+		logger.Warnf("failed to put version of credential records: %s", err)
+	}
+
+	return nil
 }
 
 // SaveCredential saves a verifiable credential.
@@ -88,22 +162,73 @@ func (s *StoreImplementation) SaveCredential(name string, vc *verifiable.Credent
 		return errors.New("credential name already exists")
 	}
 
-	vcBytes, err := vc.MarshalJSON()
-	if err != nil {
-		return fmt.Errorf("failed to marshal vc: %w", err)
-	}
-
 	id = vc.ID
 	if id == "" {
 		// ID in VCs are not mandatory, use uuid to save in DB if id missing
 		id = uuid.New().String()
 	}
 
+	return s.putCredential(name, id, vc)
+}
+
+// UpdateCredential replaces the verifiable credential saved under the given name (e.g. with the refreshed one).
+func (s *StoreImplementation) UpdateCredential(name string, vc *verifiable.Credential) error {
+	if name == "" {
+		return errors.New("credential name is mandatory")
+	}
+
+	oldID, err := s.GetCredentialIDByName(name)
+	if err != nil {
+		return fmt.Errorf("get credential id using name : %w", err)
+	}
+
+	id := vc.ID
+	if id == "" {
+		id = oldID
+	}
+
+	if id != oldID {
+		// the credential must not replace other stored credential with the same ID
+		_, err = s.store.Get(id)
+		if err == nil {
+			return fmt.Errorf("credential ID %s belongs to another stored credential", id)
+		}
+
+		if !errors.Is(err, storage.ErrDataNotFound) {
+			return fmt.Errorf("get credential by new id : %w", err)
+		}
+
+		if err := s.store.Delete(oldID); err != nil {
+			return fmt.Errorf("unable to delete replaced credential : %w", err)
+		}
+	}
+
+	return s.putCredential(name, id, vc)
+}
+
+func (s *StoreImplementation) putCredential(name, id string, vc *verifiable.Credential) error {
+	vcBytes, err := vc.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("failed to marshal vc: %w", err)
+	}
+
 	if e := s.store.Put(id, vcBytes); e != nil {
 		return fmt.Errorf("failed to put vc: %w", e)
 	}
 
-	recordBytes, err := getRecord(id, getVCSubjectID(vc), vc.Context, vc.Types)
+	return s.putCredentialRecord(name, id, vc)
+}
+
+func (s *StoreImplementation) putCredentialRecord(name, id string, vc *verifiable.Credential) error {
+	recordBytes, err := json.Marshal(&record{
+		ID:             id,
+		Context:        vc.Context,
+		Type:           vc.Types,
+		SubjectID:      getVCSubjectID(vc),
+		Issuer:         vc.Issuer.ID,
+		IssuanceDate:   getTime(vc.Issued),
+		ExpirationDate: getTime(vc.Expired),
+	})
 	if err != nil {
 		return fmt.Errorf("failed to prepare record: %w", err)
 	}
@@ -179,7 +304,8 @@ func (s *StoreImplementation) GetPresentation(id string) (*verifiable.Presentati
 		return nil, fmt.Errorf("failed to get vc: %w", err)
 	}
 
-	vp, err := verifiable.ParsePresentation(vpBytes, verifiable.WithPresDisabledProofCheck())
+	vp, err := verifiable.ParsePresentation(vpBytes, verifiable.WithPresDisabledProofCheck(),
+		verifiable.WithPresJSONLDDocumentLoader(s.documentLoader))
 	if err != nil {
 		return nil, fmt.Errorf("new presentation failed: %w", err)
 	}
@@ -247,6 +373,12 @@ func (s *StoreImplementation) RemoveCredentialByName(name string) error {
 		return fmt.Errorf("unable to delete credential : %w", err)
 	}
 
+	for _, key := range expiryNotifiedKeys(name) {
+		if err := s.store.Delete(key); err != nil {
+			return fmt.Errorf("unable to delete expiry notification state : %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -298,11 +430,14 @@ func (s *StoreImplementation) getAllRecords(searchKey string, keyPrefix func(str
 		}
 
 		record := &Record{
-			Name:      keyPrefix(string(itr.Key())),
-			ID:        r.ID,
-			Context:   r.Context,
-			Type:      r.Type,
-			SubjectID: r.SubjectID,
+			Name:           keyPrefix(string(itr.Key())),
+			ID:             r.ID,
+			Context:        r.Context,
+			Type:           r.Type,
+			SubjectID:      r.SubjectID,
+			Issuer:         r.Issuer,
+			IssuanceDate:   r.IssuanceDate,
+			ExpirationDate: r.ExpirationDate,
 		}
 
 		records = append(records, record)
@@ -319,8 +454,16 @@ func getVCSubjectID(vc *verifiable.Credential) string {
 	return ""
 }
 
+func getTime(t *util.TimeWithTrailingZeroMsec) *time.Time {
+	if t == nil {
+		return nil
+	}
+
+	return &t.Time
+}
+
 func getRecord(id, subjectID string, contexts, types []string) ([]byte, error) {
-	recordBytes, err := json.Marshal(&record{id, contexts, types, subjectID})
+	recordBytes, err := json.Marshal(&record{ID: id, Context: contexts, Type: types, SubjectID: subjectID})
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal vc record: %w", err)
 	}
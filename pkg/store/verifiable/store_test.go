@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
 	mockstore "github.com/hyperledger/aries-framework-go/pkg/mock/storage"
@@ -292,6 +294,105 @@ func TestSaveVC(t *testing.T) {
 	})
 }
 
+func TestUpdateVC(t *testing.T) {
+	t.Run("test update vc - success", func(t *testing.T) {
+		s, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, s.SaveCredential(sampleCredentialName, &verifiable.Credential{ID: "vc1"}))
+
+		issued := util.NewTime(time.Now())
+		require.NoError(t, s.UpdateCredential(sampleCredentialName, &verifiable.Credential{
+			ID:      "vc1",
+			Context: []string{baseContext},
+			Types:   []string{"VerifiableCredential"},
+			Issuer:  verifiable.Issuer{ID: "did:example:issuer"},
+			Subject: "did:example:subject",
+			Issued:  issued,
+		}))
+
+		vc, err := s.GetCredential("vc1")
+		require.NoError(t, err)
+		require.Equal(t, issued.Unix(), vc.Issued.Unix())
+
+		records, err := s.GetCredentials()
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, issued.Unix(), records[0].IssuanceDate.Unix())
+	})
+
+	t.Run("test update vc - id changed", func(t *testing.T) {
+		s, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, s.SaveCredential(sampleCredentialName, &verifiable.Credential{ID: "vc1"}))
+		require.NoError(t, s.UpdateCredential(sampleCredentialName, &verifiable.Credential{ID: "vc2"}))
+
+		_, err = s.GetCredential("vc1")
+		require.Error(t, err)
+
+		id, err := s.GetCredentialIDByName(sampleCredentialName)
+		require.NoError(t, err)
+		require.Equal(t, "vc2", id)
+	})
+
+	t.Run("test update vc - id of other stored credential", func(t *testing.T) {
+		s, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, s.SaveCredential(sampleCredentialName, &verifiable.Credential{ID: "vc1"}))
+		require.NoError(t, s.SaveCredential("other", &verifiable.Credential{ID: "vc2"}))
+
+		err = s.UpdateCredential(sampleCredentialName, &verifiable.Credential{ID: "vc2"})
+		require.EqualError(t, err, "credential ID vc2 belongs to another stored credential")
+
+		id, err := s.GetCredentialIDByName(sampleCredentialName)
+		require.NoError(t, err)
+		require.Equal(t, "vc1", id)
+
+		_, err = s.store.Get("vc1")
+		require.NoError(t, err)
+
+		id, err = s.GetCredentialIDByName("other")
+		require.NoError(t, err)
+		require.Equal(t, "vc2", id)
+	})
+
+	t.Run("test update vc - credential without id", func(t *testing.T) {
+		s, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+		require.NoError(t, s.SaveCredential(sampleCredentialName, &verifiable.Credential{}))
+
+		id, err := s.GetCredentialIDByName(sampleCredentialName)
+		require.NoError(t, err)
+
+		require.NoError(t, s.UpdateCredential(sampleCredentialName, &verifiable.Credential{}))
+
+		updatedID, err := s.GetCredentialIDByName(sampleCredentialName)
+		require.NoError(t, err)
+		require.Equal(t, id, updatedID)
+	})
+
+	t.Run("test update vc - name not found", func(t *testing.T) {
+		s, err := New(&mockprovider.Provider{
+			StorageProviderValue: mockstore.NewMockStoreProvider(),
+		})
+		require.NoError(t, err)
+
+		err = s.UpdateCredential(sampleCredentialName, &verifiable.Credential{ID: "vc1"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get credential id using name")
+
+		err = s.UpdateCredential("", &verifiable.Credential{ID: "vc1"})
+		require.EqualError(t, err, "credential name is mandatory")
+	})
+}
+
 func TestGetVC(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 		s, err := New(&mockprovider.Provider{
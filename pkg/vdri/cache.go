@@ -0,0 +1,319 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"container/list"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	// CacheNameSpace is the namespace of the persistent DID resolution cache store.
+	CacheNameSpace = "vdricache"
+
+	defaultCacheTTL  = 5 * time.Minute
+	defaultCacheSize = 1000
+
+	cacheKeyPrefix = "didres_"
+)
+
+var logger = log.New("aries-framework/vdri")
+
+// CacheOpt is the DID resolution cache option.
+type CacheOpt func(c *ResolutionCache)
+
+// WithDefaultCacheTTL sets time to live of the cached resolution results of DID methods
+// without their own TTL. Zero TTL disables caching of such methods.
+func WithDefaultCacheTTL(ttl time.Duration) CacheOpt {
+	return func(c *ResolutionCache) {
+		c.defaultTTL = ttl
+	}
+}
+
+// WithCacheTTL sets time to live of the cached resolution results of the given DID method.
+// Zero TTL disables caching of the method (e.g. for DID methods resolved locally).
+func WithCacheTTL(method string, ttl time.Duration) CacheOpt {
+	return func(c *ResolutionCache) {
+		c.methodTTL[method] = ttl
+	}
+}
+
+// WithCacheSize sets the maximum number of cached resolution results. When the cache is full,
+// the least recently used result is evicted.
+func WithCacheSize(size int) CacheOpt {
+	return func(c *ResolutionCache) {
+		c.size = size
+	}
+}
+
+// WithCacheStorage persists cached resolution results in the given storage, so they survive restarts.
+func WithCacheStorage(p storage.Provider) CacheOpt {
+	return func(c *ResolutionCache) {
+		c.storeProvider = p
+	}
+}
+
+// CacheMetrics holds the DID resolution cache statistics.
+type CacheMetrics struct {
+	Hits          uint64 `json:"hits"`
+	Misses        uint64 `json:"misses"`
+	Evictions     uint64 `json:"evictions"`
+	Expirations   uint64 `json:"expirations"`
+	Invalidations uint64 `json:"invalidations"`
+	Entries       int    `json:"entries"`
+}
+
+// ResolutionCache is the LRU cache of DID resolution results. Cached results are shared between callers
+// and must not be modified.
+type ResolutionCache struct {
+	defaultTTL    time.Duration
+	methodTTL     map[string]time.Duration
+	size          int
+	storeProvider storage.Provider
+	store         storage.Store
+	now           func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+	metrics CacheMetrics
+}
+
+type cacheEntry struct {
+	DID        string
+	Resolution *diddoc.DocResolution
+	Expires    time.Time
+}
+
+type storedCacheEntry struct {
+	Resolution json.RawMessage `json:"resolution"`
+	Expires    time.Time       `json:"expires"`
+}
+
+// NewResolutionCache returns a new DID resolution cache. Persisted results are loaded
+// if the cache is storage-backed.
+func NewResolutionCache(opts ...CacheOpt) (*ResolutionCache, error) {
+	c := &ResolutionCache{
+		defaultTTL: defaultCacheTTL,
+		methodTTL:  make(map[string]time.Duration),
+		size:       defaultCacheSize,
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.size <= 0 {
+		return nil, errors.New("cache size must be positive")
+	}
+
+	if c.storeProvider != nil {
+		store, err := c.storeProvider.OpenStore(CacheNameSpace)
+		if err != nil {
+			return nil, fmt.Errorf("open DID resolution cache store: %w", err)
+		}
+
+		c.store = store
+
+		if err := c.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// Get returns cached resolution result of the DID.
+func (c *ResolutionCache) Get(did string) (*diddoc.DocResolution, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[did]
+	if !ok {
+		c.metrics.Misses++
+
+		return nil, false
+	}
+
+	entry := elem.Value.(*cacheEntry) // nolint: errcheck
+
+	if !c.now().Before(entry.Expires) {
+		c.remove(elem)
+		c.metrics.Expirations++
+		c.metrics.Misses++
+
+		return nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	c.metrics.Hits++
+
+	return entry.Resolution, true
+}
+
+// Put caches resolution result of the DID for the TTL of its method.
+func (c *ResolutionCache) Put(did string, resolution *diddoc.DocResolution) {
+	method, err := getDidMethod(did)
+	if err != nil {
+		return
+	}
+
+	ttl := c.ttl(method)
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[did]; ok {
+		c.remove(elem)
+	}
+
+	entry := &cacheEntry{DID: did, Resolution: resolution, Expires: c.now().Add(ttl)}
+	c.entries[did] = c.lru.PushFront(entry)
+
+	c.persist(entry)
+
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+		c.metrics.Evictions++
+	}
+}
+
+// Invalidate removes cached resolution result of the DID, e.g. when the DID was updated.
+func (c *ResolutionCache) Invalidate(did string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[did]; ok {
+		c.remove(elem)
+		c.metrics.Invalidations++
+	}
+}
+
+// Purge removes all cached resolution results.
+func (c *ResolutionCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+		c.metrics.Invalidations++
+	}
+}
+
+// Metrics returns the cache statistics.
+func (c *ResolutionCache) Metrics() CacheMetrics {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	metrics := c.metrics
+	metrics.Entries = c.lru.Len()
+
+	return metrics
+}
+
+func (c *ResolutionCache) ttl(method string) time.Duration {
+	if ttl, ok := c.methodTTL[method]; ok {
+		return ttl
+	}
+
+	return c.defaultTTL
+}
+
+func (c *ResolutionCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*cacheEntry) // nolint: errcheck
+	delete(c.entries, entry.DID)
+
+	if c.store == nil {
+		return
+	}
+
+	if err := c.store.Delete(cacheKeyPrefix + entry.DID); err != nil && !errors.Is(err, storage.ErrDataNotFound) {
+		logger.Warnf("failed to delete cached resolution of %s: %s", entry.DID, err)
+	}
+}
+
+func (c *ResolutionCache) persist(entry *cacheEntry) {
+	if c.store == nil {
+		return
+	}
+
+	resolutionBytes, err := entry.Resolution.JSONBytes()
+	if err != nil {
+		logger.Warnf("failed to marshal cached resolution of %s: %s", entry.DID, err)
+
+		return
+	}
+
+	entryBytes, err := json.Marshal(&storedCacheEntry{Resolution: resolutionBytes, Expires: entry.Expires})
+	if err != nil {
+		logger.Warnf("failed to marshal cached resolution of %s: %s", entry.DID, err)
+
+		return
+	}
+
+	if err := c.store.Put(cacheKeyPrefix+entry.DID, entryBytes); err != nil {
+		logger.Warnf("failed to persist cached resolution of %s: %s", entry.DID, err)
+	}
+}
+
+func (c *ResolutionCache) load() error {
+	itr := c.store.Iterator(cacheKeyPrefix, cacheKeyPrefix+storage.EndKeySuffix)
+	defer itr.Release()
+
+	var stale []string
+
+	for itr.Next() {
+		did := string(itr.Key())[len(cacheKeyPrefix):]
+
+		var stored storedCacheEntry
+
+		if err := json.Unmarshal(itr.Value(), &stored); err != nil || !c.now().Before(stored.Expires) {
+			stale = append(stale, did)
+
+			continue
+		}
+
+		resolution, err := diddoc.ParseDocumentResolution(stored.Resolution)
+		if err != nil {
+			stale = append(stale, did)
+
+			continue
+		}
+
+		c.entries[did] = c.lru.PushBack(&cacheEntry{DID: did, Resolution: resolution, Expires: stored.Expires})
+	}
+
+	if itr.Error() != nil {
+		return fmt.Errorf("load DID resolution cache: %w", itr.Error())
+	}
+
+	for _, did := range stale {
+		if err := c.store.Delete(cacheKeyPrefix + did); err != nil {
+			logger.Warnf("failed to delete stale cached resolution of %s: %s", did, err)
+		}
+	}
+
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+
+	return nil
+}
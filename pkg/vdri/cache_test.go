@@ -0,0 +1,223 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+func TestNewResolutionCache(t *testing.T) {
+	t.Run("test defaults", func(t *testing.T) {
+		cache, err := NewResolutionCache()
+		require.NoError(t, err)
+		require.Equal(t, defaultCacheTTL, cache.defaultTTL)
+		require.Equal(t, defaultCacheSize, cache.size)
+		require.Nil(t, cache.store)
+	})
+
+	t.Run("test invalid size", func(t *testing.T) {
+		_, err := NewResolutionCache(WithCacheSize(0))
+		require.EqualError(t, err, "cache size must be positive")
+	})
+
+	t.Run("test open store error", func(t *testing.T) {
+		_, err := NewResolutionCache(WithCacheStorage(&storage.MockStoreProvider{
+			ErrOpenStoreHandle: errors.New("open error"),
+		}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open error")
+	})
+
+	t.Run("test load error", func(t *testing.T) {
+		_, err := NewResolutionCache(WithCacheStorage(&storage.MockStoreProvider{Store: &storage.MockStore{
+			Store:  make(map[string][]byte),
+			ErrItr: errors.New("iterator error"),
+		}}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "iterator error")
+	})
+}
+
+func TestResolutionCache(t *testing.T) {
+	t.Run("test get and put", func(t *testing.T) {
+		cache, err := NewResolutionCache()
+		require.NoError(t, err)
+
+		_, ok := cache.Get("did:example:1")
+		require.False(t, ok)
+
+		resolution := did.NewDocResolution(&did.Doc{ID: "did:example:1"})
+		cache.Put("did:example:1", resolution)
+
+		cached, ok := cache.Get("did:example:1")
+		require.True(t, ok)
+		require.Equal(t, resolution, cached)
+
+		require.Equal(t, CacheMetrics{Hits: 1, Misses: 1, Entries: 1}, cache.Metrics())
+	})
+
+	t.Run("test method TTL", func(t *testing.T) {
+		now := time.Now()
+
+		cache, err := NewResolutionCache(WithDefaultCacheTTL(time.Minute), WithCacheTTL("example", time.Hour),
+			WithCacheTTL("peer", 0))
+		require.NoError(t, err)
+
+		cache.now = func() time.Time { return now }
+
+		cache.Put("did:example:1", did.NewDocResolution(&did.Doc{ID: "did:example:1"}))
+		cache.Put("did:other:1", did.NewDocResolution(&did.Doc{ID: "did:other:1"}))
+		cache.Put("did:peer:1", did.NewDocResolution(&did.Doc{ID: "did:peer:1"}))
+		cache.Put("invalid", did.NewDocResolution(&did.Doc{ID: "invalid"}))
+		require.Equal(t, 2, cache.Metrics().Entries)
+
+		cache.now = func() time.Time { return now.Add(2 * time.Minute) }
+
+		_, ok := cache.Get("did:other:1")
+		require.False(t, ok)
+
+		_, ok = cache.Get("did:example:1")
+		require.True(t, ok)
+
+		cache.now = func() time.Time { return now.Add(time.Hour) }
+
+		_, ok = cache.Get("did:example:1")
+		require.False(t, ok)
+
+		metrics := cache.Metrics()
+		require.Equal(t, uint64(2), metrics.Expirations)
+		require.Equal(t, 0, metrics.Entries)
+	})
+
+	t.Run("test size bound", func(t *testing.T) {
+		cache, err := NewResolutionCache(WithCacheSize(2))
+		require.NoError(t, err)
+
+		for i := 1; i <= 3; i++ {
+			didID := fmt.Sprintf("did:example:%d", i)
+			cache.Put(didID, did.NewDocResolution(&did.Doc{ID: didID}))
+
+			if i == 2 {
+				// did:example:1 becomes the most recently used
+				_, ok := cache.Get("did:example:1")
+				require.True(t, ok)
+			}
+		}
+
+		_, ok := cache.Get("did:example:2")
+		require.False(t, ok)
+
+		_, ok = cache.Get("did:example:1")
+		require.True(t, ok)
+
+		_, ok = cache.Get("did:example:3")
+		require.True(t, ok)
+
+		metrics := cache.Metrics()
+		require.Equal(t, uint64(1), metrics.Evictions)
+		require.Equal(t, 2, metrics.Entries)
+	})
+
+	t.Run("test invalidate and purge", func(t *testing.T) {
+		cache, err := NewResolutionCache()
+		require.NoError(t, err)
+
+		cache.Put("did:example:1", did.NewDocResolution(&did.Doc{ID: "did:example:1"}))
+		cache.Put("did:example:1", did.NewDocResolution(&did.Doc{ID: "did:example:1"}))
+		cache.Put("did:example:2", did.NewDocResolution(&did.Doc{ID: "did:example:2"}))
+		require.Equal(t, 2, cache.Metrics().Entries)
+
+		cache.Invalidate("did:example:1")
+		cache.Invalidate("did:example:3")
+
+		_, ok := cache.Get("did:example:1")
+		require.False(t, ok)
+
+		cache.Purge()
+
+		_, ok = cache.Get("did:example:2")
+		require.False(t, ok)
+
+		metrics := cache.Metrics()
+		require.Equal(t, uint64(2), metrics.Invalidations)
+		require.Equal(t, 0, metrics.Entries)
+	})
+}
+
+func TestResolutionCache_Storage(t *testing.T) {
+	t.Run("test persisted across restarts", func(t *testing.T) {
+		now := time.Now().Add(-time.Hour)
+		provider := storage.NewMockStoreProvider()
+
+		cache, err := NewResolutionCache(WithCacheStorage(provider), WithCacheSize(2),
+			WithCacheTTL("expiring", time.Second))
+		require.NoError(t, err)
+
+		cache.now = func() time.Time { return now }
+
+		for _, didID := range []string{"did:example:1", "did:example:2", "did:example:3", "did:expiring:1"} {
+			cache.Put(didID, did.NewDocResolution(&did.Doc{ID: didID, Context: []string{"https://w3id.org/did/v1"}}))
+		}
+
+		cache.Put("did:example:3", did.NewDocResolution(&did.Doc{ID: "did:example:3",
+			Context: []string{"https://w3id.org/did/v1"}}))
+
+		cache.Invalidate("did:example:3")
+		require.Len(t, provider.Store.Store, 1)
+
+		// stale and invalid entries are removed on load
+		provider.Store.Store[cacheKeyPrefix+"did:example:4"] = []byte("{")
+		provider.Store.Store[cacheKeyPrefix+"did:example:5"] = []byte(`{"resolution": "invalid",` +
+			`"expires": "` + time.Now().Add(time.Hour).Format(time.RFC3339) + `"}`)
+
+		restarted, err := NewResolutionCache(WithCacheStorage(provider))
+		require.NoError(t, err)
+
+		require.Equal(t, 0, restarted.Metrics().Entries)
+		require.Empty(t, provider.Store.Store)
+
+		resolution, ok := restarted.Get("did:expiring:1")
+		require.False(t, ok)
+		require.Nil(t, resolution)
+
+		restarted.Put("did:example:1", did.NewDocResolution(&did.Doc{ID: "did:example:1",
+			Context: []string{"https://w3id.org/did/v1"}}))
+
+		restarted, err = NewResolutionCache(WithCacheStorage(provider))
+		require.NoError(t, err)
+
+		resolution, ok = restarted.Get("did:example:1")
+		require.True(t, ok)
+		require.Equal(t, "did:example:1", resolution.DIDDocument.ID)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+	})
+
+	t.Run("test size bound on load", func(t *testing.T) {
+		provider := storage.NewMockStoreProvider()
+
+		cache, err := NewResolutionCache(WithCacheStorage(provider))
+		require.NoError(t, err)
+
+		for i := 1; i <= 3; i++ {
+			didID := fmt.Sprintf("did:example:%d", i)
+			cache.Put(didID, did.NewDocResolution(&did.Doc{ID: didID, Context: []string{"https://w3id.org/did/v1"}}))
+		}
+
+		restarted, err := NewResolutionCache(WithCacheStorage(provider), WithCacheSize(2))
+		require.NoError(t, err)
+		require.Equal(t, 2, restarted.Metrics().Entries)
+		require.Len(t, provider.Store.Store, 2)
+	})
+}
@@ -0,0 +1,314 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	diddoc "github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+// DID URL parameters (https://w3c.github.io/did-core/#did-parameters).
+const (
+	ServiceParam     = "service"
+	RelativeRefParam = "relativeRef"
+	VersionIDParam   = "versionId"
+	VersionTimeParam = "versionTime"
+
+	contentTypeURIList = "text/uri-list"
+)
+
+// ErrContentNotFound is returned when the DID document does not hold the content of the DID URL.
+var ErrContentNotFound = errors.New("DID URL content not found")
+
+// DereferenceResult is the DID URL dereferencing result
+// (https://w3c-ccg.github.io/did-resolution/#did-url-dereferencing). Depending on the DID URL, the content
+// is either the DID document, its verification method or service selected by the fragment, or
+// the service endpoint URL selected by the service parameter.
+type DereferenceResult struct {
+	Document              *diddoc.Doc
+	VerificationMethod    *diddoc.PublicKey
+	Service               *diddoc.Service
+	ServiceEndpoint       string
+	DereferencingMetadata *DereferencingMetadata
+	// ContentMetadata is the metadata of the DID document holding the content.
+	ContentMetadata *diddoc.DocumentMetadata
+}
+
+// DereferencingMetadata is the metadata of the DID URL dereferencing process.
+type DereferencingMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+	// Message is a human readable description of the error.
+	Message string `json:"message,omitempty"`
+}
+
+// Dereferencer dereferences DID URLs by resolving the DIDs with the vdri registry.
+type Dereferencer struct {
+	registry vdriapi.Registry
+}
+
+// NewDereferencer returns new DID URL dereferencer.
+func NewDereferencer(registry vdriapi.Registry) *Dereferencer {
+	return &Dereferencer{registry: registry}
+}
+
+// Dereference dereferences the DID URL. The versionId and versionTime parameters of the DID URL
+// are passed to the DID resolution. If dereferencing fails, the returned result holds the error code
+// in its dereferencing metadata.
+func (d *Dereferencer) Dereference(didURL string, opts ...vdriapi.ResolveOpts) (*DereferenceResult, error) {
+	parsed, err := diddoc.ParseDIDURL(didURL)
+	if err != nil {
+		return dereferencingError(diddoc.ResolutionErrorInvalidDIDURL, err), err
+	}
+
+	return d.dereferenceURL(parsed, opts...)
+}
+
+func (d *Dereferencer) dereferenceURL(parsed *diddoc.DIDURL,
+	opts ...vdriapi.ResolveOpts) (*DereferenceResult, error) {
+	if parsed.Path != "" {
+		err := fmt.Errorf("DID URL path %s: %w", parsed.Path, ErrContentNotFound)
+
+		return dereferencingError(diddoc.ResolutionErrorNotFound, err), err
+	}
+
+	resolveOpts, err := queryResolveOpts(parsed.Queries)
+	if err == nil && parsed.Queries.Get(RelativeRefParam) != "" && parsed.Queries.Get(ServiceParam) == "" {
+		err = errors.New("relativeRef parameter requires service parameter")
+	}
+
+	if err != nil {
+		return dereferencingError(diddoc.ResolutionErrorInvalidDIDURL, err), err
+	}
+
+	resolution, err := d.registry.ResolveWithMetadata(parsed.DID.String(), append(opts, resolveOpts...)...)
+	if err != nil {
+		code := diddoc.ResolutionErrorInternal
+		if resolution != nil && resolution.ResolutionMetadata != nil && resolution.ResolutionMetadata.Error != "" {
+			code = resolution.ResolutionMetadata.Error
+		}
+
+		return dereferencingError(code, err), err
+	}
+
+	result, err := dereference(resolution.DIDDocument, parsed)
+	if err != nil {
+		return dereferencingError(diddoc.ResolutionErrorNotFound, err), err
+	}
+
+	result.ContentMetadata = resolution.DocumentMetadata
+
+	return result, nil
+}
+
+func dereference(doc *diddoc.Doc, didURL *diddoc.DIDURL) (*DereferenceResult, error) {
+	if didURL.Queries.Get(ServiceParam) != "" {
+		endpoint, err := serviceEndpoint(doc, didURL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &DereferenceResult{
+			ServiceEndpoint:       endpoint,
+			DereferencingMetadata: &DereferencingMetadata{ContentType: contentTypeURIList},
+		}, nil
+	}
+
+	result := &DereferenceResult{
+		DereferencingMetadata: &DereferencingMetadata{ContentType: diddoc.ContentTypeDIDLDJSON},
+	}
+
+	if didURL.Fragment == "" {
+		result.Document = doc
+
+		return result, nil
+	}
+
+	if pk, ok := lookupVerificationMethod(doc, didURL.DID.String(), didURL.Fragment); ok {
+		result.VerificationMethod = pk
+
+		return result, nil
+	}
+
+	if svc, ok := lookupService(doc, didURL.DID.String(), didURL.Fragment); ok {
+		result.Service = svc
+
+		return result, nil
+	}
+
+	return nil, fmt.Errorf("fragment %s: %w", didURL.Fragment, ErrContentNotFound)
+}
+
+// serviceEndpoint returns endpoint URL of the service selected by the service parameter. The relativeRef
+// parameter is resolved against the endpoint URL and the fragment is appended to it.
+func serviceEndpoint(doc *diddoc.Doc, didURL *diddoc.DIDURL) (string, error) {
+	serviceID := didURL.Queries.Get(ServiceParam)
+
+	svc, ok := lookupService(doc, didURL.DID.String(), serviceID)
+	if !ok {
+		return "", fmt.Errorf("service %s: %w", serviceID, ErrContentNotFound)
+	}
+
+	endpoint, err := url.Parse(svc.ServiceEndpoint)
+	if err != nil {
+		return "", fmt.Errorf("service %s endpoint: %w", serviceID, err)
+	}
+
+	if relativeRef := didURL.Queries.Get(RelativeRefParam); relativeRef != "" {
+		ref, err := url.Parse(relativeRef)
+		if err != nil {
+			return "", fmt.Errorf("invalid relativeRef: %w", err)
+		}
+
+		endpoint = endpoint.ResolveReference(ref)
+	}
+
+	if didURL.Fragment != "" {
+		endpoint.Fragment = didURL.Fragment
+	}
+
+	return endpoint.String(), nil
+}
+
+// lookupVerificationMethod returns public key of the verification method referenced by the fragment
+// of the DID in any of the verification relationships.
+func lookupVerificationMethod(doc *diddoc.Doc, didID, fragment string) (*diddoc.PublicKey, bool) {
+	for i := range doc.PublicKey {
+		if matchesFragment(didID, doc.PublicKey[i].ID, fragment) {
+			return &doc.PublicKey[i], true
+		}
+	}
+
+	for _, methods := range doc.VerificationMethods() {
+		for i := range methods {
+			if matchesFragment(didID, methods[i].PublicKey.ID, fragment) {
+				return &methods[i].PublicKey, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func lookupService(doc *diddoc.Doc, didID, fragment string) (*diddoc.Service, bool) {
+	for i := range doc.Service {
+		if matchesFragment(didID, doc.Service[i].ID, fragment) {
+			return &doc.Service[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// matchesFragment checks if the absolute (did#fragment), relative (#fragment) or bare ID matches the fragment.
+func matchesFragment(didID, id, fragment string) bool {
+	return id == didID+"#"+fragment || id == "#"+fragment || id == fragment
+}
+
+func queryResolveOpts(queries url.Values) ([]vdriapi.ResolveOpts, error) {
+	var opts []vdriapi.ResolveOpts
+
+	if versionID := queries.Get(VersionIDParam); versionID != "" {
+		opts = append(opts, vdriapi.WithVersionID(versionID))
+	}
+
+	if versionTime := queries.Get(VersionTimeParam); versionTime != "" {
+		t, err := time.Parse(time.RFC3339, versionTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid versionTime: %w", err)
+		}
+
+		opts = append(opts, vdriapi.WithVersionTime(t))
+	}
+
+	return opts, nil
+}
+
+func dereferencingError(code string, err error) *DereferenceResult {
+	return &DereferenceResult{
+		DereferencingMetadata: &DereferencingMetadata{Error: code, Message: err.Error()},
+		ContentMetadata:       &diddoc.DocumentMetadata{},
+	}
+}
+
+// DereferenceKey dereferences the key ID of the DID and returns public key of the referenced verification method.
+// The key ID is either DID URL of the DID, relative DID URL (#key-1) or the fragment. The key ID may be empty
+// if the DID document has single public key. Key IDs of other DIDs are rejected. Keys of deactivated DIDs
+// are not dereferenced (vdriapi.ErrDeactivated is returned).
+func (d *Dereferencer) DereferenceKey(didID, keyID string) (*diddoc.PublicKey, error) {
+	keyURL, err := keyDIDURL(didID, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := d.dereferenceURL(keyURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.ContentMetadata != nil && result.ContentMetadata.Deactivated {
+		return nil, fmt.Errorf("key %s: %w", keyID, vdriapi.ErrDeactivated)
+	}
+
+	if result.VerificationMethod != nil {
+		return result.VerificationMethod, nil
+	}
+
+	if result.Document != nil && len(result.Document.PublicKey) == 1 {
+		return &result.Document.PublicKey[0], nil
+	}
+
+	return nil, fmt.Errorf("key %s: %w", keyID, ErrContentNotFound)
+}
+
+// keyDIDURL returns DID URL of the key ID. The DID is not validated against the generic DID syntax as it is
+// resolved by the registry which may accept it (e.g. method-specific syntax).
+func keyDIDURL(didID, keyID string) (*diddoc.DIDURL, error) {
+	parts := strings.SplitN(didID, ":", 3)
+	if len(parts) != 3 || parts[0] != "did" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid DID %s", didID)
+	}
+
+	keyURL := &diddoc.DIDURL{
+		DID:     diddoc.DID{Scheme: parts[0], Method: parts[1], MethodSpecificID: parts[2]},
+		Queries: url.Values{},
+	}
+
+	switch {
+	case keyID == "" || keyID == didID:
+		return keyURL, nil
+	case strings.HasPrefix(keyID, "#"):
+		keyURL.Fragment = keyID[1:]
+
+		return keyURL, nil
+	case strings.HasPrefix(keyID, didID+"#"):
+		keyURL.Fragment = keyID[len(didID)+1:]
+
+		return keyURL, nil
+	case !strings.HasPrefix(keyID, "did:"):
+		keyURL.Fragment = keyID
+
+		return keyURL, nil
+	}
+
+	parsed, err := diddoc.ParseDIDURL(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key ID %s: %w", keyID, err)
+	}
+
+	if parsed.DID.String() != didID {
+		return nil, fmt.Errorf("key %s does not belong to DID %s: %w", keyID, didID, ErrContentNotFound)
+	}
+
+	return parsed, nil
+}
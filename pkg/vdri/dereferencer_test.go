@@ -0,0 +1,228 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package vdri
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	mockvdri "github.com/hyperledger/aries-framework-go/pkg/mock/vdri"
+)
+
+const dereferencerDID = "did:example:123"
+
+func TestDereferencer_Dereference(t *testing.T) {
+	doc := did.BuildDoc()
+	doc.ID = dereferencerDID
+	doc.PublicKey = []did.PublicKey{
+		*did.NewPublicKeyFromBytes(dereferencerDID+"#key-1", "Ed25519VerificationKey2018", dereferencerDID, []byte("k1")),
+	}
+	doc.KeyAgreement = []did.VerificationMethod{*did.NewEmbeddedVerificationMethod(
+		did.NewPublicKeyFromBytes("#key-2", "X25519KeyAgreementKey2019", dereferencerDID, []byte("k2")),
+		did.KeyAgreement)}
+	doc.Service = []did.Service{{
+		ID:              dereferencerDID + "#agent",
+		Type:            "did-communication",
+		ServiceEndpoint: "https://agent.example.com/base/",
+	}}
+
+	var resolveOpts vdriapi.ResolveDIDOpts
+
+	registry := &mockvdri.MockVDRIRegistry{
+		ResolveWithMetadataFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+			resolveOpts = vdriapi.ResolveDIDOpts{}
+			for _, opt := range opts {
+				opt(&resolveOpts)
+			}
+
+			if didID != dereferencerDID {
+				return did.NewResolutionError(did.ResolutionErrorNotFound, vdriapi.ErrNotFound), vdriapi.ErrNotFound
+			}
+
+			return &did.DocResolution{
+				DIDDocument:      doc,
+				DocumentMetadata: &did.DocumentMetadata{VersionID: "1"},
+			}, nil
+		},
+	}
+
+	dereferencer := NewDereferencer(registry)
+
+	t.Run("test DID", func(t *testing.T) {
+		result, err := dereferencer.Dereference(dereferencerDID)
+		require.NoError(t, err)
+		require.Equal(t, doc, result.Document)
+		require.Equal(t, did.ContentTypeDIDLDJSON, result.DereferencingMetadata.ContentType)
+		require.Equal(t, "1", result.ContentMetadata.VersionID)
+	})
+
+	t.Run("test verification method", func(t *testing.T) {
+		result, err := dereferencer.Dereference(dereferencerDID + "#key-1")
+		require.NoError(t, err)
+		require.Nil(t, result.Document)
+		require.Equal(t, &doc.PublicKey[0], result.VerificationMethod)
+
+		// relative ID of the embedded verification method
+		result, err = dereferencer.Dereference(dereferencerDID + "#key-2")
+		require.NoError(t, err)
+		require.Equal(t, "X25519KeyAgreementKey2019", result.VerificationMethod.Type)
+	})
+
+	t.Run("test service", func(t *testing.T) {
+		result, err := dereferencer.Dereference(dereferencerDID + "#agent")
+		require.NoError(t, err)
+		require.Equal(t, &doc.Service[0], result.Service)
+	})
+
+	t.Run("test service endpoint", func(t *testing.T) {
+		result, err := dereferencer.Dereference(dereferencerDID + "?service=agent")
+		require.NoError(t, err)
+		require.Equal(t, "https://agent.example.com/base/", result.ServiceEndpoint)
+		require.Equal(t, "text/uri-list", result.DereferencingMetadata.ContentType)
+
+		result, err = dereferencer.Dereference(dereferencerDID + "?service=agent&relativeRef=inbox%3Fq%3D1#frag")
+		require.NoError(t, err)
+		require.Equal(t, "https://agent.example.com/base/inbox?q=1#frag", result.ServiceEndpoint)
+
+		result, err = dereferencer.Dereference(dereferencerDID + "?service=agent&relativeRef=%2Finbox")
+		require.NoError(t, err)
+		require.Equal(t, "https://agent.example.com/inbox", result.ServiceEndpoint)
+	})
+
+	t.Run("test version parameters", func(t *testing.T) {
+		_, err := dereferencer.Dereference(dereferencerDID + "?versionId=2&versionTime=2020-10-01T10:00:00Z")
+		require.NoError(t, err)
+		require.Equal(t, "2", resolveOpts.VersionID)
+		require.Equal(t, "2020-10-01T10:00:00Z", resolveOpts.VersionTime)
+	})
+
+	t.Run("test invalid DID URL", func(t *testing.T) {
+		tests := map[string]string{
+			"example:123":                          "invalidDidUrl",
+			dereferencerDID + "?versionTime=today": "invalidDidUrl",
+			dereferencerDID + "?relativeRef=/x":    "invalidDidUrl",
+			dereferencerDID + "?%zz":               "invalidDidUrl",
+			dereferencerDID + "/path":              "notFound",
+			dereferencerDID + "#key-3":             "notFound",
+			dereferencerDID + "?service=other":     "notFound",
+			"did:example:456#key-1":                "notFound",
+		}
+
+		for didURL, code := range tests {
+			result, err := dereferencer.Dereference(didURL)
+			require.Error(t, err, didURL)
+			require.Equal(t, code, result.DereferencingMetadata.Error, didURL)
+			require.Equal(t, err.Error(), result.DereferencingMetadata.Message, didURL)
+		}
+
+		_, err := dereferencer.Dereference(dereferencerDID + "#key-3")
+		require.True(t, errors.Is(err, ErrContentNotFound))
+	})
+
+	t.Run("test resolution error", func(t *testing.T) {
+		d := NewDereferencer(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				return nil, errors.New("resolve error")
+			},
+		})
+
+		result, err := d.Dereference(dereferencerDID)
+		require.EqualError(t, err, "resolve error")
+		require.Equal(t, did.ResolutionErrorInternal, result.DereferencingMetadata.Error)
+		require.False(t, errors.Is(err, ErrContentNotFound))
+	})
+}
+
+func TestDereferencer_DereferenceKey(t *testing.T) {
+	doc := did.BuildDoc(did.WithPublicKey([]did.PublicKey{
+		*did.NewPublicKeyFromBytes(dereferencerDID+"#key-1", "Ed25519VerificationKey2018", dereferencerDID, []byte("k1")),
+	}))
+	doc.ID = dereferencerDID
+	doc.Service = []did.Service{{ID: dereferencerDID + "#agent", Type: "did-communication"}}
+
+	dereferencer := NewDereferencer(&mockvdri.MockVDRIRegistry{ResolveValue: doc})
+
+	for _, keyID := range []string{"key-1", "#key-1", dereferencerDID + "#key-1", dereferencerDID, ""} {
+		pk, err := dereferencer.DereferenceKey(dereferencerDID, keyID)
+		require.NoError(t, err, keyID)
+		require.Equal(t, &doc.PublicKey[0], pk)
+	}
+
+	for _, keyID := range []string{"key-2", "agent", "did:example:456#key-1", "did:example:123456#key-1"} {
+		_, err := dereferencer.DereferenceKey(dereferencerDID, keyID)
+		require.True(t, errors.Is(err, ErrContentNotFound), keyID)
+	}
+
+	_, err := dereferencer.DereferenceKey(dereferencerDID, "did:example")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid key ID")
+
+	// the key ID is required if the document has many keys
+	doc.PublicKey = append(doc.PublicKey, doc.PublicKey[0])
+
+	_, err = dereferencer.DereferenceKey(dereferencerDID, "")
+	require.True(t, errors.Is(err, ErrContentNotFound))
+
+	// keys of deactivated DID are not dereferenced
+	dereferencer = NewDereferencer(&mockvdri.MockVDRIRegistry{
+		ResolveWithMetadataFunc: func(string, ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+			return &did.DocResolution{
+				DIDDocument:      doc,
+				DocumentMetadata: &did.DocumentMetadata{Deactivated: true},
+			}, nil
+		},
+	})
+
+	_, err = dereferencer.DereferenceKey(dereferencerDID, "key-1")
+	require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+
+	// the deactivated document is still dereferenced along with its metadata
+	result, err := dereferencer.Dereference(dereferencerDID + "#key-1")
+	require.NoError(t, err)
+	require.True(t, result.ContentMetadata.Deactivated)
+}
+
+func TestDereferencer_DereferenceKeyOfMethodSpecificDID(t *testing.T) {
+	for _, didID := range []string{
+		"did:web:example.com%3A8443:user:alice",
+		// accepted by the DID method, but not the generic DID syntax
+		"did:trustbloc:testnet.trustbloc.local:EiAzdTbGPXhvC0ESOcnlR7hBfGpBA4nt2dOGnUHA2w2kLA==",
+	} {
+		doc := did.BuildDoc(did.WithPublicKey([]did.PublicKey{
+			*did.NewPublicKeyFromBytes(didID+"#key-1", "Ed25519VerificationKey2018", didID, []byte("k1")),
+		}))
+		doc.ID = didID
+
+		var resolvedDID string
+
+		dereferencer := NewDereferencer(&mockvdri.MockVDRIRegistry{
+			ResolveWithMetadataFunc: func(id string, _ ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				resolvedDID = id
+
+				return &did.DocResolution{DIDDocument: doc}, nil
+			},
+		})
+
+		for _, keyID := range []string{"key-1", "#key-1", didID + "#key-1"} {
+			pk, err := dereferencer.DereferenceKey(didID, keyID)
+			require.NoError(t, err, keyID)
+			require.Equal(t, &doc.PublicKey[0], pk)
+			require.Equal(t, didID, resolvedDID)
+		}
+
+		_, err := dereferencer.DereferenceKey(didID, "key-2")
+		require.True(t, errors.Is(err, ErrContentNotFound))
+	}
+
+	_, err := NewDereferencer(&mockvdri.MockVDRIRegistry{}).DereferenceKey("did:example", "key-1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid DID did:example")
+}
@@ -6,7 +6,6 @@ SPDX-License-Identifier: Apache-2.0
 package httpbinding
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -20,23 +19,26 @@ import (
 
 const (
 	didLDJson = "application/did+ld+json"
-)
+	// didResolutionLDJson is the content type of the DID resolution result returned by Universal Resolver.
+	didResolutionLDJson = `application/ld+json;profile="https://w3id.org/did-resolution"`
+	ldJSON              = "application/ld+json"
 
-type didResolution struct {
-	Context          interface{}            `json:"@context"`
-	DIDDocument      map[string]interface{} `json:"didDocument"`
-	ResolverMetadata map[string]interface{} `json:"resolverMetadata"`
-	MethodMetadata   map[string]interface{} `json:"methodMetadata"`
-}
+	versionIDParam   = "versionId"
+	versionTimeParam = "versionTime"
+)
 
 // resolveDID makes DID resolution via HTTP.
-func (v *VDRI) resolveDID(uri string) ([]byte, error) {
+func (v *VDRI) resolveDID(uri string, noCache bool) ([]byte, error) {
 	req, err := http.NewRequest(http.MethodGet, uri, nil)
 	if err != nil {
 		return nil, fmt.Errorf("HTTP create get request failed: %w", err)
 	}
 
-	req.Header.Add("Accept", didLDJson)
+	req.Header.Add("Accept", didResolutionLDJson+", "+didLDJson)
+
+	if noCache {
+		req.Header.Add("Cache-Control", "no-cache")
+	}
 
 	if v.resolveAuthToken != "" {
 		req.Header.Add("Authorization", v.resolveAuthToken)
@@ -56,18 +58,29 @@ func (v *VDRI) resolveDID(uri string) ([]byte, error) {
 		return nil, fmt.Errorf("reading response body failed: %w", err)
 	}
 
-	if resp.StatusCode == http.StatusOK && strings.Contains(resp.Header.Get("Content-type"), didLDJson) {
+	contentType := resp.Header.Get("Content-type")
+
+	if resp.StatusCode == http.StatusOK &&
+		(strings.Contains(contentType, didLDJson) || strings.Contains(contentType, ldJSON)) {
 		return gotBody, nil
 	} else if resp.StatusCode == http.StatusNotFound {
-		return nil, fmt.Errorf("DID does not exist for request: %s", uri)
+		return nil, fmt.Errorf("%w: DID does not exist for request: %s", vdriapi.ErrNotFound, uri)
 	}
 
 	return nil, fmt.Errorf("unsupported response from DID resolver [%v] header [%s] body [%s]",
-		resp.StatusCode, resp.Header.Get("Content-type"), gotBody)
+		resp.StatusCode, contentType, gotBody)
 }
 
 // Read implements didresolver.DidMethod.Read interface (https://w3c-ccg.github.io/did-resolution/#resolving-input)
-func (v *VDRI) Read(didID string, _ ...vdriapi.ResolveOpts) (*did.Doc, error) {
+// The resolver may return either a DID document or a DID resolution result, the resolution and document
+// metadata of the latter are returned to the caller.
+func (v *VDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+	resolveOpts := &vdriapi.ResolveDIDOpts{}
+
+	for _, opt := range opts {
+		opt(resolveOpts)
+	}
+
 	reqURL, err := url.ParseRequestURI(v.endpointURL)
 	if err != nil {
 		return nil, fmt.Errorf("url parse request uri failed: %w", err)
@@ -75,7 +88,19 @@ func (v *VDRI) Read(didID string, _ ...vdriapi.ResolveOpts) (*did.Doc, error) {
 
 	reqURL.Path = path.Join(reqURL.Path, didID)
 
-	data, err := v.resolveDID(reqURL.String())
+	query := reqURL.Query()
+
+	if resolveOpts.VersionID != nil {
+		query.Set(versionIDParam, fmt.Sprint(resolveOpts.VersionID))
+	}
+
+	if resolveOpts.VersionTime != "" {
+		query.Set(versionTimeParam, resolveOpts.VersionTime)
+	}
+
+	reqURL.RawQuery = query.Encode()
+
+	data, err := v.resolveDID(reqURL.String(), resolveOpts.NoCache)
 	if err != nil {
 		return nil, err
 	}
@@ -84,21 +109,18 @@ func (v *VDRI) Read(didID string, _ ...vdriapi.ResolveOpts) (*did.Doc, error) {
 		return nil, vdriapi.ErrNotFound
 	}
 
-	var r didResolution
-	if err := json.Unmarshal(data, &r); err != nil {
-		return nil, fmt.Errorf("unmarshal data return from http binding resolver %w", err)
+	resolution, err := did.ParseDocumentResolution(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse data returned from http binding resolver: %w", err)
 	}
 
-	didDocBytes := data
-	// check if data is did resolution
-	if len(r.DIDDocument) != 0 {
-		var err error
+	if resolution.ResolutionMetadata.Error == did.ResolutionErrorNotFound {
+		return nil, fmt.Errorf("%w: %s", vdriapi.ErrNotFound, didID)
+	}
 
-		didDocBytes, err = json.Marshal(r.DIDDocument)
-		if err != nil {
-			return nil, fmt.Errorf("marshal data from did resolution did doc %w", err)
-		}
+	if err = resolution.Error(); err != nil {
+		return nil, fmt.Errorf("http binding resolver: %w", err)
 	}
 
-	return did.ParseDocument(didDocBytes)
+	return resolution, nil
 }
@@ -146,7 +146,7 @@ func TestRead_DIDDoc(t *testing.T) {
 		require.NoError(t, err)
 		didDoc, err := did.ParseDocument([]byte(doc))
 		require.NoError(t, err)
-		require.Equal(t, didDoc.ID, gotDocument.ID)
+		require.Equal(t, didDoc.ID, gotDocument.DIDDocument.ID)
 	})
 
 	t.Run("test success return did resolution", func(t *testing.T) {
@@ -166,7 +166,7 @@ func TestRead_DIDDoc(t *testing.T) {
 		require.NoError(t, err)
 		didDoc, err := did.ParseDocument([]byte(doc))
 		require.NoError(t, err)
-		require.Equal(t, didDoc.ID, gotDocument.ID)
+		require.Equal(t, didDoc.ID, gotDocument.DIDDocument.ID)
 	})
 	t.Run("test empty doc", func(t *testing.T) {
 		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
@@ -204,7 +204,7 @@ func TestRead_DIDDocWithBasePath(t *testing.T) {
 	require.NoError(t, err)
 	didDoc, err := did.ParseDocument([]byte(doc))
 	require.NoError(t, err)
-	require.Equal(t, didDoc.ID, gotDocument.ID)
+	require.Equal(t, didDoc.ID, gotDocument.DIDDocument.ID)
 }
 
 func TestRead_DIDDocWithBasePathWithSlashes(t *testing.T) {
@@ -224,7 +224,7 @@ func TestRead_DIDDocWithBasePathWithSlashes(t *testing.T) {
 	require.NoError(t, err)
 	didDoc, err := did.ParseDocument([]byte(doc))
 	require.NoError(t, err)
-	require.Equal(t, didDoc.ID, gotDocument.ID)
+	require.Equal(t, didDoc.ID, gotDocument.DIDDocument.ID)
 }
 
 func TestRead_DIDDocNotFound(t *testing.T) {
@@ -242,6 +242,117 @@ func TestRead_DIDDocNotFound(t *testing.T) {
 	_, err = resolver.Read("did:example:334455")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "DID does not exist")
+	require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+}
+
+func TestRead_DIDResolutionResult(t *testing.T) {
+	const resolutionResult = `{
+  "@context": "https://w3id.org/did-resolution/v1",
+  "didDocument": ` + doc + `,
+  "didResolutionMetadata": {
+    "contentType": "application/did+ld+json",
+    "duration": 12
+  },
+  "didDocumentMetadata": {
+    "created": "2020-06-01T19:23:24Z",
+    "updated": "2020-07-01T19:23:24Z",
+    "versionId": "2",
+    "method": {
+      "published": true
+    }
+  }
+}`
+
+	t.Run("test success", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			require.Equal(t, "/did:example:334455?versionId=2&versionTime=2020-07-01T19%3A23%3A24Z",
+				req.URL.String())
+			require.Equal(t, "no-cache", req.Header.Get("Cache-Control"))
+			res.Header().Add("Content-type", `application/ld+json;profile="https://w3id.org/did-resolution"`)
+			res.WriteHeader(http.StatusOK)
+			_, err := res.Write([]byte(resolutionResult))
+			require.NoError(t, err)
+		}))
+
+		defer func() { testServer.Close() }()
+
+		resolver, err := New(testServer.URL)
+		require.NoError(t, err)
+
+		versionTime, err := time.Parse(time.RFC3339, "2020-07-01T19:23:24Z")
+		require.NoError(t, err)
+
+		resolution, err := resolver.Read("did:example:334455", vdriapi.WithVersionID(2),
+			vdriapi.WithVersionTime(versionTime), vdriapi.WithNoCache(true))
+		require.NoError(t, err)
+		require.Equal(t, "did:peer:21tDAKCERh95uGgKbJNHYp", resolution.DIDDocument.ID)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.Equal(t, "2", resolution.DocumentMetadata.VersionID)
+		require.Equal(t, versionTime, resolution.DocumentMetadata.Updated.UTC())
+		require.NotNil(t, resolution.DocumentMetadata.Created)
+		require.Equal(t, true, resolution.DocumentMetadata.Method["published"])
+	})
+
+	t.Run("test legacy method metadata", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Add("Content-type", "application/did+ld+json")
+			res.WriteHeader(http.StatusOK)
+			_, err := res.Write([]byte(didResolutionData))
+			require.NoError(t, err)
+		}))
+
+		defer func() { testServer.Close() }()
+
+		resolver, err := New(testServer.URL)
+		require.NoError(t, err)
+
+		resolution, err := resolver.Read("did:example:334455")
+		require.NoError(t, err)
+		require.Contains(t, resolution.DocumentMetadata.Method, "nymResponse")
+	})
+
+	t.Run("test resolution error", func(t *testing.T) {
+		resolutionError := ""
+
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Add("Content-type", "application/ld+json")
+			res.WriteHeader(http.StatusOK)
+			_, err := res.Write([]byte(`{"didDocument": null, "didResolutionMetadata": {"error": "` +
+				resolutionError + `"}}`))
+			require.NoError(t, err)
+		}))
+
+		defer func() { testServer.Close() }()
+
+		resolver, err := New(testServer.URL)
+		require.NoError(t, err)
+
+		resolutionError = did.ResolutionErrorNotFound
+		_, err = resolver.Read("did:example:334455")
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+
+		resolutionError = did.ResolutionErrorInvalidDID
+		_, err = resolver.Read("did:example:334455")
+		require.EqualError(t, err, "http binding resolver: invalidDid")
+	})
+
+	t.Run("test invalid resolution result", func(t *testing.T) {
+		testServer := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Add("Content-type", "application/did+ld+json")
+			res.WriteHeader(http.StatusOK)
+			_, err := res.Write([]byte(`{"didDocument": "invalid"}`))
+			require.NoError(t, err)
+		}))
+
+		defer func() { testServer.Close() }()
+
+		resolver, err := New(testServer.URL)
+		require.NoError(t, err)
+
+		_, err = resolver.Read("did:example:334455")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse data returned from http binding resolver")
+	})
 }
 
 func TestRead_UnsupportedStatus(t *testing.T) {
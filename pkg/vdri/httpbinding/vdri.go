@@ -62,6 +62,16 @@ func (v *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
 	return nil
 }
 
+// Update is not supported by http binding vdri.
+func (v *VDRI) Update(didID string, _ []vdriapi.Patch, _ *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+	return nil, fmt.Errorf("update %s in http binding vdri: %w", didID, vdriapi.ErrOperationNotSupported)
+}
+
+// Deactivate is not supported by http binding vdri.
+func (v *VDRI) Deactivate(didID string, _ *[]vdriapi.ModifiedBy) error {
+	return fmt.Errorf("deactivate %s in http binding vdri: %w", didID, vdriapi.ErrOperationNotSupported)
+}
+
 // Build did doc.
 func (v *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error) {
 	return nil, fmt.Errorf("build not supported in http binding vdri")
@@ -7,9 +7,12 @@ SPDX-License-Identifier: Apache-2.0
 package httpbinding
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 func TestVDRI_Close(t *testing.T) {
@@ -32,3 +35,14 @@ func TestVDRI_Build(t *testing.T) {
 		require.Nil(t, result)
 	})
 }
+
+func TestVDRI_UpdateAndDeactivate(t *testing.T) {
+	v, err := New("/did:example:334455")
+	require.NoError(t, err)
+
+	_, err = v.Update("did:example:334455", nil, nil)
+	require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+
+	err = v.Deactivate("did:example:334455", nil)
+	require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+}
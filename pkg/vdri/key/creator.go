@@ -19,34 +19,42 @@ import (
 )
 
 const (
-	schemaV1                   = "https://w3id.org/did/v1"
-	ed25519VerificationKey2018 = "Ed25519VerificationKey2018"
-	x25519KeyAgreementKey2019  = "X25519KeyAgreementKey2019"
+	schemaV1                          = "https://w3id.org/did/v1"
+	ed25519VerificationKey2018        = "Ed25519VerificationKey2018"
+	x25519KeyAgreementKey2019         = "X25519KeyAgreementKey2019"
+	ecdsaSecp256k1VerificationKey2019 = "EcdsaSecp256k1VerificationKey2019"
+	bls12381G2Key2020                 = "Bls12381G2Key2020"
+	jsonWebKey2020                    = "JsonWebKey2020"
 )
 
 const (
-	ed25519pub = 0xed // Ed25519 public key in multicodec table
-	x25519pub  = 0xec // Curve25519 public key in multicodec table
+	ed25519pub    = 0xed   // Ed25519 public key in multicodec table
+	x25519pub     = 0xec   // Curve25519 public key in multicodec table
+	secp256k1pub  = 0xe7   // Secp256k1 compressed public key in multicodec table
+	bls12381g2pub = 0xeb   // BLS12-381 G2 public key in multicodec table
+	p256pub       = 0x1200 // P-256 compressed public key in multicodec table
+	p384pub       = 0x1201 // P-384 compressed public key in multicodec table
 )
 
-// Build builds new DID document.
+// Build builds new DID document. Supported public key types are Ed25519VerificationKey2018,
+// X25519KeyAgreementKey2019, EcdsaSecp256k1VerificationKey2019, Bls12381G2Key2020 and
+// JsonWebKey2020 (P-256 and P-384 points).
 func (v *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error) {
-	if pubKey.Type != ed25519VerificationKey2018 {
-		return nil, fmt.Errorf("not supported public key type: %s", pubKey.Type)
+	code, value, err := fingerprintKey(pubKey.Type, base58.Decode(pubKey.Value))
+	if err != nil {
+		return nil, err
 	}
 
-	return createDoc(base58.Decode(pubKey.Value))
+	return createDoc(code, value)
 }
 
 //nolint:lll
-func createDoc(pubKeyValue []byte) (*did.Doc, error) {
-	methodID := keyFingerprint(multicodec(ed25519pub), pubKeyValue)
+func createDoc(code uint64, pubKeyValue []byte) (*did.Doc, error) {
+	methodID := keyFingerprint(multicodec(code), pubKeyValue)
 	didKey := fmt.Sprintf("did:key:%s", methodID)
 	keyID := fmt.Sprintf("%s#%s", didKey, methodID)
 
-	pubKey := did.NewPublicKeyFromBytes(keyID, ed25519VerificationKey2018, didKey, pubKeyValue)
-
-	keyAgreement, err := keyAgreement(didKey, pubKeyValue)
+	pubKey, err := verificationKey(code, keyID, didKey, pubKeyValue)
 	if err != nil {
 		return nil, err
 	}
@@ -54,18 +62,37 @@ func createDoc(pubKeyValue []byte) (*did.Doc, error) {
 	// Created/Updated time
 	t := time.Now()
 
-	return &did.Doc{
-		Context:              []string{schemaV1},
-		ID:                   didKey,
-		PublicKey:            []did.PublicKey{*pubKey},
-		Authentication:       []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.Authentication, false)},
-		AssertionMethod:      []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.AssertionMethod, false)},
-		CapabilityDelegation: []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.CapabilityDelegation, false)},
-		CapabilityInvocation: []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.CapabilityInvocation, false)},
-		KeyAgreement:         []did.VerificationMethod{*did.NewEmbeddedVerificationMethod(keyAgreement, did.KeyAgreement)},
-		Created:              &t,
-		Updated:              &t,
-	}, nil
+	doc := &did.Doc{
+		Context:   []string{schemaV1},
+		ID:        didKey,
+		PublicKey: []did.PublicKey{*pubKey},
+		Created:   &t,
+		Updated:   &t,
+	}
+
+	// X25519 key is used for key agreement only
+	if code == x25519pub {
+		doc.KeyAgreement = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.KeyAgreement, false)}
+
+		return doc, nil
+	}
+
+	doc.Authentication = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.Authentication, false)}
+	doc.AssertionMethod = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.AssertionMethod, false)}
+	doc.CapabilityDelegation = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.CapabilityDelegation, false)}
+	doc.CapabilityInvocation = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.CapabilityInvocation, false)}
+
+	// X25519 key agreement is derived from Ed25519 key
+	if code == ed25519pub {
+		keyAgreement, err := keyAgreement(didKey, pubKeyValue)
+		if err != nil {
+			return nil, err
+		}
+
+		doc.KeyAgreement = []did.VerificationMethod{*did.NewEmbeddedVerificationMethod(keyAgreement, did.KeyAgreement)}
+	}
+
+	return doc, nil
 }
 
 func keyFingerprint(multicodecValue, pubKeyValue []byte) string {
@@ -91,8 +118,8 @@ func keyAgreement(didKey string, ed25519PubKey []byte) (*did.PublicKey, error) {
 }
 
 func multicodec(code uint64) []byte {
-	buf := make([]byte, 2)
-	binary.PutUvarint(buf, code)
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, code)
 
-	return buf
+	return buf[:n]
 }
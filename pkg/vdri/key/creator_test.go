@@ -7,8 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package key
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/stretchr/testify/require"
 
@@ -70,6 +75,99 @@ func TestBuild(t *testing.T) {
 
 		assertDoc(t, doc)
 	})
+
+	t.Run("build with EC key types", func(t *testing.T) {
+		v := New()
+
+		secp256k1Key, err := btcec.NewPrivateKey(btcec.S256())
+		require.NoError(t, err)
+
+		p256Key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		p384Key, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+		require.NoError(t, err)
+
+		tests := []struct {
+			keyType string
+			pubKey  *ecdsa.PublicKey
+			prefix  string
+		}{
+			{ecdsaSecp256k1VerificationKey2019, secp256k1Key.PubKey().ToECDSA(), "did:key:zQ3s"},
+			{jsonWebKey2020, &p256Key.PublicKey, "did:key:zDn"},
+			{jsonWebKey2020, &p384Key.PublicKey, "did:key:z82"},
+		}
+
+		for _, tc := range tests {
+			uncompressed := elliptic.Marshal(tc.pubKey.Curve, tc.pubKey.X, tc.pubKey.Y)
+			compressed := marshalCompressed(tc.pubKey.Curve, tc.pubKey.X, tc.pubKey.Y)
+
+			doc, err := v.Build(&vdriapi.PubKey{Type: tc.keyType, Value: base58.Encode(uncompressed)})
+			require.NoError(t, err)
+			require.True(t, strings.HasPrefix(doc.ID, tc.prefix), doc.ID)
+			require.Equal(t, tc.keyType, doc.PublicKey[0].Type)
+			require.Equal(t, tc.pubKey.X, doc.PublicKey[0].JSONWebKey().Key.(*ecdsa.PublicKey).X)
+			require.Equal(t, tc.pubKey.Y, doc.PublicKey[0].JSONWebKey().Key.(*ecdsa.PublicKey).Y)
+			require.Len(t, doc.AssertionMethod, 1)
+			require.Empty(t, doc.KeyAgreement)
+
+			// compressed key gives the same DID
+			compressedDoc, err := v.Build(&vdriapi.PubKey{Type: tc.keyType, Value: base58.Encode(compressed)})
+			require.NoError(t, err)
+			require.Equal(t, doc.ID, compressedDoc.ID)
+
+			// built document is resolved back
+			resolution, err := v.Read(doc.ID)
+			require.NoError(t, err)
+			require.Equal(t, doc.PublicKey[0].Value, resolution.DIDDocument.PublicKey[0].Value)
+		}
+	})
+
+	t.Run("build with BLS12-381 G2 key", func(t *testing.T) {
+		v := New()
+
+		pubKey := make([]byte, bls12381G2PubKeySize)
+		_, err := rand.Read(pubKey)
+		require.NoError(t, err)
+
+		doc, err := v.Build(&vdriapi.PubKey{Type: bls12381G2Key2020, Value: base58.Encode(pubKey)})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(doc.ID, "did:key:zUC"), doc.ID)
+		require.Equal(t, bls12381G2Key2020, doc.PublicKey[0].Type)
+		require.Equal(t, pubKey, doc.PublicKey[0].Value)
+		require.Len(t, doc.CapabilityInvocation, 1)
+		require.Empty(t, doc.KeyAgreement)
+
+		resolution, err := v.Read(doc.ID)
+		require.NoError(t, err)
+		require.Equal(t, pubKey, resolution.DIDDocument.PublicKey[0].Value)
+	})
+
+	t.Run("build with X25519 key", func(t *testing.T) {
+		v := New()
+
+		doc, err := v.Build(&vdriapi.PubKey{Type: x25519KeyAgreementKey2019, Value: keyAgreementBase58})
+		require.NoError(t, err)
+		require.Equal(t, "did:key:z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc", doc.ID)
+		require.Len(t, doc.KeyAgreement, 1)
+		require.Empty(t, doc.Authentication)
+	})
+
+	t.Run("validate invalid public keys", func(t *testing.T) {
+		v := New()
+
+		for keyType, errMsg := range map[string]string{
+			x25519KeyAgreementKey2019:         "invalid X25519KeyAgreementKey2019 public key size: 3",
+			bls12381G2Key2020:                 "invalid Bls12381G2Key2020 public key size: 3",
+			ecdsaSecp256k1VerificationKey2019: "invalid secp256k1 public key",
+			jsonWebKey2020:                    "invalid P-256 or P-384 public key",
+		} {
+			doc, err := v.Build(&vdriapi.PubKey{Type: keyType, Value: base58.Encode([]byte{2, 1, 1})})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), errMsg)
+			require.Nil(t, doc)
+		}
+	})
 }
 
 func assertDoc(t *testing.T, doc *did.Doc) {
@@ -0,0 +1,184 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package key
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+)
+
+const (
+	ed25519PubKeySize    = 32
+	x25519PubKeySize     = 32
+	bls12381G2PubKeySize = 96
+)
+
+// fingerprintKey returns multicodec code and the public key bytes of the did:key fingerprint
+// for the verification method type and the raw public key bytes. EC public keys may be given
+// either compressed or uncompressed, the fingerprint holds the compressed point.
+func fingerprintKey(keyType string, value []byte) (uint64, []byte, error) {
+	switch keyType {
+	case ed25519VerificationKey2018:
+		return ed25519pub, value, nil
+	case x25519KeyAgreementKey2019:
+		return x25519pub, value, checkKeySize(x25519KeyAgreementKey2019, value, x25519PubKeySize)
+	case bls12381G2Key2020:
+		return bls12381g2pub, value, checkKeySize(bls12381G2Key2020, value, bls12381G2PubKeySize)
+	case ecdsaSecp256k1VerificationKey2019:
+		pubKey, err := btcec.ParsePubKey(value, btcec.S256())
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+		}
+
+		return secp256k1pub, pubKey.SerializeCompressed(), nil
+	case jsonWebKey2020:
+		if x, y := unmarshalPoint(elliptic.P256(), value); x != nil {
+			return p256pub, marshalCompressed(elliptic.P256(), x, y), nil
+		}
+
+		if x, y := unmarshalPoint(elliptic.P384(), value); x != nil {
+			return p384pub, marshalCompressed(elliptic.P384(), x, y), nil
+		}
+
+		return 0, nil, errors.New("invalid P-256 or P-384 public key")
+	default:
+		return 0, nil, fmt.Errorf("not supported public key type: %s", keyType)
+	}
+}
+
+// verificationKey creates public key of the verification method for the multicodec code and
+// the fingerprint public key bytes.
+func verificationKey(code uint64, keyID, didKey string, value []byte) (*did.PublicKey, error) {
+	switch code {
+	case ed25519pub:
+		if err := checkKeySize(ed25519VerificationKey2018, value, ed25519PubKeySize); err != nil {
+			return nil, err
+		}
+
+		return did.NewPublicKeyFromBytes(keyID, ed25519VerificationKey2018, didKey, value), nil
+	case x25519pub:
+		if err := checkKeySize(x25519KeyAgreementKey2019, value, x25519PubKeySize); err != nil {
+			return nil, err
+		}
+
+		return did.NewPublicKeyFromBytes(keyID, x25519KeyAgreementKey2019, didKey, value), nil
+	case bls12381g2pub:
+		if err := checkKeySize(bls12381G2Key2020, value, bls12381G2PubKeySize); err != nil {
+			return nil, err
+		}
+
+		return did.NewPublicKeyFromBytes(keyID, bls12381G2Key2020, didKey, value), nil
+	case secp256k1pub:
+		pubKey, err := btcec.ParsePubKey(value, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid secp256k1 public key: %w", err)
+		}
+
+		return jwkPublicKey(keyID, ecdsaSecp256k1VerificationKey2019, didKey, pubKey.ToECDSA())
+	case p256pub, p384pub:
+		curve := elliptic.P256()
+		if code == p384pub {
+			curve = elliptic.P384()
+		}
+
+		x, y := unmarshalCompressed(curve, value)
+		if x == nil {
+			return nil, fmt.Errorf("invalid %s public key", curve.Params().Name)
+		}
+
+		return jwkPublicKey(keyID, jsonWebKey2020, didKey, &ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	default:
+		return nil, fmt.Errorf("not supported public key (multicodec code: %#x)", code)
+	}
+}
+
+func jwkPublicKey(keyID, keyType, didKey string, pubKey *ecdsa.PublicKey) (*did.PublicKey, error) {
+	jwk, err := jose.JWKFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return did.NewPublicKeyFromJWK(keyID, keyType, didKey, jwk)
+}
+
+func checkKeySize(keyType string, value []byte, size int) error {
+	if len(value) != size {
+		return fmt.Errorf("invalid %s public key size: %d", keyType, len(value))
+	}
+
+	return nil
+}
+
+// unmarshalPoint converts compressed or uncompressed point to the coordinates, x is nil on error.
+func unmarshalPoint(curve elliptic.Curve, data []byte) (*big.Int, *big.Int) {
+	if len(data) > 0 && data[0] == 4 {
+		return elliptic.Unmarshal(curve, data)
+	}
+
+	return unmarshalCompressed(curve, data)
+}
+
+// marshalCompressed converts the point to the compressed form of SEC 1, Version 2.0, Section 2.3.3.
+func marshalCompressed(curve elliptic.Curve, x, y *big.Int) []byte {
+	byteLen := (curve.Params().BitSize + 7) / 8
+	compressed := make([]byte, 1+byteLen)
+	compressed[0] = byte(y.Bit(0)) | 2
+
+	xBytes := x.Bytes()
+	copy(compressed[1+byteLen-len(xBytes):], xBytes)
+
+	return compressed
+}
+
+// unmarshalCompressed converts the compressed point to the coordinates, x is nil on error.
+func unmarshalCompressed(curve elliptic.Curve, data []byte) (*big.Int, *big.Int) {
+	params := curve.Params()
+	byteLen := (params.BitSize + 7) / 8
+
+	if len(data) != 1+byteLen || (data[0] != 2 && data[0] != 3) {
+		return nil, nil
+	}
+
+	x := new(big.Int).SetBytes(data[1:])
+	if x.Cmp(params.P) >= 0 {
+		return nil, nil
+	}
+
+	// y² = x³ - 3x + b
+	y := new(big.Int).Mul(x, x)
+	y.Mul(y, x)
+
+	threeX := new(big.Int).Lsh(x, 1)
+	threeX.Add(threeX, x)
+
+	y.Sub(y, threeX)
+	y.Add(y, params.B)
+	y.Mod(y, params.P)
+
+	if y.ModSqrt(y, params.P) == nil {
+		return nil, nil
+	}
+
+	if byte(y.Bit(0)) != data[0]&1 {
+		y.Neg(y)
+		y.Mod(y, params.P)
+	}
+
+	if !curve.IsOnCurve(x, y) {
+		return nil, nil
+	}
+
+	return x, y
+}
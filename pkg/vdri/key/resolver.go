@@ -7,7 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package key
 
 import (
-	"bytes"
+	"encoding/binary"
 	"fmt"
 	"regexp"
 
@@ -17,8 +17,9 @@ import (
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
-// Read expands did:key value to a DID document.
-func (v *VDRI) Read(didKey string, opts ...vdriapi.ResolveOpts) (*did.Doc, error) {
+// Read expands did:key value to a DID document. The did:key document is derived from the key,
+// so it has a single version and is never updated or deactivated.
+func (v *VDRI) Read(didKey string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
 	parsed, err := did.Parse(didKey)
 	if err != nil {
 		return nil, err
@@ -28,26 +29,33 @@ func (v *VDRI) Read(didKey string, opts ...vdriapi.ResolveOpts) (*did.Doc, error
 		return nil, fmt.Errorf("invalid did:key method ID: %s", parsed.MethodSpecificID)
 	}
 
-	pubKey, err := pubKeyFromFingerprint(parsed.MethodSpecificID)
+	code, pubKey, err := pubKeyFromFingerprint(parsed.MethodSpecificID)
 	if err != nil {
 		return nil, err
 	}
 
-	return createDoc(pubKey)
+	doc, err := createDoc(code, pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return did.NewDocResolution(doc), nil
 }
 
 func isValidMethodID(id string) bool {
-	r := regexp.MustCompile(`(z)([1-9a-km-zA-HJ-NP-Z]{46})`)
+	r := regexp.MustCompile(`^(z)([1-9a-km-zA-HJ-NP-Z]{46,})$`)
 	return r.MatchString(id)
 }
 
-func pubKeyFromFingerprint(fingerprint string) ([]byte, error) {
+func pubKeyFromFingerprint(fingerprint string) (uint64, []byte, error) {
 	// did:key:MULTIBASE(base58-btc, MULTICODEC(public-key-type, raw-public-key-bytes))
 	// https://w3c-ccg.github.io/did-method-key/#format
 	mc := base58.Decode(fingerprint[1:]) // skip leading "z"
-	if !bytes.Equal(multicodec(ed25519pub), mc[:2]) {
-		return nil, fmt.Errorf("not supported public key (multicodec code: %#x)", mc[0])
+
+	code, n := binary.Uvarint(mc)
+	if n <= 0 {
+		return 0, nil, fmt.Errorf("invalid did:key multicodec: %s", fingerprint)
 	}
 
-	return mc[2:], nil
+	return code, mc[n:], nil
 }
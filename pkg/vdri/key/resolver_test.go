@@ -7,9 +7,13 @@ SPDX-License-Identifier: Apache-2.0
 package key
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/btcsuite/btcutil/base58"
 	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 )
 
 func TestRead(t *testing.T) {
@@ -34,20 +38,81 @@ func TestRead(t *testing.T) {
 	t.Run("validate not supported public key", func(t *testing.T) {
 		v := New()
 
-		doc, err := v.Read("did:key:z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc")
+		rsaKey := keyFingerprint(multicodec(0x1205), []byte(strings.Repeat("k", 64))) // RSA public key
+
+		doc, err := v.Read("did:key:" + rsaKey)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "not supported public key (multicodec code: 0x1205)")
+		require.Nil(t, doc)
+	})
+
+	t.Run("validate public key size", func(t *testing.T) {
+		v := New()
+
+		doc, err := v.Read("did:key:" + keyFingerprint(multicodec(bls12381g2pub), make([]byte, 48)))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid Bls12381G2Key2020 public key size: 48")
+		require.Nil(t, doc)
+
+		doc, err = v.Read("did:key:" + keyFingerprint(multicodec(p256pub), make([]byte, 33)))
 		require.Error(t, err)
-		require.Contains(t, err.Error(), "not supported public key (multicodec code: 0xec)") // Curve25519 public key
+		require.Contains(t, err.Error(), "invalid P-256 public key")
 		require.Nil(t, doc)
 	})
 
 	t.Run("resolve assuming default key type", func(t *testing.T) {
 		v := New()
 
-		doc, err := v.Read(didKey)
+		resolution, err := v.Read(didKey)
 		require.NoError(t, err)
-		require.NotNil(t, doc)
+		require.NotNil(t, resolution)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.False(t, resolution.DocumentMetadata.Deactivated)
+
+		doc := resolution.DIDDocument
 		require.True(t, doc.KeyAgreement[0].Embedded)
 
 		assertDoc(t, doc)
 	})
+
+	t.Run("resolve other key types", func(t *testing.T) {
+		v := New()
+
+		tests := []struct {
+			didKey  string
+			keyType string
+			crv     string
+		}{
+			{"did:key:zQ3shokFTS3brHcDQrn82RUDfCZESWL1ZdCEJwekUDPQiYBme", ecdsaSecp256k1VerificationKey2019, "secp256k1"},
+			{"did:key:zDnaerDaTF5BXEavCrfRZEk316dpbLsfPDZ3WJ5hRTPFU2169", jsonWebKey2020, "P-256"},
+			{"did:key:z82Lm1MpAkeJcix9K8TMiLd5NMAhnwkjjCBeWHXyu3U4oT2MVJJKXkcVBgjGhnLBn2Kaau9", jsonWebKey2020, "P-384"},
+		}
+
+		for _, tc := range tests {
+			resolution, err := v.Read(tc.didKey)
+			require.NoError(t, err)
+
+			doc := resolution.DIDDocument
+			require.Equal(t, tc.didKey, doc.ID)
+			require.Len(t, doc.PublicKey, 1)
+			require.Equal(t, tc.keyType, doc.PublicKey[0].Type)
+			require.Equal(t, tc.crv, doc.PublicKey[0].JSONWebKey().Crv)
+			require.Equal(t, doc.PublicKey[0].ID, doc.Authentication[0].PublicKey.ID)
+			require.Empty(t, doc.KeyAgreement)
+		}
+	})
+
+	t.Run("resolve X25519 key", func(t *testing.T) {
+		v := New()
+
+		resolution, err := v.Read("did:key:z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc")
+		require.NoError(t, err)
+
+		doc := resolution.DIDDocument
+		require.Empty(t, doc.Authentication)
+		require.Len(t, doc.KeyAgreement, 1)
+		require.False(t, doc.KeyAgreement[0].Embedded)
+		require.Equal(t, x25519KeyAgreementKey2019, doc.KeyAgreement[0].PublicKey.Type)
+		require.Equal(t, base58.Decode(keyAgreementBase58), doc.KeyAgreement[0].PublicKey.Value)
+	})
 }
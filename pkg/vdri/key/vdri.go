@@ -7,6 +7,8 @@ SPDX-License-Identifier: Apache-2.0
 package key
 
 import (
+	"fmt"
+
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	"github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
@@ -32,6 +34,16 @@ func (v *VDRI) Store(doc *did.Doc, by *[]vdri.ModifiedBy) error {
 	return nil
 }
 
+// Update is not supported as did:key document is derived from the key.
+func (v *VDRI) Update(didID string, _ []vdri.Patch, _ *[]vdri.ModifiedBy) (*did.Doc, error) {
+	return nil, fmt.Errorf("update %s: %w", didID, vdri.ErrOperationNotSupported)
+}
+
+// Deactivate is not supported as did:key document is derived from the key.
+func (v *VDRI) Deactivate(didID string, _ *[]vdri.ModifiedBy) error {
+	return fmt.Errorf("deactivate %s: %w", didID, vdri.ErrOperationNotSupported)
+}
+
 // Close frees resources being maintained by VDRI.
 func (v *VDRI) Close() error {
 	return nil
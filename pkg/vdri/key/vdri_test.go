@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package key
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,6 +43,16 @@ func TestStore(t *testing.T) {
 	})
 }
 
+func TestUpdateAndDeactivate(t *testing.T) {
+	v := New()
+
+	_, err := v.Update(didKey, nil, nil)
+	require.True(t, errors.Is(err, vdri.ErrOperationNotSupported))
+
+	err = v.Deactivate(didKey, nil)
+	require.True(t, errors.Is(err, vdri.ErrOperationNotSupported))
+}
+
 func TestClose(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 		v := New()
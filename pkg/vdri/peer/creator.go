@@ -24,7 +24,20 @@ func (v *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc,
 		opt(docOpts)
 	}
 
-	didDoc, err := build(pubKey, docOpts)
+	var (
+		didDoc *did.Doc
+		err    error
+	)
+
+	switch v.numAlgo {
+	case 0:
+		didDoc, err = buildNumAlgo0(pubKey, docOpts)
+	case 2: //nolint:gomnd
+		didDoc, err = buildNumAlgo2(pubKey, docOpts)
+	default:
+		didDoc, err = build(pubKey, docOpts)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf("create peer DID : %w", err)
 	}
@@ -0,0 +1,299 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/internal/cryptoutil"
+)
+
+// Static peer DIDs (numalgo 0 and 2) hold the keys and the service in the DID itself, so they
+// are resolved without the peer store.
+// Reference: https://identity.foundation/peer-did-method-spec/#generation-method
+const (
+	numAlgo0 = "0"
+	numAlgo2 = "2"
+
+	ed25519VerificationKey2018 = "Ed25519VerificationKey2018"
+	x25519KeyAgreementKey2019  = "X25519KeyAgreementKey2019"
+
+	ed25519pub = 0xed // Ed25519 public key in multicodec table
+	x25519pub  = 0xec // Curve25519 public key in multicodec table
+
+	// numalgo 2 element purpose codes.
+	purposeAssertion    = 'A'
+	purposeEncryption   = 'E'
+	purposeVerification = 'V'
+	purposeInvocation   = 'I'
+	purposeDelegation   = 'D'
+	purposeService      = 'S'
+
+	serviceTypeDIDCommMessaging = "DIDCommMessaging"
+	abbrDIDCommMessaging        = "dm"
+)
+
+// peerService is the abbreviated service of numalgo 2 DID.
+type peerService struct {
+	Type        string   `json:"t"`
+	Endpoint    string   `json:"s"`
+	RoutingKeys []string `json:"r,omitempty"`
+	Accept      []string `json:"a,omitempty"`
+}
+
+// isStatic checks if the peer DID is resolved from the DID itself.
+func isStatic(didID string) bool {
+	return strings.HasPrefix(didID, peerPrefix+numAlgo0+"z") || strings.HasPrefix(didID, peerPrefix+numAlgo2+".")
+}
+
+// buildNumAlgo0 creates numalgo 0 DID document, the DID is the inception key. The document has no service,
+// so the service options are rejected instead of being dropped.
+func buildNumAlgo0(pubKey *vdriapi.PubKey, docOpts *vdriapi.CreateDIDOpts) (*did.Doc, error) {
+	if pubKey.Type != ed25519VerificationKey2018 {
+		return nil, fmt.Errorf("numalgo 0: not supported public key type: %s", pubKey.Type)
+	}
+
+	if docOpts.ServiceType != "" || docOpts.ServiceEndpoint != "" || len(docOpts.RoutingKeys) != 0 {
+		return nil, errors.New("numalgo 0: DID document has no service, use numalgo 1 or 2 for the service")
+	}
+
+	return resolveNumAlgo0(peerPrefix + numAlgo0 + fingerprint(ed25519pub, base58.Decode(pubKey.Value)))
+}
+
+// buildNumAlgo2 creates numalgo 2 DID document. The public key is used for authentication and
+// the key agreement key is derived from it.
+func buildNumAlgo2(pubKey *vdriapi.PubKey, docOpts *vdriapi.CreateDIDOpts) (*did.Doc, error) {
+	if pubKey.Type != ed25519VerificationKey2018 {
+		return nil, fmt.Errorf("numalgo 2: not supported public key type: %s", pubKey.Type)
+	}
+
+	ed25519PubKey := base58.Decode(pubKey.Value)
+
+	x25519PubKey, err := cryptoutil.PublicEd25519toCurve25519(ed25519PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("numalgo 2: %w", err)
+	}
+
+	didID := fmt.Sprintf("%s%s.%c%s.%c%s", peerPrefix, numAlgo2,
+		purposeEncryption, fingerprint(x25519pub, x25519PubKey),
+		purposeVerification, fingerprint(ed25519pub, ed25519PubKey))
+
+	if docOpts.ServiceType != "" {
+		svcBytes, err := json.Marshal(&peerService{
+			Type:        abbreviate(docOpts.ServiceType),
+			Endpoint:    docOpts.ServiceEndpoint,
+			RoutingKeys: docOpts.RoutingKeys,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("numalgo 2: marshal service: %w", err)
+		}
+
+		didID += fmt.Sprintf(".%c%s", purposeService, base64.RawURLEncoding.EncodeToString(svcBytes))
+	}
+
+	return resolveNumAlgo2(didID)
+}
+
+// resolveStatic resolves numalgo 0 or 2 DID.
+func resolveStatic(didID string) (*did.Doc, error) {
+	if strings.HasPrefix(didID, peerPrefix+numAlgo0) {
+		return resolveNumAlgo0(didID)
+	}
+
+	return resolveNumAlgo2(didID)
+}
+
+func resolveNumAlgo0(didID string) (*did.Doc, error) {
+	pubKey, err := verificationKey(didID, strings.TrimPrefix(didID, peerPrefix+numAlgo0), "")
+	if err != nil {
+		return nil, fmt.Errorf("numalgo 0: %w", err)
+	}
+
+	if pubKey.Type != ed25519VerificationKey2018 {
+		return nil, fmt.Errorf("numalgo 0: not supported inception key type: %s", pubKey.Type)
+	}
+
+	doc := did.BuildDoc()
+	doc.ID = didID
+	doc.PublicKey = []did.PublicKey{*pubKey}
+	doc.Authentication = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.Authentication, false)}
+	doc.AssertionMethod = []did.VerificationMethod{*did.NewReferencedVerificationMethod(pubKey, did.AssertionMethod, false)}
+
+	return doc, nil
+}
+
+//nolint:funlen,gocyclo
+func resolveNumAlgo2(didID string) (*did.Doc, error) {
+	elements := strings.Split(strings.TrimPrefix(didID, peerPrefix+numAlgo2), ".")
+	if len(elements) < 2 || elements[0] != "" {
+		return nil, fmt.Errorf("numalgo 2: invalid DID: %s", didID)
+	}
+
+	doc := did.BuildDoc()
+	doc.ID = didID
+
+	var services []peerService
+
+	for _, element := range elements[1:] {
+		if len(element) < 2 {
+			return nil, fmt.Errorf("numalgo 2: invalid element: %s", element)
+		}
+
+		purpose, value := element[0], element[1:]
+
+		if purpose == purposeService {
+			svc, err := decodeService(value)
+			if err != nil {
+				return nil, fmt.Errorf("numalgo 2: %w", err)
+			}
+
+			services = append(services, *svc)
+
+			continue
+		}
+
+		pubKey, err := verificationKey(didID, value, fmt.Sprintf("key-%d", len(doc.PublicKey)+1))
+		if err != nil {
+			return nil, fmt.Errorf("numalgo 2: %w", err)
+		}
+
+		doc.PublicKey = append(doc.PublicKey, *pubKey)
+
+		switch purpose {
+		case purposeAssertion:
+			doc.AssertionMethod = append(doc.AssertionMethod,
+				*did.NewReferencedVerificationMethod(pubKey, did.AssertionMethod, false))
+		case purposeEncryption:
+			doc.KeyAgreement = append(doc.KeyAgreement,
+				*did.NewReferencedVerificationMethod(pubKey, did.KeyAgreement, false))
+		case purposeVerification:
+			doc.Authentication = append(doc.Authentication,
+				*did.NewReferencedVerificationMethod(pubKey, did.Authentication, false))
+		case purposeInvocation:
+			doc.CapabilityInvocation = append(doc.CapabilityInvocation,
+				*did.NewReferencedVerificationMethod(pubKey, did.CapabilityInvocation, false))
+		case purposeDelegation:
+			doc.CapabilityDelegation = append(doc.CapabilityDelegation,
+				*did.NewReferencedVerificationMethod(pubKey, did.CapabilityDelegation, false))
+		default:
+			return nil, fmt.Errorf("numalgo 2: unsupported purpose code: %c", purpose)
+		}
+	}
+
+	for i, svc := range services {
+		id := didID + "#service"
+		if i > 0 {
+			id = fmt.Sprintf("%s-%d", id, i)
+		}
+
+		s := did.Service{
+			ID:              id,
+			Type:            svc.Type,
+			ServiceEndpoint: svc.Endpoint,
+			RoutingKeys:     svc.RoutingKeys,
+		}
+
+		if len(svc.Accept) > 0 {
+			s.Properties = map[string]interface{}{"accept": svc.Accept}
+		}
+
+		// DIDComm v1 recipient keys are the authentication keys
+		if svc.Type == vdriapi.DIDCommServiceType {
+			for _, vm := range doc.Authentication {
+				s.RecipientKeys = append(s.RecipientKeys, base58.Encode(vm.PublicKey.Value))
+			}
+		}
+
+		doc.Service = append(doc.Service, s)
+	}
+
+	return doc, nil
+}
+
+// verificationKey creates public key of the multibase encoded multicodec key. The key fingerprint
+// is the key ID if the key ID is not given.
+func verificationKey(didID, multibaseKey, keyID string) (*did.PublicKey, error) {
+	if !strings.HasPrefix(multibaseKey, "z") {
+		return nil, fmt.Errorf("invalid multibase key: %s", multibaseKey)
+	}
+
+	mc := base58.Decode(multibaseKey[1:])
+
+	code, n := binary.Uvarint(mc)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid multicodec key: %s", multibaseKey)
+	}
+
+	var keyType string
+
+	switch code {
+	case ed25519pub:
+		keyType = ed25519VerificationKey2018
+	case x25519pub:
+		keyType = x25519KeyAgreementKey2019
+	default:
+		return nil, fmt.Errorf("not supported public key (multicodec code: %#x)", code)
+	}
+
+	value := mc[n:]
+	if len(value) != 32 { //nolint:gomnd
+		return nil, fmt.Errorf("invalid %s public key size: %d", keyType, len(value))
+	}
+
+	if keyID == "" {
+		keyID = multibaseKey
+	}
+
+	return did.NewPublicKeyFromBytes(didID+"#"+keyID, keyType, didID, value), nil
+}
+
+func decodeService(encoded string) (*peerService, error) {
+	svcBytes, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(encoded, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decode service: %w", err)
+	}
+
+	var svc peerService
+
+	if err = json.Unmarshal(svcBytes, &svc); err != nil {
+		return nil, fmt.Errorf("unmarshal service: %w", err)
+	}
+
+	if svc.Type == "" {
+		return nil, errors.New("service type is missing")
+	}
+
+	if svc.Type == abbrDIDCommMessaging {
+		svc.Type = serviceTypeDIDCommMessaging
+	}
+
+	return &svc, nil
+}
+
+func abbreviate(serviceType string) string {
+	if serviceType == serviceTypeDIDCommMessaging {
+		return abbrDIDCommMessaging
+	}
+
+	return serviceType
+}
+
+func fingerprint(code uint64, pubKey []byte) string {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, code)
+
+	return "z" + base58.Encode(append(buf[:n], pubKey...))
+}
@@ -0,0 +1,209 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package peer
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+)
+
+const (
+	numAlgo0DID = "did:peer:0z6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V"
+	numAlgo2DID = "did:peer:2.Ez6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc" +
+		".Vz6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V" +
+		".SeyJ0IjoiZG0iLCJzIjoiaHR0cHM6Ly9leGFtcGxlLmNvbS9lbmRwb2ludCIsInIiOlsiZGlkOmV4YW1wbGU6c29tZW1lZGlhdG9yI3NvbWVrZXkiXX0" //nolint:lll
+)
+
+func TestNew_NumAlgo(t *testing.T) {
+	_, err := New(storage.NewMockStoreProvider(), WithNumAlgo(3))
+	require.EqualError(t, err, "unsupported numalgo: 3")
+}
+
+func TestNumAlgo0(t *testing.T) {
+	v, err := New(storage.NewMockStoreProvider(), WithNumAlgo(0))
+	require.NoError(t, err)
+
+	t.Run("test resolve", func(t *testing.T) {
+		resolution, err := v.Read(numAlgo0DID)
+		require.NoError(t, err)
+
+		doc := resolution.DIDDocument
+		require.Equal(t, numAlgo0DID, doc.ID)
+		require.Len(t, doc.PublicKey, 1)
+		require.Equal(t, numAlgo0DID+"#z6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V", doc.PublicKey[0].ID)
+		require.Equal(t, ed25519VerificationKey2018, doc.PublicKey[0].Type)
+		require.Equal(t, doc.PublicKey[0].ID, doc.Authentication[0].PublicKey.ID)
+		require.Equal(t, doc.PublicKey[0].ID, doc.AssertionMethod[0].PublicKey.ID)
+
+		// nothing is stored
+		_, err = v.store.Get(numAlgo0DID)
+		require.Error(t, err)
+	})
+
+	t.Run("test build", func(t *testing.T) {
+		pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		doc, err := v.Build(&vdriapi.PubKey{Type: ed25519VerificationKey2018, Value: base58.Encode(pubKey)})
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(doc.ID, "did:peer:0z6Mk"))
+		require.Equal(t, []byte(pubKey), doc.PublicKey[0].Value)
+
+		_, err = v.Build(&vdriapi.PubKey{Type: "other", Value: base58.Encode(pubKey)})
+		require.EqualError(t, err, "create peer DID : numalgo 0: not supported public key type: other")
+
+		// the service is not dropped silently
+		for _, opt := range []vdriapi.DocOpts{
+			vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+			vdriapi.WithServiceEndpoint("https://example.com/endpoint"),
+			vdriapi.WithRoutingKeys([]string{"did:example:somemediator#somekey"}),
+		} {
+			_, err = v.Build(&vdriapi.PubKey{Type: ed25519VerificationKey2018, Value: base58.Encode(pubKey)}, opt)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "numalgo 0: DID document has no service")
+		}
+	})
+
+	t.Run("test invalid inception key", func(t *testing.T) {
+		_, err := v.Read("did:peer:0z6LSbysY2xFMRpGMhb7tFTLMpeuPRaqaWM1yECx2AtzE3KCc")
+		require.EqualError(t, err, "numalgo 0: not supported inception key type: X25519KeyAgreementKey2019")
+
+		_, err = v.Read("did:peer:0" + fingerprint(ed25519pub, []byte{1, 2, 3}))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid Ed25519VerificationKey2018 public key size")
+	})
+}
+
+func TestNumAlgo2(t *testing.T) {
+	v, err := New(storage.NewMockStoreProvider(), WithNumAlgo(2))
+	require.NoError(t, err)
+
+	t.Run("test resolve", func(t *testing.T) {
+		resolution, err := v.Read(numAlgo2DID)
+		require.NoError(t, err)
+
+		doc := resolution.DIDDocument
+		require.Equal(t, numAlgo2DID, doc.ID)
+		require.Len(t, doc.PublicKey, 2)
+		require.Equal(t, numAlgo2DID+"#key-1", doc.KeyAgreement[0].PublicKey.ID)
+		require.Equal(t, x25519KeyAgreementKey2019, doc.KeyAgreement[0].PublicKey.Type)
+		require.Equal(t, numAlgo2DID+"#key-2", doc.Authentication[0].PublicKey.ID)
+		require.Equal(t, ed25519VerificationKey2018, doc.Authentication[0].PublicKey.Type)
+
+		require.Len(t, doc.Service, 1)
+		require.Equal(t, numAlgo2DID+"#service", doc.Service[0].ID)
+		require.Equal(t, "DIDCommMessaging", doc.Service[0].Type)
+		require.Equal(t, "https://example.com/endpoint", doc.Service[0].ServiceEndpoint)
+		require.Equal(t, []string{"did:example:somemediator#somekey"}, doc.Service[0].RoutingKeys)
+		require.Empty(t, doc.Service[0].RecipientKeys)
+
+		// the document is valid
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		_, err = did.ParseDocument(docBytes)
+		require.NoError(t, err)
+	})
+
+	t.Run("test build", func(t *testing.T) {
+		pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		doc, err := v.Build(&vdriapi.PubKey{Type: ed25519VerificationKey2018, Value: base58.Encode(pubKey)},
+			vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+			vdriapi.WithServiceEndpoint("https://agent.example.com"),
+			vdriapi.WithRoutingKeys([]string{"routing-key"}))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(doc.ID, "did:peer:2.Ez6LS"))
+		require.Len(t, doc.KeyAgreement, 1)
+		require.Equal(t, []byte(pubKey), doc.Authentication[0].PublicKey.Value)
+
+		svc, ok := did.LookupService(doc, vdriapi.DIDCommServiceType)
+		require.True(t, ok)
+		require.Equal(t, "https://agent.example.com", svc.ServiceEndpoint)
+		require.Equal(t, []string{"routing-key"}, svc.RoutingKeys)
+		require.Equal(t, []string{base58.Encode(pubKey)}, svc.RecipientKeys)
+
+		// the DID is resolved to the same document
+		resolution, err := v.Read(doc.ID)
+		require.NoError(t, err)
+		require.Equal(t, doc, resolution.DIDDocument)
+
+		// nothing is stored and the document can't be changed
+		require.NoError(t, v.Store(doc, nil))
+
+		_, err = v.store.Get(doc.ID)
+		require.Error(t, err)
+
+		stored, err := v.Get(doc.ID)
+		require.NoError(t, err)
+		require.Equal(t, doc, stored)
+
+		_, err = v.Update(doc.ID, nil, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+
+		err = v.Deactivate(doc.ID, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+
+		// no service
+		doc, err = v.Build(&vdriapi.PubKey{Type: ed25519VerificationKey2018, Value: base58.Encode(pubKey)})
+		require.NoError(t, err)
+		require.Empty(t, doc.Service)
+		require.Len(t, strings.Split(doc.ID, "."), 3)
+	})
+
+	t.Run("test build errors", func(t *testing.T) {
+		_, err := v.Build(&vdriapi.PubKey{Type: "other"})
+		require.EqualError(t, err, "create peer DID : numalgo 2: not supported public key type: other")
+
+		_, err = v.Build(&vdriapi.PubKey{Type: ed25519VerificationKey2018, Value: "invalid"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "numalgo 2")
+	})
+
+	t.Run("test version", func(t *testing.T) {
+		_, err := v.Read(numAlgo2DID, vdriapi.WithVersionID("1"))
+		require.NoError(t, err)
+
+		_, err = v.Read(numAlgo2DID, vdriapi.WithVersionID("2"))
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+	})
+
+	t.Run("test invalid DIDs", func(t *testing.T) {
+		encode := func(s string) string {
+			return base64.RawURLEncoding.EncodeToString([]byte(s))
+		}
+
+		tests := map[string]string{
+			"did:peer:2.": "numalgo 2: invalid element",
+			"did:peer:2.Xz6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V": "unsupported purpose code: X",
+			"did:peer:2.V6MkqRYqQiSgvZQdnBytw86Qbs2ZWUkGv22od935YF4s8M7V":  "invalid multibase key",
+			"did:peer:2.Vz":              "invalid multicodec key",
+			"did:peer:2.Vz3t4":           "not supported public key (multicodec code: 0x",
+			"did:peer:2.S!":              "decode service",
+			"did:peer:2.S" + encode("{"): "unmarshal service",
+			"did:peer:2.S" + encode(`{"s":"https://example.com"}`): "service type is missing",
+		}
+
+		for didID, errMsg := range tests {
+			_, err := v.Read(didID)
+			require.Error(t, err, didID)
+			require.Contains(t, err.Error(), errMsg, didID)
+		}
+	})
+}
@@ -7,23 +7,106 @@ SPDX-License-Identifier: Apache-2.0
 package peer
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
 	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 )
 
 // Read implements didresolver.DidMethod.Read interface (https://w3c-ccg.github.io/did-resolution/#resolving-input)
-func (v *VDRI) Read(didID string, _ ...vdriapi.ResolveOpts) (*did.Doc, error) {
-	// get the document from the store
-	doc, err := v.Get(didID)
+// Version ID of the peer DID document is the (1-based) number of its delta.
+func (v *VDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+	resolveOpts := &vdriapi.ResolveDIDOpts{}
+
+	for _, opt := range opts {
+		opt(resolveOpts)
+	}
+
+	if didID == "" {
+		return nil, errors.New("ID is mandatory")
+	}
+
+	if isStatic(didID) {
+		return readStatic(didID, resolveOpts)
+	}
+
+	// get the document deltas from the store
+	deltas, err := v.getDeltas(didID)
 	if err != nil {
 		return nil, fmt.Errorf("fetching data from store failed: %w", err)
 	}
 
-	if doc == nil {
-		return nil, vdriapi.ErrNotFound
+	version, err := deltaIndex(deltas, resolveOpts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", didID, err)
+	}
+
+	doc, err := deltas[version].document()
+	if err != nil {
+		return nil, err
+	}
+
+	deactivated := false
+
+	for _, delta := range deltas[:version+1] {
+		deactivated = deactivated || delta.Deactivated
+	}
+
+	created := deltas[0].ModifiedAt
+	updated := deltas[version].ModifiedAt
+
+	resolution := did.NewDocResolution(doc)
+	resolution.DocumentMetadata.Created = &created
+	resolution.DocumentMetadata.Updated = &updated
+	resolution.DocumentMetadata.Deactivated = deactivated
+	resolution.DocumentMetadata.VersionID = strconv.Itoa(version + 1)
+
+	return resolution, nil
+}
+
+// readStatic resolves numalgo 0 or 2 DID, the document has a single version.
+func readStatic(didID string, opts *vdriapi.ResolveDIDOpts) (*did.DocResolution, error) {
+	if opts.VersionID != nil && fmt.Sprint(opts.VersionID) != "1" {
+		return nil, fmt.Errorf("%s: version %v: %w", didID, opts.VersionID, vdriapi.ErrNotFound)
+	}
+
+	doc, err := resolveStatic(didID)
+	if err != nil {
+		return nil, err
+	}
+
+	return did.NewDocResolution(doc), nil
+}
+
+// deltaIndex returns index of the delta of the requested document version (the latest by default).
+func deltaIndex(deltas []docDelta, opts *vdriapi.ResolveDIDOpts) (int, error) {
+	if opts.VersionID != nil {
+		versionID, err := strconv.Atoi(fmt.Sprint(opts.VersionID))
+		if err != nil || versionID < 1 || versionID > len(deltas) {
+			return 0, fmt.Errorf("version %v: %w", opts.VersionID, vdriapi.ErrNotFound)
+		}
+
+		return versionID - 1, nil
+	}
+
+	if opts.VersionTime != "" {
+		versionTime, err := time.Parse(time.RFC3339, opts.VersionTime)
+		if err != nil {
+			return 0, fmt.Errorf("invalid version time: %w", err)
+		}
+
+		// version time is formatted with seconds precision
+		for i := len(deltas) - 1; i >= 0; i-- {
+			if !deltas[i].ModifiedAt.Truncate(time.Second).After(versionTime) {
+				return i, nil
+			}
+		}
+
+		return 0, fmt.Errorf("version at %s: %w", opts.VersionTime, vdriapi.ErrNotFound)
 	}
 
-	return doc, nil
+	return len(deltas) - 1, nil
 }
@@ -7,11 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package peer
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
 	"github.com/hyperledger/aries-framework-go/pkg/mock/storage"
 )
 
@@ -26,11 +29,36 @@ func TestPeerDIDResolver(t *testing.T) {
 		err = vdri.Store(&did.Doc{Context: context, ID: peerDID}, nil)
 		require.NoError(t, err)
 
-		doc, err := vdri.Read(peerDID)
+		resolution, err := vdri.Read(peerDID)
+		require.NoError(t, err)
+		require.Equal(t, peerDID, resolution.DIDDocument.ID)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.Equal(t, "1", resolution.DocumentMetadata.VersionID)
+		require.NotNil(t, resolution.DocumentMetadata.Created)
+		require.Equal(t, resolution.DocumentMetadata.Created, resolution.DocumentMetadata.Updated)
+	})
+	t.Run("test version", func(t *testing.T) {
+		vdri, err := New(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+		err = vdri.Store(&did.Doc{Context: []string{"https://w3id.org/did/v1"}, ID: peerDID}, nil)
 		require.NoError(t, err)
 
+		resolution, err := vdri.Read(peerDID, vdriapi.WithVersionID("1"),
+			vdriapi.WithVersionTime(time.Now().Add(time.Hour)))
+		require.NoError(t, err)
+		require.Equal(t, peerDID, resolution.DIDDocument.ID)
+
+		_, err = vdri.Read(peerDID, vdriapi.WithVersionID(2))
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+
+		_, err = vdri.Read(peerDID, vdriapi.WithVersionTime(time.Now().Add(-time.Hour)))
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+	})
+	t.Run("test not found", func(t *testing.T) {
+		vdri, err := New(storage.NewMockStoreProvider())
 		require.NoError(t, err)
-		require.Equal(t, peerDID, doc.ID)
+		_, err = vdri.Read(peerDID)
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
 	})
 	t.Run("test empty doc id", func(t *testing.T) {
 		vdri, err := New(storage.NewMockStoreProvider())
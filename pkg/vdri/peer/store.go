@@ -18,21 +18,37 @@ import (
 	"github.com/hyperledger/aries-framework-go/pkg/storage"
 )
 
+// docDelta holds the document after the change. Deactivation delta holds the last document.
 type docDelta struct {
-	Change     string                `json:"change,omitempty"`
-	ModifiedBy *[]vdriapi.ModifiedBy `json:"by,omitempty"`
-	ModifiedAt time.Time             `json:"when,omitempty"`
+	Change      string                `json:"change,omitempty"`
+	ModifiedBy  *[]vdriapi.ModifiedBy `json:"by,omitempty"`
+	ModifiedAt  time.Time             `json:"when,omitempty"`
+	Deactivated bool                  `json:"deactivated,omitempty"`
 }
 
-// Store saves Peer DID Document along with user key/signature.
+// Store saves Peer DID Document along with user key/signature. The document of already stored DID is saved
+// as a new delta, so the previous versions are kept. Deactivated DID can't be stored again.
 func (v *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
 	if doc == nil || doc.ID == "" {
 		return errors.New("DID and document are mandatory")
 	}
 
-	var deltas []docDelta
+	// numalgo 0 and 2 documents are resolved from the DID
+	if isStatic(doc.ID) {
+		return nil
+	}
+
+	deltas, err := v.getDeltas(doc.ID)
+
+	switch {
+	case errors.Is(err, vdriapi.ErrNotFound):
+		// genesis document
+	case err != nil:
+		return fmt.Errorf("delta data fetch from store for did [%s] failed: %w", doc.ID, err)
+	case deltas[len(deltas)-1].Deactivated:
+		return fmt.Errorf("%s: %w", doc.ID, vdriapi.ErrDeactivated)
+	}
 
-	// For now, assume the doc is a genesis document
 	jsonDoc, err := doc.JSONBytes()
 	if err != nil {
 		return fmt.Errorf("JSON marshalling of document failed: %w", err)
@@ -46,39 +62,81 @@ func (v *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
 
 	deltas = append(deltas, *docDelta)
 
-	val, err := json.Marshal(deltas)
-	if err != nil {
-		return fmt.Errorf("JSON marshalling of document deltas failed: %w", err)
-	}
-
-	return v.store.Put(doc.ID, val)
+	return v.putDeltas(doc.ID, deltas)
 }
 
-// Get returns Peer DID Document.
+// Get returns the latest Peer DID Document.
 func (v *VDRI) Get(id string) (*did.Doc, error) {
 	if id == "" {
 		return nil, errors.New("ID is mandatory")
 	}
 
+	if isStatic(id) {
+		return resolveStatic(id)
+	}
+
 	deltas, err := v.getDeltas(id)
 	if err != nil {
 		return nil, fmt.Errorf("delta data fetch from store for did [%s] failed: %w", id, err)
 	}
 
-	// For now, assume storage contains only one delta(genesis document)
-	delta := deltas[0]
+	return deltas[len(deltas)-1].document()
+}
 
-	doc, err := base64.URLEncoding.DecodeString(delta.Change)
+// Update applies patches to the latest Peer DID Document and saves the result as a new delta.
+func (v *VDRI) Update(didID string, patches []vdriapi.Patch, by *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+	deltas, err := v.getLiveDeltas(didID)
 	if err != nil {
-		return nil, fmt.Errorf("decoding of document delta failed: %w", err)
+		return nil, err
 	}
 
-	document, err := did.ParseDocument(doc)
+	doc, err := deltas[len(deltas)-1].document()
 	if err != nil {
-		return nil, fmt.Errorf("document ParseDocument() failed: %w", err)
+		return nil, err
 	}
 
-	return document, nil
+	for _, patch := range patches {
+		if err = patch.Apply(doc); err != nil {
+			return nil, fmt.Errorf("apply patch: %w", err)
+		}
+	}
+
+	now := time.Now()
+	doc.Updated = &now
+
+	jsonDoc, err := doc.JSONBytes()
+	if err != nil {
+		return nil, fmt.Errorf("JSON marshalling of document failed: %w", err)
+	}
+
+	deltas = append(deltas, docDelta{
+		Change:     base64.URLEncoding.EncodeToString(jsonDoc),
+		ModifiedBy: by,
+		ModifiedAt: now,
+	})
+
+	if err = v.putDeltas(didID, deltas); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// Deactivate deactivates Peer DID. Deactivated DID can still be resolved, but it can't be updated.
+func (v *VDRI) Deactivate(didID string, by *[]vdriapi.ModifiedBy) error {
+	deltas, err := v.getLiveDeltas(didID)
+	if err != nil {
+		return err
+	}
+
+	deltas = append(deltas, docDelta{
+		Change:      deltas[len(deltas)-1].Change,
+		ModifiedBy:  by,
+		ModifiedAt:  time.Now(),
+		Deactivated: true,
+	})
+
+	return v.putDeltas(didID, deltas)
 }
 
 // Close frees resources being maintained by vdri.
@@ -103,5 +161,55 @@ func (v *VDRI) getDeltas(id string) ([]docDelta, error) {
 		return nil, fmt.Errorf("JSON unmarshalling of document deltas failed: %w", err)
 	}
 
+	if len(deltas) == 0 {
+		return nil, errors.New("document deltas are missing")
+	}
+
+	return deltas, nil
+}
+
+// getLiveDeltas returns deltas of the DID which is not deactivated.
+func (v *VDRI) getLiveDeltas(id string) ([]docDelta, error) {
+	if id == "" {
+		return nil, errors.New("ID is mandatory")
+	}
+
+	// numalgo 0 and 2 documents can't be changed as they are resolved from the DID
+	if isStatic(id) {
+		return nil, fmt.Errorf("%s: %w", id, vdriapi.ErrOperationNotSupported)
+	}
+
+	deltas, err := v.getDeltas(id)
+	if err != nil {
+		return nil, fmt.Errorf("delta data fetch from store for did [%s] failed: %w", id, err)
+	}
+
+	if deltas[len(deltas)-1].Deactivated {
+		return nil, fmt.Errorf("%s: %w", id, vdriapi.ErrDeactivated)
+	}
+
 	return deltas, nil
 }
+
+func (v *VDRI) putDeltas(id string, deltas []docDelta) error {
+	val, err := json.Marshal(deltas)
+	if err != nil {
+		return fmt.Errorf("JSON marshalling of document deltas failed: %w", err)
+	}
+
+	return v.store.Put(id, val)
+}
+
+func (d *docDelta) document() (*did.Doc, error) {
+	doc, err := base64.URLEncoding.DecodeString(d.Change)
+	if err != nil {
+		return nil, fmt.Errorf("decoding of document delta failed: %w", err)
+	}
+
+	document, err := did.ParseDocument(doc)
+	if err != nil {
+		return nil, fmt.Errorf("document ParseDocument() failed: %w", err)
+	}
+
+	return document, nil
+}
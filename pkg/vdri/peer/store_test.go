@@ -71,6 +71,11 @@ func TestPeerDIDStore(t *testing.T) {
 	require.Nil(t, v)
 	require.Contains(t, err.Error(), "delta data fetch from store")
 
+	// put - not json document
+	err = store.Store(&did.Doc{Context: context, ID: "not-json"}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "delta data fetch from store")
+
 	t.Run("returns vdri.ErrNotFound if did is not resolved", func(t *testing.T) {
 		store, err := New(storage.NewMockStoreProvider())
 		require.NoError(t, err)
@@ -81,6 +86,149 @@ func TestPeerDIDStore(t *testing.T) {
 	})
 }
 
+func TestVDRI_Update(t *testing.T) {
+	context := []string{"https://w3id.org/did/v1"}
+	did1 := "did:peer:1234"
+
+	pubKey := did.PublicKey{
+		ID:         did1 + "#key-1",
+		Type:       "Ed25519VerificationKey2018",
+		Controller: did1,
+		Value:      []byte("public key"),
+	}
+
+	service := did.Service{
+		ID:              did1 + "#did-communication",
+		Type:            "did-communication",
+		ServiceEndpoint: "https://agent.example.com/",
+	}
+
+	t.Run("test update and deactivate", func(t *testing.T) {
+		v, err := New(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+		require.NoError(t, v.Store(&did.Doc{Context: context, ID: did1}, nil))
+
+		doc, err := v.Update(did1, []vdriapi.Patch{
+			&vdriapi.AddPublicKeysPatch{
+				PublicKeys:    []did.PublicKey{pubKey},
+				Relationships: []did.VerificationRelationship{did.Authentication},
+			},
+			&vdriapi.AddServicesPatch{Services: []did.Service{service}},
+		}, &[]vdriapi.ModifiedBy{{Key: "key", Sig: "sig"}})
+		require.NoError(t, err)
+		require.Len(t, doc.PublicKey, 1)
+		require.Len(t, doc.Authentication, 1)
+		require.Len(t, doc.Service, 1)
+		require.NotNil(t, doc.Updated)
+
+		doc, err = v.Update(did1, []vdriapi.Patch{&vdriapi.RemoveServicesPatch{IDs: []string{"#did-communication"}}}, nil)
+		require.NoError(t, err)
+		require.Empty(t, doc.Service)
+
+		latest, err := v.Get(did1)
+		require.NoError(t, err)
+		require.Len(t, latest.PublicKey, 1)
+		require.Empty(t, latest.Service)
+
+		resolution, err := v.Read(did1)
+		require.NoError(t, err)
+		require.Equal(t, "3", resolution.DocumentMetadata.VersionID)
+		require.False(t, resolution.DocumentMetadata.Deactivated)
+
+		resolution, err = v.Read(did1, vdriapi.WithVersionID(2))
+		require.NoError(t, err)
+		require.Len(t, resolution.DIDDocument.Service, 1)
+
+		_, err = v.Read(did1, vdriapi.WithVersionID("invalid"))
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+
+		require.NoError(t, v.Deactivate(did1, nil))
+
+		resolution, err = v.Read(did1)
+		require.NoError(t, err)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+		require.Equal(t, "4", resolution.DocumentMetadata.VersionID)
+		require.Len(t, resolution.DIDDocument.PublicKey, 1)
+
+		resolution, err = v.Read(did1, vdriapi.WithVersionID(1))
+		require.NoError(t, err)
+		require.False(t, resolution.DocumentMetadata.Deactivated)
+		require.Empty(t, resolution.DIDDocument.PublicKey)
+
+		_, err = v.Update(did1, []vdriapi.Patch{&vdriapi.RemovePublicKeysPatch{IDs: []string{pubKey.ID}}}, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+
+		err = v.Deactivate(did1, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+	})
+
+	t.Run("test store", func(t *testing.T) {
+		v, err := New(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+		require.NoError(t, v.Store(&did.Doc{Context: context, ID: did1}, nil))
+		require.NoError(t, v.Store(&did.Doc{Context: context, ID: did1, Service: []did.Service{service}}, nil))
+
+		// the stored document is a new version
+		resolution, err := v.Read(did1)
+		require.NoError(t, err)
+		require.Equal(t, "2", resolution.DocumentMetadata.VersionID)
+		require.Len(t, resolution.DIDDocument.Service, 1)
+
+		require.NoError(t, v.Deactivate(did1, nil))
+
+		err = v.Store(&did.Doc{Context: context, ID: did1}, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+
+		resolution, err = v.Read(did1)
+		require.NoError(t, err)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+		require.Len(t, resolution.DIDDocument.Service, 1)
+	})
+
+	t.Run("test patch error", func(t *testing.T) {
+		v, err := New(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+		require.NoError(t, v.Store(&did.Doc{Context: context, ID: did1}, nil))
+
+		_, err = v.Update(did1, []vdriapi.Patch{&vdriapi.RemovePublicKeysPatch{IDs: []string{pubKey.ID}}}, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "apply patch")
+
+		resolution, err := v.Read(did1)
+		require.NoError(t, err)
+		require.Equal(t, "1", resolution.DocumentMetadata.VersionID)
+	})
+
+	t.Run("test DID not found", func(t *testing.T) {
+		v, err := New(storage.NewMockStoreProvider())
+		require.NoError(t, err)
+
+		_, err = v.Update(did1, nil, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+
+		err = v.Deactivate(did1, nil)
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+
+		_, err = v.Update("", nil, nil)
+		require.EqualError(t, err, "ID is mandatory")
+	})
+
+	t.Run("test put error", func(t *testing.T) {
+		store := &storage.MockStore{Store: make(map[string][]byte)}
+		v, err := New(&storage.MockStoreProvider{Store: store})
+		require.NoError(t, err)
+		require.NoError(t, v.Store(&did.Doc{Context: context, ID: did1}, nil))
+
+		store.ErrPut = errors.New("put error")
+
+		_, err = v.Update(did1, []vdriapi.Patch{&vdriapi.AddServicesPatch{Services: []did.Service{service}}}, nil)
+		require.EqualError(t, err, "put error")
+
+		err = v.Deactivate(did1, nil)
+		require.EqualError(t, err, "put error")
+	})
+}
+
 func TestVDRI_Close(t *testing.T) {
 	t.Run("test success", func(t *testing.T) {
 		v, err := New(&storage.MockStoreProvider{})
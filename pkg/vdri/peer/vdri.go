@@ -19,17 +19,43 @@ const (
 
 // VDRI implements building new peer dids.
 type VDRI struct {
-	store storage.Store
+	store   storage.Store
+	numAlgo int
 }
 
+// Option configures the peer vdri.
+type Option func(opts *VDRI)
+
 // New return new instance of peer vdri.
-func New(s storage.Provider) (*VDRI, error) {
+func New(s storage.Provider, opts ...Option) (*VDRI, error) {
+	v := &VDRI{numAlgo: 1}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	if v.numAlgo != 0 && v.numAlgo != 1 && v.numAlgo != 2 {
+		return nil, fmt.Errorf("unsupported numalgo: %d", v.numAlgo)
+	}
+
 	didDBStore, err := s.OpenStore(StoreNamespace)
 	if err != nil {
 		return nil, fmt.Errorf("open store : %w", err)
 	}
 
-	return &VDRI{store: didDBStore}, nil
+	v.store = didDBStore
+
+	return v, nil
+}
+
+// WithNumAlgo option is for the numalgo of the built peer DIDs. Numalgo 1 (default) DIDs are derived from
+// the stored genesis document, numalgo 0 DIDs hold the inception key and numalgo 2 DIDs hold the keys
+// and the service. Numalgo 0 DIDs have no service, so they can't be built with the service options
+// (e.g. for DID exchange). Peer DIDs of all numalgos are resolved.
+func WithNumAlgo(numAlgo int) Option {
+	return func(opts *VDRI) {
+		opts.numAlgo = numAlgo
+	}
 }
 
 // Accept did method.
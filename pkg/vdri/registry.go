@@ -39,6 +39,7 @@ type Registry struct {
 	kms                kms.KeyManager
 	defServiceEndpoint string
 	defServiceType     string
+	cache              *ResolutionCache
 }
 
 // New return new instance of vdri.
@@ -53,7 +54,8 @@ func New(ctx provider, opts ...Option) *Registry {
 	return baseVDRI
 }
 
-// Resolve did document.
+// Resolve did document. Deactivated DIDs are not resolved (vdriapi.ErrDeactivated is returned), use
+// ResolveWithMetadata to obtain the document of deactivated DID.
 func (r *Registry) Resolve(did string, opts ...vdriapi.ResolveOpts) (*diddoc.Doc, error) {
 	resolveOpts := &vdriapi.ResolveDIDOpts{}
 	// Apply options
@@ -61,33 +63,75 @@ func (r *Registry) Resolve(did string, opts ...vdriapi.ResolveOpts) (*diddoc.Doc
 		opt(resolveOpts)
 	}
 
-	didMethod, err := getDidMethod(did)
+	if resolveOpts.ResultType == vdriapi.ResolutionResult {
+		return nil, errors.New("result type 'resolution-result' not supported, use ResolveWithMetadata")
+	}
+
+	resolution, err := r.ResolveWithMetadata(did, opts...)
 	if err != nil {
 		return nil, err
 	}
 
+	if resolution.DocumentMetadata != nil && resolution.DocumentMetadata.Deactivated {
+		return nil, fmt.Errorf("resolve %s: %w", did, vdriapi.ErrDeactivated)
+	}
+
+	return resolution.DIDDocument, nil
+}
+
+// ResolveWithMetadata resolves did document along with the resolution and document metadata
+// (https://w3c-ccg.github.io/did-resolution/#did-resolution-result). If resolution fails, the returned
+// resolution result holds the error code in its resolution metadata.
+func (r *Registry) ResolveWithMetadata(did string, opts ...vdriapi.ResolveOpts) (*diddoc.DocResolution, error) {
+	resolveOpts := &vdriapi.ResolveDIDOpts{}
+	// Apply options
+	for _, opt := range opts {
+		opt(resolveOpts)
+	}
+
+	// only the latest version of the DID document is cached
+	cacheable := r.cache != nil && resolveOpts.VersionID == nil && resolveOpts.VersionTime == ""
+
+	if cacheable && !resolveOpts.NoCache {
+		if resolution, ok := r.cache.Get(did); ok {
+			return resolution, nil
+		}
+	}
+
+	didMethod, err := getDidMethod(did)
+	if err != nil {
+		return diddoc.NewResolutionError(diddoc.ResolutionErrorInvalidDID, err), err
+	}
+
 	// resolve did method
 	method, err := r.resolveVDRI(didMethod)
 	if err != nil {
-		return nil, err
+		return diddoc.NewResolutionError(diddoc.ResolutionErrorMethodNotSupported, err), err
 	}
 
 	// Obtain the DID Document
-	didDoc, err := method.Read(did, opts...)
+	resolution, err := method.Read(did, opts...)
 	if err != nil {
 		if errors.Is(err, vdriapi.ErrNotFound) {
-			return nil, err
+			return diddoc.NewResolutionError(diddoc.ResolutionErrorNotFound, err), err
 		}
 
-		return nil, fmt.Errorf("did method read failed failed: %w", err)
+		err = fmt.Errorf("did method read failed failed: %w", err)
+
+		return diddoc.NewResolutionError(diddoc.ResolutionErrorInternal, err), err
 	}
 
-	if resolveOpts.ResultType == vdriapi.ResolutionResult {
-		// TODO https://github.com/hyperledger/aries-framework-go/issues/745 Support resolution-result
-		return nil, errors.New("result type 'resolution-result' not supported")
+	if resolution == nil || resolution.DIDDocument == nil {
+		err = fmt.Errorf("did method read failed failed: %w", vdriapi.ErrNotFound)
+
+		return diddoc.NewResolutionError(diddoc.ResolutionErrorNotFound, err), err
 	}
 
-	return didDoc, nil
+	if cacheable {
+		r.cache.Put(did, resolution)
+	}
+
+	return resolution, nil
 }
 
 // Create a new DID Document and store it in this registry.
@@ -168,7 +212,56 @@ func (r *Registry) Store(doc *diddoc.Doc) error {
 		return err
 	}
 
-	return method.Store(doc, nil)
+	if err := method.Store(doc, nil); err != nil {
+		return err
+	}
+
+	if r.cache != nil {
+		r.cache.Invalidate(doc.ID)
+	}
+
+	return nil
+}
+
+// Update applies patches to the DID document and returns the updated document.
+func (r *Registry) Update(did string, patches ...vdriapi.Patch) (*diddoc.Doc, error) {
+	if len(patches) == 0 {
+		return nil, errors.New("at least one patch is required")
+	}
+
+	method, err := r.vdriOf(did)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := method.Update(did, patches, nil)
+	if err != nil {
+		return nil, fmt.Errorf("did method update failed: %w", err)
+	}
+
+	if r.cache != nil {
+		r.cache.Invalidate(did)
+	}
+
+	return doc, nil
+}
+
+// Deactivate deactivates the DID.
+func (r *Registry) Deactivate(did string) error {
+	method, err := r.vdriOf(did)
+	if err != nil {
+		return err
+	}
+
+	if err := method.Deactivate(did, nil); err != nil {
+		return fmt.Errorf("did method deactivate failed: %w", err)
+	}
+
+	if r.cache != nil {
+		r.cache.Invalidate(did)
+	}
+
+	return nil
 }
 
 // Close frees resources being maintained by vdri.
@@ -182,6 +275,15 @@ func (r *Registry) Close() error {
 	return nil
 }
 
+func (r *Registry) vdriOf(did string) (vdriapi.VDRI, error) {
+	didMethod, err := getDidMethod(did)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.resolveVDRI(didMethod)
+}
+
 func (r *Registry) resolveVDRI(method string) (vdriapi.VDRI, error) {
 	for _, v := range r.vdri {
 		if v.Accept(method) {
@@ -199,6 +301,14 @@ func WithVDRI(method vdriapi.VDRI) Option {
 	}
 }
 
+// WithResolutionCache enables caching of DID resolution results. Resolution requests with WithNoCache option
+// bypass the cache, storing DID document through the registry invalidates its cached resolution.
+func WithResolutionCache(cache *ResolutionCache) Option {
+	return func(opts *Registry) {
+		opts.cache = cache
+	}
+}
+
 // WithDefaultServiceType is default service type for this creator.
 func WithDefaultServiceType(serviceType string) Option {
 	return func(opts *Registry) {
@@ -7,6 +7,7 @@ SPDX-License-Identifier: Apache-2.0
 package vdri
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -69,7 +70,7 @@ func TestRegistry_Resolve(t *testing.T) {
 
 	t.Run("test DID not found", func(t *testing.T) {
 		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
-			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error) {
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
 				return nil, vdriapi.ErrNotFound
 			}}))
 		doc, err := registry.Resolve("1:id:123")
@@ -80,7 +81,7 @@ func TestRegistry_Resolve(t *testing.T) {
 
 	t.Run("test error from resolve did", func(t *testing.T) {
 		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
-			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error) {
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
 				return nil, fmt.Errorf("read error")
 			}}))
 		doc, err := registry.Resolve("1:id:123")
@@ -92,21 +93,56 @@ func TestRegistry_Resolve(t *testing.T) {
 	t.Run("test ResultType", func(t *testing.T) {
 		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: true}))
 		doc, err := registry.Resolve("1:id:123", vdriapi.WithResultType(vdriapi.ResolutionResult))
-		require.Error(t, err)
-		require.Contains(t, err.Error(), "result type 'resolution-result' not supported")
+		require.EqualError(t, err, "result type 'resolution-result' not supported, use ResolveWithMetadata")
+		require.Nil(t, doc)
+
+		doc, err = registry.Resolve("1:id:123", vdriapi.WithResultType(vdriapi.DidDocumentResult))
+		require.NoError(t, err)
+		require.Equal(t, "1:id:123", doc.ID)
+
+		resolution, err := registry.ResolveWithMetadata("1:id:123", vdriapi.WithResultType(vdriapi.ResolutionResult))
+		require.NoError(t, err)
+		require.Equal(t, "1:id:123", resolution.DIDDocument.ID)
+	})
+
+	t.Run("test deactivated DID", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				resolution := did.NewDocResolution(&did.Doc{ID: didID})
+				resolution.DocumentMetadata.Deactivated = true
+
+				return resolution, nil
+			}}))
+		doc, err := registry.Resolve("1:id:123")
+		require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+		require.Nil(t, doc)
+
+		resolution, err := registry.ResolveWithMetadata("1:id:123")
+		require.NoError(t, err)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+		require.Equal(t, "1:id:123", resolution.DIDDocument.ID)
+	})
+
+	t.Run("test empty resolution", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				return nil, nil
+			}}))
+		doc, err := registry.Resolve("1:id:123")
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
 		require.Nil(t, doc)
 	})
 
 	t.Run("test opts passed", func(t *testing.T) {
 		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
-			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.Doc, error) {
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
 				resolveOpts := &vdriapi.ResolveDIDOpts{}
 				// Apply options
 				for _, opt := range opts {
 					opt(resolveOpts)
 				}
 				require.Equal(t, "1", resolveOpts.VersionID)
-				return nil, nil
+				return did.NewDocResolution(&did.Doc{ID: didID}), nil
 			}}))
 		_, err := registry.Resolve("1:id:123", vdriapi.WithVersionID("1"))
 		require.NoError(t, err)
@@ -119,6 +155,191 @@ func TestRegistry_Resolve(t *testing.T) {
 	})
 }
 
+func TestRegistry_ResolveWithMetadata(t *testing.T) {
+	t.Run("test invalid did input", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{})
+		resolution, err := registry.ResolveWithMetadata("id")
+		require.Error(t, err)
+		require.Nil(t, resolution.DIDDocument)
+		require.Equal(t, did.ResolutionErrorInvalidDID, resolution.ResolutionMetadata.Error)
+	})
+
+	t.Run("test did method not supported", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: false}))
+		resolution, err := registry.ResolveWithMetadata("1:id:123")
+		require.Error(t, err)
+		require.Equal(t, did.ResolutionErrorMethodNotSupported, resolution.ResolutionMetadata.Error)
+	})
+
+	t.Run("test DID not found", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				return nil, vdriapi.ErrNotFound
+			}}))
+		resolution, err := registry.ResolveWithMetadata("1:id:123")
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+		require.Equal(t, did.ResolutionErrorNotFound, resolution.ResolutionMetadata.Error)
+	})
+
+	t.Run("test error from resolve did", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+				return nil, fmt.Errorf("read error")
+			}}))
+		resolution, err := registry.ResolveWithMetadata("1:id:123")
+		require.Error(t, err)
+		require.Equal(t, did.ResolutionErrorInternal, resolution.ResolutionMetadata.Error)
+		require.Contains(t, resolution.ResolutionMetadata.Message, "read error")
+	})
+
+	t.Run("test success", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: true}))
+		resolution, err := registry.ResolveWithMetadata("1:id:123")
+		require.NoError(t, err)
+		require.Equal(t, "1:id:123", resolution.DIDDocument.ID)
+		require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+		require.Empty(t, resolution.ResolutionMetadata.Error)
+	})
+}
+
+func TestRegistry_ResolveWithCache(t *testing.T) {
+	reads := 0
+
+	cache, err := NewResolutionCache()
+	require.NoError(t, err)
+
+	registry := New(&mockprovider.Provider{}, WithResolutionCache(cache), WithVDRI(&mockvdri.MockVDRI{
+		AcceptValue: true, ReadFunc: func(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+			reads++
+
+			if didID == "1:id:unknown" {
+				return nil, vdriapi.ErrNotFound
+			}
+
+			return did.NewDocResolution(&did.Doc{ID: didID}), nil
+		}}))
+
+	for i := 0; i < 2; i++ {
+		doc, err := registry.Resolve("1:id:123")
+		require.NoError(t, err)
+		require.Equal(t, "1:id:123", doc.ID)
+	}
+
+	require.Equal(t, 1, reads)
+
+	_, err = registry.Resolve("1:id:123", vdriapi.WithNoCache(true))
+	require.NoError(t, err)
+	require.Equal(t, 2, reads)
+
+	_, err = registry.Resolve("1:id:123", vdriapi.WithVersionID("1"))
+	require.NoError(t, err)
+	require.Equal(t, 3, reads)
+
+	require.NoError(t, registry.Store(&did.Doc{ID: "1:id:123"}))
+
+	_, err = registry.Resolve("1:id:123")
+	require.NoError(t, err)
+	require.Equal(t, 4, reads)
+
+	// resolution errors are not cached
+	for i := 0; i < 2; i++ {
+		_, err = registry.Resolve("1:id:unknown")
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+	}
+
+	require.Equal(t, 6, reads)
+	require.Equal(t, uint64(1), cache.Metrics().Hits)
+	require.Equal(t, uint64(1), cache.Metrics().Invalidations)
+}
+
+func TestRegistry_Update(t *testing.T) {
+	patch := &vdriapi.AddServicesPatch{Services: []did.Service{{ID: "#svc"}}}
+
+	t.Run("test no patches", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: true}))
+		_, err := registry.Update("1:id:123")
+		require.EqualError(t, err, "at least one patch is required")
+	})
+
+	t.Run("test invalid did input", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{})
+		_, err := registry.Update("id", patch)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "wrong format did input")
+	})
+
+	t.Run("test did method not supported", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: false}))
+		_, err := registry.Update("1:id:123", patch)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "did method id not supported for vdri")
+	})
+
+	t.Run("test update error", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true,
+			UpdateFunc: func(string, []vdriapi.Patch, *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+				return nil, vdriapi.ErrOperationNotSupported
+			},
+		}))
+		_, err := registry.Update("1:id:123", patch)
+		require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+	})
+
+	t.Run("test success", func(t *testing.T) {
+		cache, err := NewResolutionCache()
+		require.NoError(t, err)
+
+		registry := New(&mockprovider.Provider{}, WithResolutionCache(cache), WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true,
+			UpdateFunc: func(didID string, patches []vdriapi.Patch, _ *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+				require.Equal(t, []vdriapi.Patch{patch}, patches)
+
+				return &did.Doc{ID: didID}, nil
+			},
+		}))
+
+		_, err = registry.Resolve("1:id:123")
+		require.NoError(t, err)
+
+		doc, err := registry.Update("1:id:123", patch)
+		require.NoError(t, err)
+		require.Equal(t, "1:id:123", doc.ID)
+		require.Equal(t, 0, cache.Metrics().Entries)
+	})
+}
+
+func TestRegistry_Deactivate(t *testing.T) {
+	t.Run("test did method not supported", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{AcceptValue: false}))
+		err := registry.Deactivate("1:id:123")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "did method id not supported for vdri")
+	})
+
+	t.Run("test deactivate error", func(t *testing.T) {
+		registry := New(&mockprovider.Provider{}, WithVDRI(&mockvdri.MockVDRI{
+			AcceptValue: true, DeactivateErr: vdriapi.ErrDeactivated,
+		}))
+		err := registry.Deactivate("1:id:123")
+		require.True(t, errors.Is(err, vdriapi.ErrDeactivated))
+	})
+
+	t.Run("test success", func(t *testing.T) {
+		cache, err := NewResolutionCache()
+		require.NoError(t, err)
+
+		registry := New(&mockprovider.Provider{}, WithResolutionCache(cache),
+			WithVDRI(&mockvdri.MockVDRI{AcceptValue: true}))
+
+		_, err = registry.Resolve("1:id:123")
+		require.NoError(t, err)
+
+		require.NoError(t, registry.Deactivate("1:id:123"))
+		require.Equal(t, 0, cache.Metrics().Entries)
+	})
+}
+
 func TestRegistry_Store(t *testing.T) {
 	t.Run("test invalid did input", func(t *testing.T) {
 		registry := New(&mockprovider.Provider{})
@@ -0,0 +1,225 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/sidetree/internal/protocol"
+)
+
+const (
+	defaultKeyID     = "key-1"
+	defaultServiceID = "agent"
+	ed25519KeyType   = "Ed25519VerificationKey2018"
+)
+
+// Build creates new DID document by submitting create operation to the Sidetree node. The public key
+// is used for authentication and assertion. New update and recovery keys are created by the KMS.
+func (v *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error) {
+	if pubKey == nil || pubKey.Value == "" {
+		return nil, errors.New("build sidetree DID: public key is mandatory")
+	}
+
+	docOpts := &vdriapi.CreateDIDOpts{}
+	// Apply options
+	for _, opt := range opts {
+		opt(docOpts)
+	}
+
+	keyID := protocol.Fragment(pubKey.ID)
+	if keyID == "" {
+		keyID = defaultKeyID
+	}
+
+	jwk, err := publicKeyJWK(pubKey.Type, base58.Decode(pubKey.Value), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build sidetree DID: %w", err)
+	}
+
+	document := &protocol.Document{
+		PublicKeys: []protocol.PublicKey{{
+			ID:      keyID,
+			Type:    pubKey.Type,
+			Purpose: []string{protocol.PurposeGeneral, protocol.PurposeAuth, protocol.PurposeAssertion},
+			JWK:     jwk,
+		}},
+	}
+
+	if docOpts.ServiceType != "" {
+		document.ServiceEndpoints = []protocol.Service{{
+			ID:       defaultServiceID,
+			Type:     docOpts.ServiceType,
+			Endpoint: docOpts.ServiceEndpoint,
+		}}
+	}
+
+	doc, err := v.create(document)
+	if err != nil {
+		return nil, fmt.Errorf("build sidetree DID: %w", err)
+	}
+
+	return doc, nil
+}
+
+func (v *VDRI) create(document *protocol.Document) (*did.Doc, error) {
+	updateKey, err := v.newOperationKey()
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryKey, err := v.newOperationKey()
+	if err != nil {
+		return nil, err
+	}
+
+	updateCommitment, err := protocol.Commitment(updateKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCommitment, err := protocol.Commitment(recoveryKey.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+
+	delta, deltaHash, err := protocol.Encode(&protocol.Delta{
+		UpdateCommitment: updateCommitment,
+		Patches:          []protocol.Patch{{Action: protocol.PatchActionReplace, Document: document}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode delta: %w", err)
+	}
+
+	suffixData, suffix, err := protocol.Encode(&protocol.SuffixData{
+		DeltaHash:          deltaHash,
+		RecoveryCommitment: recoveryCommitment,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode suffix data: %w", err)
+	}
+
+	didID := v.namespace + ":" + suffix
+
+	respBody, err := v.submit(&protocol.CreateRequest{
+		Operation:  protocol.OperationTypeCreate,
+		SuffixData: suffixData,
+		Delta:      delta,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the node has accepted the operation, so the keys must be kept even if the response is invalid
+	err = v.putKeys(didID, &operationKeys{
+		UpdateKeyID:   updateKey.keyID,
+		RecoveryKeyID: recoveryKey.keyID,
+		KeyType:       v.keyType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolution, err := v.operationResolution(didID, respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolution.DIDDocument == nil || resolution.DIDDocument.ID != didID {
+		return nil, fmt.Errorf("sidetree node created unexpected DID document, expected %s", didID)
+	}
+
+	return resolution.DIDDocument, nil
+}
+
+// toDocument converts DID document to the Sidetree document. Purposes of the public keys
+// are taken from the verification methods referencing them.
+func toDocument(doc *did.Doc) (*protocol.Document, error) {
+	document := &protocol.Document{}
+	methods := doc.VerificationMethods()
+
+	for i := range doc.PublicKey {
+		pk := &doc.PublicKey[i]
+
+		purposes := []string{protocol.PurposeGeneral}
+
+		for _, relationship := range []did.VerificationRelationship{did.Authentication, did.AssertionMethod,
+			did.KeyAgreement, did.CapabilityDelegation, did.CapabilityInvocation} {
+			for _, vm := range methods[relationship] {
+				if protocol.Fragment(vm.PublicKey.ID) == protocol.Fragment(pk.ID) {
+					purpose, _ := protocol.Purpose(relationship)
+					purposes = append(purposes, purpose)
+
+					break
+				}
+			}
+		}
+
+		publicKey, err := toPublicKey(pk, purposes)
+		if err != nil {
+			return nil, err
+		}
+
+		document.PublicKeys = append(document.PublicKeys, *publicKey)
+	}
+
+	document.ServiceEndpoints = toServices(doc.Service)
+
+	return document, nil
+}
+
+func toPublicKey(pk *did.PublicKey, purposes []string) (*protocol.PublicKey, error) {
+	jwk, err := publicKeyJWK(pk.Type, pk.Value, pk.JSONWebKey())
+	if err != nil {
+		return nil, fmt.Errorf("public key %s: %w", pk.ID, err)
+	}
+
+	return &protocol.PublicKey{
+		ID:      protocol.Fragment(pk.ID),
+		Type:    pk.Type,
+		Purpose: purposes,
+		JWK:     jwk,
+	}, nil
+}
+
+func toServices(services []did.Service) []protocol.Service {
+	var result []protocol.Service
+
+	for _, svc := range services {
+		result = append(result, protocol.Service{
+			ID:       protocol.Fragment(svc.ID),
+			Type:     svc.Type,
+			Endpoint: svc.ServiceEndpoint,
+		})
+	}
+
+	return result
+}
+
+// publicKeyJWK returns JWK of the public key, Sidetree documents hold public keys as JWK only.
+func publicKeyJWK(keyType string, value []byte, jwk *jose.JWK) (*jose.JWK, error) {
+	if jwk != nil {
+		return jwk, nil
+	}
+
+	if keyType != ed25519KeyType {
+		return nil, fmt.Errorf("public key type %s is not supported without JWK", keyType)
+	}
+
+	if len(value) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid Ed25519 public key")
+	}
+
+	return jose.JWKFromPublicKey(ed25519.PublicKey(value))
+}
@@ -0,0 +1,523 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package node provides an in-process Sidetree node for the unit tests of the sidetree VDRI.
+// The node applies the operations immediately, there is no batching nor anchoring.
+package node
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/sidetree/internal/protocol"
+)
+
+const (
+	didContext = "https://www.w3.org/ns/did/v1"
+
+	// OperationsPath is the path of the operations endpoint.
+	OperationsPath = "/operations"
+	// IdentifiersPath is the path of the resolution endpoint.
+	IdentifiersPath = "/identifiers/"
+)
+
+var errNotFound = errors.New("document not found")
+
+type document struct {
+	doc                protocol.Document
+	updateCommitment   string
+	recoveryCommitment string
+	created            time.Time
+	updated            time.Time
+	version            int
+	deactivated        bool
+}
+
+// Node is the in-process Sidetree node.
+type Node struct {
+	server    *httptest.Server
+	namespace string
+
+	mutex sync.RWMutex
+	docs  map[string]*document
+}
+
+// New starts the Sidetree node of the given DID namespace (e.g. did:sidetree).
+func New(namespace string) *Node {
+	n := &Node{namespace: namespace, docs: make(map[string]*document)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OperationsPath, n.operations)
+	mux.HandleFunc(IdentifiersPath, n.identifiers)
+
+	n.server = httptest.NewServer(mux)
+
+	return n
+}
+
+// URL returns base URL of the node.
+func (n *Node) URL() string {
+	return n.server.URL
+}
+
+// Close stops the node.
+func (n *Node) Close() {
+	n.server.Close()
+}
+
+func (n *Node) operations(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var operation struct {
+		Type string `json:"type"`
+	}
+
+	if err = json.Unmarshal(body, &operation); err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	var suffix string
+
+	switch operation.Type {
+	case protocol.OperationTypeCreate:
+		suffix, err = n.create(body)
+	case protocol.OperationTypeUpdate:
+		suffix, err = n.update(body)
+	case protocol.OperationTypeRecover:
+		suffix, err = n.recover(body)
+	case protocol.OperationTypeDeactivate:
+		suffix, err = n.deactivate(body)
+	default:
+		err = fmt.Errorf("unsupported operation type: %s", operation.Type)
+	}
+
+	if errors.Is(err, errNotFound) {
+		http.Error(rw, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	n.writeResolution(rw, suffix)
+}
+
+func (n *Node) identifiers(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	didID := strings.TrimPrefix(req.URL.Path, IdentifiersPath)
+
+	if !strings.HasPrefix(didID, n.namespace+":") {
+		http.Error(rw, "unknown namespace", http.StatusBadRequest)
+		return
+	}
+
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	n.writeResolution(rw, strings.TrimPrefix(didID, n.namespace+":"))
+}
+
+func (n *Node) writeResolution(rw http.ResponseWriter, suffix string) {
+	d, ok := n.docs[suffix]
+	if !ok {
+		http.Error(rw, errNotFound.Error(), http.StatusNotFound)
+		return
+	}
+
+	resolution, err := n.resolution(suffix, d)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := resolution.JSONBytes()
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", `application/ld+json;profile="https://w3id.org/did-resolution"`)
+
+	if _, err = rw.Write(data); err != nil {
+		panic(err)
+	}
+}
+
+func (n *Node) create(body []byte) (string, error) {
+	var req protocol.CreateRequest
+
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+
+	var suffixData protocol.SuffixData
+
+	suffix, err := protocol.Decode(req.SuffixData, &suffixData)
+	if err != nil {
+		return "", fmt.Errorf("suffix data: %w", err)
+	}
+
+	if _, ok := n.docs[suffix]; ok {
+		return "", fmt.Errorf("document %s already exists", suffix)
+	}
+
+	var delta protocol.Delta
+
+	if err = decodeDelta(req.Delta, suffixData.DeltaHash, &delta); err != nil {
+		return "", err
+	}
+
+	d := &document{recoveryCommitment: suffixData.RecoveryCommitment, created: time.Now()}
+
+	if err = d.apply(&delta); err != nil {
+		return "", err
+	}
+
+	n.docs[suffix] = d
+
+	return suffix, nil
+}
+
+func (n *Node) update(body []byte) (string, error) {
+	var req protocol.UpdateRequest
+
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+
+	d, err := n.live(req.DIDSuffix)
+	if err != nil {
+		return "", err
+	}
+
+	var signedData protocol.UpdateSignedData
+
+	err = verify(req.SignedData, &signedData, func() *jose.JWK { return signedData.UpdateKey }, d.updateCommitment)
+	if err != nil {
+		return "", fmt.Errorf("update: %w", err)
+	}
+
+	var delta protocol.Delta
+
+	if err = decodeDelta(req.Delta, signedData.DeltaHash, &delta); err != nil {
+		return "", err
+	}
+
+	// patches are applied to a copy, the document is not modified if any patch fails
+	updated := *d
+
+	if err = updated.apply(&delta); err != nil {
+		return "", err
+	}
+
+	n.docs[req.DIDSuffix] = &updated
+
+	return req.DIDSuffix, nil
+}
+
+func (n *Node) recover(body []byte) (string, error) {
+	var req protocol.RecoverRequest
+
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+
+	d, err := n.live(req.DIDSuffix)
+	if err != nil {
+		return "", err
+	}
+
+	var signedData protocol.RecoverSignedData
+
+	err = verify(req.SignedData, &signedData, func() *jose.JWK { return signedData.RecoveryKey }, d.recoveryCommitment)
+	if err != nil {
+		return "", fmt.Errorf("recover: %w", err)
+	}
+
+	var delta protocol.Delta
+
+	if err = decodeDelta(req.Delta, signedData.DeltaHash, &delta); err != nil {
+		return "", err
+	}
+
+	recovered := &document{
+		recoveryCommitment: signedData.RecoveryCommitment,
+		created:            d.created,
+		version:            d.version,
+	}
+
+	if err = recovered.apply(&delta); err != nil {
+		return "", err
+	}
+
+	n.docs[req.DIDSuffix] = recovered
+
+	return req.DIDSuffix, nil
+}
+
+func (n *Node) deactivate(body []byte) (string, error) {
+	var req protocol.DeactivateRequest
+
+	if err := json.Unmarshal(body, &req); err != nil {
+		return "", err
+	}
+
+	d, err := n.live(req.DIDSuffix)
+	if err != nil {
+		return "", err
+	}
+
+	var signedData protocol.DeactivateSignedData
+
+	err = verify(req.SignedData, &signedData, func() *jose.JWK { return signedData.RecoveryKey }, d.recoveryCommitment)
+	if err != nil {
+		return "", fmt.Errorf("deactivate: %w", err)
+	}
+
+	if signedData.DIDSuffix != req.DIDSuffix {
+		return "", errors.New("deactivate: signed DID suffix does not match")
+	}
+
+	d.deactivated = true
+	d.updated = time.Now()
+	d.version++
+
+	return req.DIDSuffix, nil
+}
+
+func (n *Node) live(suffix string) (*document, error) {
+	d, ok := n.docs[suffix]
+	if !ok {
+		return nil, fmt.Errorf("%s: %w", suffix, errNotFound)
+	}
+
+	if d.deactivated {
+		return nil, fmt.Errorf("document %s is deactivated", suffix)
+	}
+
+	return d, nil
+}
+
+func (n *Node) resolution(suffix string, d *document) (*did.DocResolution, error) {
+	didID := n.namespace + ":" + suffix
+
+	doc := &did.Doc{Context: []string{didContext}, ID: didID}
+
+	if !d.deactivated {
+		if err := populate(doc, &d.doc); err != nil {
+			return nil, err
+		}
+	}
+
+	resolution := did.NewDocResolution(doc)
+	resolution.DocumentMetadata.Created = &d.created
+	resolution.DocumentMetadata.Deactivated = d.deactivated
+	resolution.DocumentMetadata.VersionID = strconv.Itoa(d.version)
+	resolution.DocumentMetadata.Method = map[string]interface{}{
+		"published":          true,
+		"updateCommitment":   d.updateCommitment,
+		"recoveryCommitment": d.recoveryCommitment,
+	}
+
+	if !d.updated.IsZero() {
+		resolution.DocumentMetadata.Updated = &d.updated
+	}
+
+	return resolution, nil
+}
+
+// populate fills DID document with the public keys and the services of the Sidetree document.
+func populate(doc *did.Doc, sidetreeDoc *protocol.Document) error {
+	for _, pk := range sidetreeDoc.PublicKeys {
+		publicKey, err := did.NewPublicKeyFromJWK(doc.ID+"#"+pk.ID, pk.Type, doc.ID, pk.JWK)
+		if err != nil {
+			return err
+		}
+
+		doc.PublicKey = append(doc.PublicKey, *publicKey)
+
+		for _, purpose := range pk.Purpose {
+			relationship, _ := protocol.Relationship(purpose)
+			vm := *did.NewReferencedVerificationMethod(publicKey, relationship, false)
+
+			switch relationship {
+			case did.Authentication:
+				doc.Authentication = append(doc.Authentication, vm)
+			case did.AssertionMethod:
+				doc.AssertionMethod = append(doc.AssertionMethod, vm)
+			case did.KeyAgreement:
+				doc.KeyAgreement = append(doc.KeyAgreement, vm)
+			case did.CapabilityDelegation:
+				doc.CapabilityDelegation = append(doc.CapabilityDelegation, vm)
+			case did.CapabilityInvocation:
+				doc.CapabilityInvocation = append(doc.CapabilityInvocation, vm)
+			case did.VerificationRelationshipGeneral:
+			}
+		}
+	}
+
+	for _, svc := range sidetreeDoc.ServiceEndpoints {
+		doc.Service = append(doc.Service, did.Service{
+			ID:              doc.ID + "#" + svc.ID,
+			Type:            svc.Type,
+			ServiceEndpoint: svc.Endpoint,
+		})
+	}
+
+	return nil
+}
+
+func (d *document) apply(delta *protocol.Delta) error {
+	doc := protocol.Document{
+		PublicKeys:       append([]protocol.PublicKey(nil), d.doc.PublicKeys...),
+		ServiceEndpoints: append([]protocol.Service(nil), d.doc.ServiceEndpoints...),
+	}
+
+	for i := range delta.Patches {
+		if err := applyPatch(&doc, &delta.Patches[i]); err != nil {
+			return err
+		}
+	}
+
+	// the created document has no updated time
+	if d.version > 0 {
+		d.updated = time.Now()
+	}
+
+	d.doc = doc
+	d.updateCommitment = delta.UpdateCommitment
+	d.version++
+
+	return nil
+}
+
+func applyPatch(doc *protocol.Document, patch *protocol.Patch) error { // nolint: gocyclo
+	switch patch.Action {
+	case protocol.PatchActionReplace:
+		if patch.Document == nil {
+			return errors.New("replace patch: document is missing")
+		}
+
+		*doc = *patch.Document
+	case protocol.PatchActionAddPublicKeys:
+		for _, pk := range patch.PublicKeys {
+			if pk.ID == "" || pk.JWK == nil {
+				return errors.New("add public keys patch: public key ID and JWK are mandatory")
+			}
+
+			for _, existing := range doc.PublicKeys {
+				if existing.ID == pk.ID {
+					return fmt.Errorf("add public keys patch: public key %s already exists", pk.ID)
+				}
+			}
+
+			doc.PublicKeys = append(doc.PublicKeys, pk)
+		}
+	case protocol.PatchActionRemovePublicKeys:
+		var keys []protocol.PublicKey
+
+		for _, pk := range doc.PublicKeys {
+			if !contains(patch.IDs, pk.ID) {
+				keys = append(keys, pk)
+			}
+		}
+
+		doc.PublicKeys = keys
+	case protocol.PatchActionAddServiceEndpoints:
+		for _, svc := range patch.ServiceEndpoints {
+			for _, existing := range doc.ServiceEndpoints {
+				if existing.ID == svc.ID {
+					return fmt.Errorf("add service endpoints patch: service %s already exists", svc.ID)
+				}
+			}
+
+			doc.ServiceEndpoints = append(doc.ServiceEndpoints, svc)
+		}
+	case protocol.PatchActionRemoveServiceEndpoints:
+		var services []protocol.Service
+
+		for _, svc := range doc.ServiceEndpoints {
+			if !contains(patch.IDs, svc.ID) {
+				services = append(services, svc)
+			}
+		}
+
+		doc.ServiceEndpoints = services
+	default:
+		return fmt.Errorf("unsupported patch action: %s", patch.Action)
+	}
+
+	return nil
+}
+
+func decodeDelta(encoded, expectedHash string, delta *protocol.Delta) error {
+	hash, err := protocol.Decode(encoded, delta)
+	if err != nil {
+		return fmt.Errorf("delta: %w", err)
+	}
+
+	if hash != expectedHash {
+		return errors.New("delta hash does not match")
+	}
+
+	return nil
+}
+
+func verify(jws string, signedData interface{}, keyOf func() *jose.JWK, commitment string) error {
+	if err := protocol.Verify(jws, signedData, keyOf); err != nil {
+		return fmt.Errorf("verify signed data: %w", err)
+	}
+
+	c, err := protocol.Commitment(keyOf())
+	if err != nil {
+		return err
+	}
+
+	if c != commitment {
+		return errors.New("key does not match the commitment")
+	}
+
+	return nil
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,205 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package node
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/sidetree/internal/protocol"
+)
+
+const namespace = "did:sidetree"
+
+type signer struct {
+	privKey ed25519.PrivateKey
+	jwk     *jose.JWK
+}
+
+func (s *signer) Sign(data []byte) ([]byte, error) {
+	return ed25519.Sign(s.privKey, data), nil
+}
+
+func (s *signer) PublicKey() *jose.JWK {
+	return s.jwk
+}
+
+func newSigner(t *testing.T) *signer {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	jwk, err := jose.JWKFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	return &signer{privKey: privKey, jwk: jwk}
+}
+
+func commitment(t *testing.T, s *signer) string {
+	c, err := protocol.Commitment(s.jwk)
+	require.NoError(t, err)
+
+	return c
+}
+
+func post(t *testing.T, n *Node, req interface{}) (int, string) {
+	reqBytes, err := json.Marshal(req)
+	require.NoError(t, err)
+
+	resp, err := http.Post(n.URL()+OperationsPath, "application/json", bytes.NewReader(reqBytes)) // nolint: noctx
+	require.NoError(t, err)
+
+	defer func() { require.NoError(t, resp.Body.Close()) }()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	return resp.StatusCode, string(body)
+}
+
+func create(t *testing.T, n *Node, updateKey, recoveryKey *signer) (*protocol.CreateRequest, string) {
+	delta, deltaHash, err := protocol.Encode(&protocol.Delta{
+		UpdateCommitment: commitment(t, updateKey),
+		Patches: []protocol.Patch{{Action: protocol.PatchActionAddServiceEndpoints, ServiceEndpoints: []protocol.Service{
+			{ID: "agent", Type: "did-communication", Endpoint: "https://agent.example.com"},
+		}}},
+	})
+	require.NoError(t, err)
+
+	suffixData, suffix, err := protocol.Encode(&protocol.SuffixData{
+		DeltaHash:          deltaHash,
+		RecoveryCommitment: commitment(t, recoveryKey),
+	})
+	require.NoError(t, err)
+
+	req := &protocol.CreateRequest{Operation: protocol.OperationTypeCreate, SuffixData: suffixData, Delta: delta}
+
+	code, body := post(t, n, req)
+	require.Equal(t, http.StatusOK, code, body)
+	require.Contains(t, body, namespace+":"+suffix)
+
+	return req, suffix
+}
+
+func TestNode(t *testing.T) {
+	n := New(namespace)
+	defer n.Close()
+
+	updateKey := newSigner(t)
+	createReq, suffix := create(t, n, updateKey, newSigner(t))
+
+	t.Run("test duplicate create", func(t *testing.T) {
+		code, body := post(t, n, createReq)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, body, "already exists")
+	})
+
+	t.Run("test invalid requests", func(t *testing.T) {
+		code, body := post(t, n, map[string]string{"type": "unknown"})
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, body, "unsupported operation type: unknown")
+
+		code, _ = post(t, n, "invalid")
+		require.Equal(t, http.StatusBadRequest, code)
+
+		resp, err := http.Get(n.URL() + OperationsPath) // nolint: noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("test update validation", func(t *testing.T) {
+		delta, deltaHash, err := protocol.Encode(&protocol.Delta{
+			UpdateCommitment: commitment(t, newSigner(t)),
+			Patches:          []protocol.Patch{{Action: protocol.PatchActionRemoveServiceEndpoints, IDs: []string{"agent"}}},
+		})
+		require.NoError(t, err)
+
+		update := func(key *signer, hash, didSuffix string) (int, string) {
+			signedData, err := protocol.Sign(&protocol.UpdateSignedData{UpdateKey: key.jwk, DeltaHash: hash}, key)
+			require.NoError(t, err)
+
+			return post(t, n, &protocol.UpdateRequest{
+				Operation:  protocol.OperationTypeUpdate,
+				DIDSuffix:  didSuffix,
+				SignedData: signedData,
+				Delta:      delta,
+			})
+		}
+
+		code, body := update(newSigner(t), deltaHash, suffix)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, body, "key does not match the commitment")
+
+		code, body = update(updateKey, "invalid", suffix)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, body, "delta hash does not match")
+
+		code, _ = update(updateKey, deltaHash, "unknown")
+		require.Equal(t, http.StatusNotFound, code)
+
+		code, body = update(updateKey, deltaHash, suffix)
+		require.Equal(t, http.StatusOK, code, body)
+		require.NotContains(t, body, "agent.example.com")
+
+		// the update key was rotated
+		code, body = update(updateKey, deltaHash, suffix)
+		require.Equal(t, http.StatusBadRequest, code)
+		require.Contains(t, body, "key does not match the commitment")
+	})
+
+	t.Run("test resolve", func(t *testing.T) {
+		resp, err := http.Get(n.URL() + IdentifiersPath + namespace + ":unknown") // nolint: noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+		resp, err = http.Post(n.URL()+IdentifiersPath+namespace+":"+suffix, "", nil) // nolint: noctx
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+func TestApplyPatch(t *testing.T) {
+	jwk := newSigner(t).jwk
+	doc := &protocol.Document{}
+
+	require.NoError(t, applyPatch(doc, &protocol.Patch{
+		Action:     protocol.PatchActionAddPublicKeys,
+		PublicKeys: []protocol.PublicKey{{ID: "key-1", JWK: jwk}},
+	}))
+
+	err := applyPatch(doc, &protocol.Patch{
+		Action:     protocol.PatchActionAddPublicKeys,
+		PublicKeys: []protocol.PublicKey{{ID: "key-1", JWK: jwk}},
+	})
+	require.EqualError(t, err, "add public keys patch: public key key-1 already exists")
+
+	err = applyPatch(doc, &protocol.Patch{
+		Action:     protocol.PatchActionAddPublicKeys,
+		PublicKeys: []protocol.PublicKey{{ID: "key-2"}},
+	})
+	require.EqualError(t, err, "add public keys patch: public key ID and JWK are mandatory")
+
+	require.NoError(t, applyPatch(doc, &protocol.Patch{Action: protocol.PatchActionRemovePublicKeys,
+		IDs: []string{"key-1"}}))
+	require.Empty(t, doc.PublicKeys)
+
+	err = applyPatch(doc, &protocol.Patch{Action: protocol.PatchActionReplace})
+	require.EqualError(t, err, "replace patch: document is missing")
+
+	err = applyPatch(doc, &protocol.Patch{Action: "unknown"})
+	require.EqualError(t, err, "unsupported patch action: unknown")
+}
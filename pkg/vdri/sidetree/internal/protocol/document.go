@@ -0,0 +1,62 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protocol
+
+import (
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+)
+
+// Purpose returns Sidetree public key purpose of the verification relationship.
+func Purpose(relationship did.VerificationRelationship) (string, bool) {
+	switch relationship {
+	case did.VerificationRelationshipGeneral:
+		return PurposeGeneral, true
+	case did.Authentication:
+		return PurposeAuth, true
+	case did.AssertionMethod:
+		return PurposeAssertion, true
+	case did.KeyAgreement:
+		return PurposeAgreement, true
+	case did.CapabilityDelegation:
+		return PurposeDelegation, true
+	case did.CapabilityInvocation:
+		return PurposeInvocation, true
+	default:
+		return "", false
+	}
+}
+
+// Relationship returns verification relationship of the Sidetree public key purpose.
+func Relationship(purpose string) (did.VerificationRelationship, bool) {
+	switch purpose {
+	case PurposeGeneral:
+		return did.VerificationRelationshipGeneral, true
+	case PurposeAuth:
+		return did.Authentication, true
+	case PurposeAssertion:
+		return did.AssertionMethod, true
+	case PurposeAgreement:
+		return did.KeyAgreement, true
+	case PurposeDelegation:
+		return did.CapabilityDelegation, true
+	case PurposeInvocation:
+		return did.CapabilityInvocation, true
+	default:
+		return 0, false
+	}
+}
+
+// Fragment returns the fragment of DID URL, Sidetree documents hold IDs without the DID.
+func Fragment(id string) string {
+	if i := strings.Index(id, "#"); i >= 0 {
+		return id[i+1:]
+	}
+
+	return id
+}
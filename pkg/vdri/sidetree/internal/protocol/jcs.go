@@ -0,0 +1,247 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	hexDigits = "0123456789abcdef"
+
+	// numbers with decimal exponent in (minFixedExponent, maxFixedExponent] are serialized without exponent
+	minFixedExponent = -6
+	maxFixedExponent = 21
+)
+
+// Canonicalize returns JSON Canonicalization Scheme (https://tools.ietf.org/html/rfc8785) serialization
+// of the value.
+func Canonicalize(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic interface{}
+
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := writeCanonical(&buf, generic); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	switch value := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(value))
+	case json.Number:
+		f, err := value.Float64()
+		if err != nil {
+			return fmt.Errorf("parse number %s: %w", value, err)
+		}
+
+		number, err := formatNumber(f)
+		if err != nil {
+			return err
+		}
+
+		buf.WriteString(number)
+	case string:
+		writeString(buf, value)
+	case []interface{}:
+		buf.WriteByte('[')
+
+		for i, item := range value {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+
+			if err := writeCanonical(buf, item); err != nil {
+				return err
+			}
+		}
+
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		return writeObject(buf, value)
+	default:
+		return fmt.Errorf("unexpected JSON value type %T", v)
+	}
+
+	return nil
+}
+
+// writeObject writes object members sorted by their names compared as arrays of UTF-16 code units.
+func writeObject(buf *bytes.Buffer, object map[string]interface{}) error {
+	keys := make([]string, 0, len(object))
+
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return lessUTF16(keys[i], keys[j])
+	})
+
+	buf.WriteByte('{')
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		writeString(buf, key)
+		buf.WriteByte(':')
+
+		if err := writeCanonical(buf, object[key]); err != nil {
+			return err
+		}
+	}
+
+	buf.WriteByte('}')
+
+	return nil
+}
+
+func lessUTF16(a, b string) bool {
+	u1, u2 := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+
+	for i := 0; i < len(u1) && i < len(u2); i++ {
+		if u1[i] != u2[i] {
+			return u1[i] < u2[i]
+		}
+	}
+
+	return len(u1) < len(u2)
+}
+
+// writeString writes the string escaping only quotation mark, reverse solidus and control characters.
+func writeString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 { // nolint: gomnd
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hexDigits[r>>4])
+				buf.WriteByte(hexDigits[r&0xF])
+
+				continue
+			}
+
+			buf.WriteRune(r)
+		}
+	}
+
+	buf.WriteByte('"')
+}
+
+// formatNumber formats the number as ECMAScript Number.prototype.toString() does.
+func formatNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("number %v is not allowed", f)
+	}
+
+	if f == 0 {
+		return "0", nil
+	}
+
+	sign := ""
+
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// shortest representation which round trips, e.g. 1.2345e+06
+	mantissa, exp, err := splitExponent(strconv.FormatFloat(f, 'e', -1, 64))
+	if err != nil {
+		return "", err
+	}
+
+	digits := strings.Replace(mantissa, ".", "", 1)
+	k := len(digits)
+	n := exp + 1
+
+	var result string
+
+	switch {
+	case k <= n && n <= maxFixedExponent:
+		result = digits + strings.Repeat("0", n-k)
+	case 0 < n && n <= maxFixedExponent:
+		result = digits[:n] + "." + digits[n:]
+	case minFixedExponent < n && n <= 0:
+		result = "0." + strings.Repeat("0", -n) + digits
+	default:
+		expSign := "+"
+		if n-1 < 0 {
+			expSign = "-"
+		}
+
+		result = digits[:1]
+		if k > 1 {
+			result += "." + digits[1:]
+		}
+
+		result += "e" + expSign + strconv.Itoa(abs(n-1))
+	}
+
+	return sign + result, nil
+}
+
+func splitExponent(s string) (string, int, error) {
+	i := strings.IndexByte(s, 'e')
+
+	exp, err := strconv.Atoi(s[i+1:])
+	if err != nil {
+		return "", 0, fmt.Errorf("parse exponent of %s: %w", s, err)
+	}
+
+	return s[:i], exp, nil
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+
+	return i
+}
@@ -0,0 +1,94 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protocol
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalize(t *testing.T) {
+	t.Run("RFC 8785 example", func(t *testing.T) {
+		data, err := Canonicalize(json.RawMessage(`{
+  "numbers": [333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001],
+  "string": "\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/",
+  "literals": [null, true, false]
+}`))
+		require.NoError(t, err)
+		require.Equal(t, `{"literals":[null,true,false],"numbers":[333333333.3333333,1e+30,4.5,0.002,1e-27],`+
+			`"string":"€$\u000f\nA'B\"\\\\\"/"}`, string(data))
+	})
+
+	t.Run("RFC 8785 sorting", func(t *testing.T) {
+		data, err := Canonicalize(json.RawMessage(`{
+  "\u20ac": "Euro Sign",
+  "\r": "Carriage Return",
+  "\ufb33": "Hebrew Letter Dalet With Dagesh",
+  "1": "One",
+  "\ud83d\ude00": "Emoji: Grinning Face",
+  "\u0080": "Control",
+  "\u00f6": "Latin Small Letter O With Diaeresis"
+}`))
+		require.NoError(t, err)
+		require.Equal(t, "{\"\\r\":\"Carriage Return\",\"1\":\"One\",\"\u0080\":\"Control\","+
+			"\"ö\":\"Latin Small Letter O With Diaeresis\",\"€\":\"Euro Sign\",\"😀\":\"Emoji: Grinning Face\","+
+			"\"\ufb33\":\"Hebrew Letter Dalet With Dagesh\"}", string(data))
+	})
+
+	t.Run("HTML characters are not escaped", func(t *testing.T) {
+		data, err := Canonicalize(&Service{ID: "hub", Type: "did-communication", Endpoint: "https://a.b/?c=<d>&e"})
+		require.NoError(t, err)
+		require.Equal(t, `{"endpoint":"https://a.b/?c=<d>&e","id":"hub","type":"did-communication"}`, string(data))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := Canonicalize(math.Inf(1))
+		require.Error(t, err)
+	})
+}
+
+func TestFormatNumber(t *testing.T) {
+	// RFC 8785 Appendix B
+	tests := map[uint64]string{
+		0x0000000000000000: "0",
+		0x8000000000000000: "0",
+		0x0000000000000001: "5e-324",
+		0x8000000000000001: "-5e-324",
+		0x7fefffffffffffff: "1.7976931348623157e+308",
+		0xffefffffffffffff: "-1.7976931348623157e+308",
+		0x4340000000000000: "9007199254740992",
+		0xc340000000000000: "-9007199254740992",
+		0x4430000000000000: "295147905179352830000",
+		0x44b52d02c7e14af5: "9.999999999999997e+22",
+		0x44b52d02c7e14af6: "1e+23",
+		0x44b52d02c7e14af7: "1.0000000000000001e+23",
+		0x444b1ae4d6e2ef4e: "999999999999999700000",
+		0x444b1ae4d6e2ef4f: "999999999999999900000",
+		0x444b1ae4d6e2ef50: "1e+21",
+		0x3eb0c6f7a0b5ed8c: "9.999999999999997e-7",
+		0x3eb0c6f7a0b5ed8d: "0.000001",
+		0x41b3de4355555553: "333333333.3333332",
+		0x41b3de4355555554: "333333333.33333325",
+		0x41b3de4355555555: "333333333.3333333",
+		0x41b3de4355555556: "333333333.3333334",
+		0x41b3de4355555557: "333333333.33333343",
+		0xbecbf647612f3696: "-0.0000033333333333333333",
+		0x43143ff3c1cb0959: "1424953923781206.2",
+	}
+
+	for bits, expected := range tests {
+		number, err := formatNumber(math.Float64frombits(bits))
+		require.NoError(t, err)
+		require.Equal(t, expected, number, "%x", bits)
+	}
+
+	_, err := formatNumber(math.NaN())
+	require.Error(t, err)
+}
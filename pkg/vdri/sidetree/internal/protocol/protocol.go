@@ -0,0 +1,318 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package protocol holds the Sidetree operation request models and the hashing, commitment and signing
+// rules shared by the sidetree VDRI and its in-process test node.
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/multiformats/go-multihash"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+)
+
+// Sidetree operation types.
+const (
+	OperationTypeCreate     = "create"
+	OperationTypeUpdate     = "update"
+	OperationTypeRecover    = "recover"
+	OperationTypeDeactivate = "deactivate"
+)
+
+// Sidetree patch actions.
+const (
+	PatchActionReplace                = "replace"
+	PatchActionAddPublicKeys          = "add-public-keys"
+	PatchActionRemovePublicKeys       = "remove-public-keys"
+	PatchActionAddServiceEndpoints    = "add-service-endpoints"
+	PatchActionRemoveServiceEndpoints = "remove-service-endpoints"
+)
+
+// Sidetree public key purposes.
+const (
+	PurposeGeneral    = "general"
+	PurposeAuth       = "auth"
+	PurposeAssertion  = "assertion"
+	PurposeAgreement  = "agreement"
+	PurposeDelegation = "delegation"
+	PurposeInvocation = "invocation"
+)
+
+// JWS algorithms of the operation signatures.
+const (
+	AlgEdDSA = "EdDSA"
+	AlgES256 = "ES256"
+)
+
+// CreateRequest is the create operation request.
+type CreateRequest struct {
+	Operation  string `json:"type"`
+	SuffixData string `json:"suffix_data"`
+	Delta      string `json:"delta"`
+}
+
+// UpdateRequest is the update operation request.
+type UpdateRequest struct {
+	Operation  string `json:"type"`
+	DIDSuffix  string `json:"did_suffix"`
+	SignedData string `json:"signed_data"`
+	Delta      string `json:"delta"`
+}
+
+// RecoverRequest is the recover operation request.
+type RecoverRequest struct {
+	Operation  string `json:"type"`
+	DIDSuffix  string `json:"did_suffix"`
+	SignedData string `json:"signed_data"`
+	Delta      string `json:"delta"`
+}
+
+// DeactivateRequest is the deactivate operation request.
+type DeactivateRequest struct {
+	Operation  string `json:"type"`
+	DIDSuffix  string `json:"did_suffix"`
+	SignedData string `json:"signed_data"`
+}
+
+// SuffixData is the suffix data of the create operation, the DID suffix is derived from it.
+type SuffixData struct {
+	DeltaHash          string `json:"delta_hash"`
+	RecoveryCommitment string `json:"recovery_commitment"`
+}
+
+// Delta holds the patches of the create, update and recover operations.
+type Delta struct {
+	UpdateCommitment string  `json:"update_commitment"`
+	Patches          []Patch `json:"patches"`
+}
+
+// UpdateSignedData is the signed payload of the update operation.
+type UpdateSignedData struct {
+	UpdateKey *jose.JWK `json:"update_key"`
+	DeltaHash string    `json:"delta_hash"`
+}
+
+// RecoverSignedData is the signed payload of the recover operation.
+type RecoverSignedData struct {
+	RecoveryKey        *jose.JWK `json:"recovery_key"`
+	RecoveryCommitment string    `json:"recovery_commitment"`
+	DeltaHash          string    `json:"delta_hash"`
+}
+
+// DeactivateSignedData is the signed payload of the deactivate operation.
+type DeactivateSignedData struct {
+	DIDSuffix   string    `json:"did_suffix"`
+	RecoveryKey *jose.JWK `json:"recovery_key"`
+}
+
+// Patch is the Sidetree document patch. Members of add-public-keys and add-service-endpoints patches
+// are named after the patch actions, unlike the members of the replace patch document.
+type Patch struct {
+	Action           string      `json:"action"`
+	Document         *Document   `json:"document,omitempty"`
+	PublicKeys       []PublicKey `json:"public_keys,omitempty"`
+	ServiceEndpoints []Service   `json:"service_endpoints,omitempty"`
+	IDs              []string    `json:"ids,omitempty"`
+}
+
+// Document is the Sidetree document model (the document of replace patch and create operation).
+type Document struct {
+	PublicKeys       []PublicKey `json:"publicKey,omitempty"`
+	ServiceEndpoints []Service   `json:"service,omitempty"`
+}
+
+// PublicKey is the public key of the Sidetree document.
+type PublicKey struct {
+	ID      string    `json:"id"`
+	Type    string    `json:"type"`
+	Purpose []string  `json:"purpose,omitempty"`
+	JWK     *jose.JWK `json:"jwk"`
+}
+
+// Service is the service endpoint of the Sidetree document.
+type Service struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Endpoint string `json:"endpoint"`
+}
+
+// Hash returns base64url encoded SHA2-256 multihash of the data.
+func Hash(data []byte) (string, error) {
+	mh, err := multihash.Sum(data, multihash.SHA2_256, -1)
+	if err != nil {
+		return "", fmt.Errorf("compute multihash: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(mh), nil
+}
+
+// Encode returns base64url encoded canonical JSON of the value and the hash of the canonical JSON.
+func Encode(v interface{}) (encoded, hash string, err error) {
+	data, err := Canonicalize(v)
+	if err != nil {
+		return "", "", fmt.Errorf("canonicalize: %w", err)
+	}
+
+	hash, err = Hash(data)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), hash, nil
+}
+
+// Decode decodes base64url encoded JSON into the value and returns the hash of the JSON.
+func Decode(encoded string, v interface{}) (string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("decode: %w", err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return "", fmt.Errorf("unmarshal: %w", err)
+	}
+
+	return Hash(data)
+}
+
+// Commitment returns the commitment of the public key, i.e. the hash of its canonical JWK.
+func Commitment(jwk *jose.JWK) (string, error) {
+	data, err := Canonicalize(jwk)
+	if err != nil {
+		return "", fmt.Errorf("canonicalize JWK: %w", err)
+	}
+
+	return Hash(data)
+}
+
+// Algorithm returns JWS algorithm of the operation key.
+func Algorithm(jwk *jose.JWK) (string, error) {
+	switch key := jwk.Key.(type) {
+	case ed25519.PublicKey:
+		return AlgEdDSA, nil
+	case *ecdsa.PublicKey:
+		if key.Curve == elliptic.P256() {
+			return AlgES256, nil
+		}
+	}
+
+	return "", errors.New("unsupported operation key type")
+}
+
+// Signer signs the operation signed data.
+type Signer interface {
+	// Sign signs the data.
+	Sign(data []byte) ([]byte, error)
+	// PublicKey returns JWK of the signing key.
+	PublicKey() *jose.JWK
+}
+
+type jwsSigner struct {
+	signer Signer
+	alg    string
+}
+
+func (s *jwsSigner) Sign(data []byte) ([]byte, error) {
+	return s.signer.Sign(data)
+}
+
+func (s *jwsSigner) Headers() jose.Headers {
+	return jose.Headers{jose.HeaderAlgorithm: s.alg}
+}
+
+// Sign returns compact JWS of the canonical JSON of the signed data.
+func Sign(signedData interface{}, signer Signer) (string, error) {
+	alg, err := Algorithm(signer.PublicKey())
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := Canonicalize(signedData)
+	if err != nil {
+		return "", fmt.Errorf("canonicalize signed data: %w", err)
+	}
+
+	jws, err := jose.NewJWS(nil, nil, payload, &jwsSigner{signer: signer, alg: alg})
+	if err != nil {
+		return "", err
+	}
+
+	return jws.SerializeCompact(false)
+}
+
+// Verify verifies compact JWS with the key returned by keyOf for the signed data and unmarshals
+// the signed data into the value.
+func Verify(compactJWS string, signedData interface{}, keyOf func() *jose.JWK) error {
+	verifier := jose.SignatureVerifierFunc(func(headers jose.Headers, payload, signingInput, signature []byte) error {
+		if err := json.Unmarshal(payload, signedData); err != nil {
+			return fmt.Errorf("unmarshal signed data: %w", err)
+		}
+
+		jwk := keyOf()
+		if jwk == nil {
+			return errors.New("signing key is missing in the signed data")
+		}
+
+		alg, err := Algorithm(jwk)
+		if err != nil {
+			return err
+		}
+
+		if headerAlg, _ := headers.Algorithm(); headerAlg != alg { // nolint: errcheck
+			return fmt.Errorf("unexpected signature algorithm: %s", headerAlg)
+		}
+
+		return verifySignature(jwk, signingInput, signature)
+	})
+
+	_, err := jose.ParseJWS(compactJWS, verifier)
+
+	return err
+}
+
+func verifySignature(jwk *jose.JWK, msg, signature []byte) error {
+	switch key := jwk.Key.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, msg, signature) {
+			return errors.New("invalid signature")
+		}
+
+		return nil
+	case *ecdsa.PublicKey:
+		return verifyECDSA(key, msg, signature)
+	default:
+		return errors.New("unsupported operation key type")
+	}
+}
+
+func verifyECDSA(key *ecdsa.PublicKey, msg, signature []byte) error {
+	keySize := (key.Curve.Params().BitSize + 7) / 8 // nolint: gomnd
+
+	if len(signature) != 2*keySize {
+		return errors.New("invalid signature size")
+	}
+
+	digest := sha256.Sum256(msg)
+
+	r := new(big.Int).SetBytes(signature[:keySize])
+	s := new(big.Int).SetBytes(signature[keySize:])
+
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
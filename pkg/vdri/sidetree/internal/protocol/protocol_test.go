@@ -0,0 +1,218 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+)
+
+type signer struct {
+	sign func(data []byte) ([]byte, error)
+	jwk  *jose.JWK
+}
+
+func (s *signer) Sign(data []byte) ([]byte, error) {
+	return s.sign(data)
+}
+
+func (s *signer) PublicKey() *jose.JWK {
+	return s.jwk
+}
+
+func newEd25519Signer(t *testing.T) *signer {
+	pubKey, privKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	jwk, err := jose.JWKFromPublicKey(pubKey)
+	require.NoError(t, err)
+
+	return &signer{
+		sign: func(data []byte) ([]byte, error) { return ed25519.Sign(privKey, data), nil },
+		jwk:  jwk,
+	}
+}
+
+func newES256Signer(t *testing.T, curve elliptic.Curve) *signer {
+	privKey, err := ecdsa.GenerateKey(curve, rand.Reader)
+	require.NoError(t, err)
+
+	jwk, err := jose.JWKFromPublicKey(&privKey.PublicKey)
+	require.NoError(t, err)
+
+	return &signer{
+		sign: func(data []byte) ([]byte, error) {
+			digest := sha256.Sum256(data)
+
+			r, s, err := ecdsa.Sign(rand.Reader, privKey, digest[:])
+			if err != nil {
+				return nil, err
+			}
+
+			keySize := (curve.Params().BitSize + 7) / 8
+			signature := make([]byte, 2*keySize)
+
+			copy(signature[keySize-len(r.Bytes()):keySize], r.Bytes())
+			copy(signature[2*keySize-len(s.Bytes()):], s.Bytes())
+
+			return signature, nil
+		},
+		jwk: jwk,
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	delta := &Delta{
+		UpdateCommitment: "commitment",
+		Patches:          []Patch{{Action: PatchActionRemoveServiceEndpoints, IDs: []string{"svc"}}},
+	}
+
+	encoded, hash, err := Encode(delta)
+	require.NoError(t, err)
+
+	var decoded Delta
+
+	decodedHash, err := Decode(encoded, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, hash, decodedHash)
+	require.Equal(t, delta, &decoded)
+
+	_, err = Decode("!", &decoded)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "decode")
+
+	_, err = Decode("e30x", &decoded)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unmarshal")
+
+	_, _, err = Encode(make(chan int))
+	require.Error(t, err)
+}
+
+func TestEncode_Fixture(t *testing.T) {
+	jwk, err := jose.JWKFromPublicKey(ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public())
+	require.NoError(t, err)
+
+	delta := &Delta{
+		UpdateCommitment: "EiC",
+		Patches: []Patch{{
+			Action: PatchActionReplace,
+			Document: &Document{
+				PublicKeys: []PublicKey{{
+					ID:      "key1",
+					Type:    "Ed25519VerificationKey2018",
+					Purpose: []string{PurposeAuth, PurposeGeneral},
+					JWK:     jwk,
+				}},
+				ServiceEndpoints: []Service{{
+					ID:       "hub",
+					Type:     "did-communication",
+					Endpoint: "https://example.com/didcomm",
+				}},
+			},
+		}},
+	}
+
+	data, err := Canonicalize(delta)
+	require.NoError(t, err)
+	require.Equal(t, `{"patches":[{"action":"replace","document":{"publicKey":[{"id":"key1",`+
+		`"jwk":{"crv":"Ed25519","kty":"OKP","x":"O2onvM62pC1io6jQKm8Nc2UyFXcd4kOmOsBIoYtZ2ik"},`+
+		`"purpose":["auth","general"],"type":"Ed25519VerificationKey2018"}],"service":[{`+
+		`"endpoint":"https://example.com/didcomm","id":"hub","type":"did-communication"}]}}],`+
+		`"update_commitment":"EiC"}`, string(data))
+
+	_, hash, err := Encode(delta)
+	require.NoError(t, err)
+	require.Equal(t, "EiBccLJ6FMm5XkLhyOgvFfwmH3Z7o-__SQACXSt08Tu3_w", hash)
+}
+
+func TestCommitment(t *testing.T) {
+	s1 := newEd25519Signer(t)
+	s2 := newEd25519Signer(t)
+
+	c1, err := Commitment(s1.jwk)
+	require.NoError(t, err)
+
+	c1Again, err := Commitment(s1.jwk)
+	require.NoError(t, err)
+	require.Equal(t, c1, c1Again)
+
+	c2, err := Commitment(s2.jwk)
+	require.NoError(t, err)
+	require.NotEqual(t, c1, c2)
+}
+
+func TestSignVerify(t *testing.T) {
+	for _, s := range []*signer{newEd25519Signer(t), newES256Signer(t, elliptic.P256())} {
+		jws, err := Sign(&UpdateSignedData{UpdateKey: s.jwk, DeltaHash: "hash"}, s)
+		require.NoError(t, err)
+
+		var signedData UpdateSignedData
+
+		require.NoError(t, Verify(jws, &signedData, func() *jose.JWK { return signedData.UpdateKey }))
+		require.Equal(t, "hash", signedData.DeltaHash)
+
+		// the signed data holds another key
+		other := newEd25519Signer(t)
+		jws, err = Sign(&UpdateSignedData{UpdateKey: other.jwk, DeltaHash: "hash"}, s)
+		require.NoError(t, err)
+
+		err = Verify(jws, &signedData, func() *jose.JWK { return signedData.UpdateKey })
+		require.Error(t, err)
+	}
+
+	t.Run("test missing key", func(t *testing.T) {
+		s := newEd25519Signer(t)
+
+		jws, err := Sign(&UpdateSignedData{DeltaHash: "hash"}, s)
+		require.NoError(t, err)
+
+		var signedData UpdateSignedData
+
+		err = Verify(jws, &signedData, func() *jose.JWK { return signedData.UpdateKey })
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "signing key is missing")
+	})
+
+	t.Run("test unsupported key", func(t *testing.T) {
+		s := newES256Signer(t, elliptic.P384())
+
+		_, err := Sign(&UpdateSignedData{UpdateKey: s.jwk}, s)
+		require.EqualError(t, err, "unsupported operation key type")
+	})
+}
+
+func TestPurposes(t *testing.T) {
+	for _, r := range []did.VerificationRelationship{did.VerificationRelationshipGeneral, did.Authentication,
+		did.AssertionMethod, did.KeyAgreement, did.CapabilityDelegation, did.CapabilityInvocation} {
+		purpose, ok := Purpose(r)
+		require.True(t, ok)
+
+		relationship, ok := Relationship(purpose)
+		require.True(t, ok)
+		require.Equal(t, r, relationship)
+	}
+
+	_, ok := Purpose(100)
+	require.False(t, ok)
+
+	_, ok = Relationship("unknown")
+	require.False(t, ok)
+
+	require.Equal(t, "key-1", Fragment("did:example:123#key-1"))
+	require.Equal(t, "key-1", Fragment("#key-1"))
+	require.Equal(t, "key-1", Fragment("key-1"))
+}
@@ -0,0 +1,126 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hyperledger/aries-framework-go/pkg/crypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+// operationKeys holds KMS key IDs of the current update and recovery keys of the DID.
+type operationKeys struct {
+	UpdateKeyID   string      `json:"updateKeyId"`
+	RecoveryKeyID string      `json:"recoveryKeyId"`
+	KeyType       kms.KeyType `json:"keyType"`
+}
+
+// operationKey signs the operations with the KMS key.
+type operationKey struct {
+	keyID  string
+	kh     interface{}
+	jwk    *jose.JWK
+	crypto crypto.Crypto
+}
+
+func (k *operationKey) Sign(data []byte) ([]byte, error) {
+	return k.crypto.Sign(data, k.kh)
+}
+
+func (k *operationKey) PublicKey() *jose.JWK {
+	return k.jwk
+}
+
+// newOperationKey creates new update or recovery key.
+func (v *VDRI) newOperationKey() (*operationKey, error) {
+	keyID, kh, err := v.kms.Create(v.keyType)
+	if err != nil {
+		return nil, fmt.Errorf("create operation key: %w", err)
+	}
+
+	return v.toOperationKey(keyID, kh, v.keyType)
+}
+
+// operationKey returns existing update or recovery key.
+func (v *VDRI) operationKey(keyID string, keyType kms.KeyType) (*operationKey, error) {
+	kh, err := v.kms.Get(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("get operation key: %w", err)
+	}
+
+	return v.toOperationKey(keyID, kh, keyType)
+}
+
+func (v *VDRI) toOperationKey(keyID string, kh interface{}, keyType kms.KeyType) (*operationKey, error) {
+	pubKeyBytes, err := v.kms.ExportPubKeyBytes(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("export operation public key: %w", err)
+	}
+
+	jwk, err := operationJWK(keyType, pubKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationKey{keyID: keyID, kh: kh, jwk: jwk, crypto: v.crypto}, nil
+}
+
+func operationJWK(keyType kms.KeyType, pubKeyBytes []byte) (*jose.JWK, error) {
+	switch keyType {
+	case kms.ED25519Type:
+		return jose.JWKFromPublicKey(ed25519.PublicKey(pubKeyBytes))
+	case kms.ECDSAP256TypeIEEEP1363:
+		x, y := elliptic.Unmarshal(elliptic.P256(), pubKeyBytes)
+		if x == nil {
+			return nil, errors.New("invalid ECDSA P-256 public key")
+		}
+
+		return jose.JWKFromPublicKey(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y})
+	default:
+		return nil, fmt.Errorf("unsupported operation key type: %s", keyType)
+	}
+}
+
+func (v *VDRI) getKeys(didID string) (*operationKeys, error) {
+	data, err := v.store.Get(didID)
+	if errors.Is(err, storage.ErrDataNotFound) {
+		return nil, fmt.Errorf("operation keys of %s not found: %w", didID, err)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get operation keys: %w", err)
+	}
+
+	keys := &operationKeys{}
+
+	if err = json.Unmarshal(data, keys); err != nil {
+		return nil, fmt.Errorf("unmarshal operation keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+func (v *VDRI) putKeys(didID string, keys *operationKeys) error {
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return fmt.Errorf("marshal operation keys: %w", err)
+	}
+
+	if err = v.store.Put(didID, data); err != nil {
+		return fmt.Errorf("store operation keys: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/sidetree/internal/protocol"
+)
+
+// Update submits update operation of the DID created by this vdri. The operation is signed by
+// the current update key and commits to a new update key.
+func (v *VDRI) Update(didID string, patches []vdriapi.Patch, _ *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+	suffix, err := v.suffix(didID)
+	if err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	sidetreePatches, err := toPatches(patches)
+	if err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	keys, err := v.getKeys(didID)
+	if err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	respBody, nextUpdateKey, err := v.update(suffix, keys, sidetreePatches)
+	if err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	keys.UpdateKeyID = nextUpdateKey
+
+	// the node has accepted the operation, so the next key must be kept even if the response is invalid
+	if err = v.putKeys(didID, keys); err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	doc, err := v.documentOf(didID, respBody)
+	if err != nil {
+		return nil, fmt.Errorf("update sidetree DID: %w", err)
+	}
+
+	return doc, nil
+}
+
+func (v *VDRI) update(suffix string, keys *operationKeys,
+	patches []protocol.Patch) ([]byte, string, error) {
+	updateKey, err := v.operationKey(keys.UpdateKeyID, keys.KeyType)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextUpdateKey, err := v.newOperationKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextUpdateCommitment, err := protocol.Commitment(nextUpdateKey.PublicKey())
+	if err != nil {
+		return nil, "", err
+	}
+
+	delta, deltaHash, err := protocol.Encode(&protocol.Delta{
+		UpdateCommitment: nextUpdateCommitment,
+		Patches:          patches,
+	})
+	if err != nil {
+		return nil, "", fmt.Errorf("encode delta: %w", err)
+	}
+
+	signedData, err := protocol.Sign(&protocol.UpdateSignedData{
+		UpdateKey: updateKey.PublicKey(),
+		DeltaHash: deltaHash,
+	}, updateKey)
+	if err != nil {
+		return nil, "", fmt.Errorf("sign update operation: %w", err)
+	}
+
+	respBody, err := v.submit(&protocol.UpdateRequest{
+		Operation:  protocol.OperationTypeUpdate,
+		DIDSuffix:  suffix,
+		SignedData: signedData,
+		Delta:      delta,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return respBody, nextUpdateKey.keyID, nil
+}
+
+// Recover submits recover operation of the DID created by this vdri. The content of the document
+// (public keys and services) is replaced with the one of the given document. The operation is signed by
+// the current recovery key and commits to new update and recovery keys.
+func (v *VDRI) Recover(didID string, doc *did.Doc) (*did.Doc, error) {
+	suffix, err := v.suffix(didID)
+	if err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	document, err := toDocument(doc)
+	if err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	keys, err := v.getKeys(didID)
+	if err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	respBody, nextKeys, err := v.recover(suffix, keys, document)
+	if err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	// the node has accepted the operation, so the next keys must be kept even if the response is invalid
+	if err = v.putKeys(didID, nextKeys); err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	recovered, err := v.documentOf(didID, respBody)
+	if err != nil {
+		return nil, fmt.Errorf("recover sidetree DID: %w", err)
+	}
+
+	return recovered, nil
+}
+
+func (v *VDRI) recover(suffix string, keys *operationKeys,
+	document *protocol.Document) ([]byte, *operationKeys, error) {
+	recoveryKey, err := v.operationKey(keys.RecoveryKeyID, keys.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextUpdateKey, err := v.newOperationKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextRecoveryKey, err := v.newOperationKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextUpdateCommitment, err := protocol.Commitment(nextUpdateKey.PublicKey())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nextRecoveryCommitment, err := protocol.Commitment(nextRecoveryKey.PublicKey())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delta, deltaHash, err := protocol.Encode(&protocol.Delta{
+		UpdateCommitment: nextUpdateCommitment,
+		Patches:          []protocol.Patch{{Action: protocol.PatchActionReplace, Document: document}},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("encode delta: %w", err)
+	}
+
+	signedData, err := protocol.Sign(&protocol.RecoverSignedData{
+		RecoveryKey:        recoveryKey.PublicKey(),
+		RecoveryCommitment: nextRecoveryCommitment,
+		DeltaHash:          deltaHash,
+	}, recoveryKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sign recover operation: %w", err)
+	}
+
+	respBody, err := v.submit(&protocol.RecoverRequest{
+		Operation:  protocol.OperationTypeRecover,
+		DIDSuffix:  suffix,
+		SignedData: signedData,
+		Delta:      delta,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return respBody, &operationKeys{
+		UpdateKeyID:   nextUpdateKey.keyID,
+		RecoveryKeyID: nextRecoveryKey.keyID,
+		KeyType:       v.keyType,
+	}, nil
+}
+
+// Deactivate submits deactivate operation of the DID created by this vdri. The operation is signed by
+// the current recovery key.
+func (v *VDRI) Deactivate(didID string, _ *[]vdriapi.ModifiedBy) error {
+	suffix, err := v.suffix(didID)
+	if err != nil {
+		return fmt.Errorf("deactivate sidetree DID: %w", err)
+	}
+
+	keys, err := v.getKeys(didID)
+	if err != nil {
+		return fmt.Errorf("deactivate sidetree DID: %w", err)
+	}
+
+	recoveryKey, err := v.operationKey(keys.RecoveryKeyID, keys.KeyType)
+	if err != nil {
+		return fmt.Errorf("deactivate sidetree DID: %w", err)
+	}
+
+	signedData, err := protocol.Sign(&protocol.DeactivateSignedData{
+		DIDSuffix:   suffix,
+		RecoveryKey: recoveryKey.PublicKey(),
+	}, recoveryKey)
+	if err != nil {
+		return fmt.Errorf("deactivate sidetree DID: sign deactivate operation: %w", err)
+	}
+
+	_, err = v.submit(&protocol.DeactivateRequest{
+		Operation:  protocol.OperationTypeDeactivate,
+		DIDSuffix:  suffix,
+		SignedData: signedData,
+	})
+	if err != nil {
+		return fmt.Errorf("deactivate sidetree DID: %w", err)
+	}
+
+	// operation keys are useless once the DID is deactivated
+	if err = v.store.Delete(didID); err != nil {
+		logger.Warnf("failed to delete operation keys of deactivated DID %s: %v", didID, err)
+	}
+
+	return nil
+}
+
+// documentOf returns the document of the operation response or resolves it if the node did not return it.
+func (v *VDRI) documentOf(didID string, respBody []byte) (*did.Doc, error) {
+	resolution, err := v.operationResolution(didID, respBody)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolution.DIDDocument == nil || resolution.DIDDocument.ID != didID {
+		return nil, fmt.Errorf("sidetree node returned unexpected DID document, expected %s", didID)
+	}
+
+	return resolution.DIDDocument, nil
+}
+
+// toPatches converts typed DID document patches to the Sidetree patches.
+func toPatches(patches []vdriapi.Patch) ([]protocol.Patch, error) {
+	var result []protocol.Patch
+
+	for _, p := range patches {
+		patch, err := toPatch(p)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, *patch)
+	}
+
+	return result, nil
+}
+
+func toPatch(p vdriapi.Patch) (*protocol.Patch, error) {
+	switch patch := p.(type) {
+	case *vdriapi.AddPublicKeysPatch:
+		purposes := []string{protocol.PurposeGeneral}
+
+		for _, r := range patch.Relationships {
+			purpose, ok := protocol.Purpose(r)
+			if !ok {
+				return nil, fmt.Errorf("unsupported verification relationship: %d", r)
+			}
+
+			if purpose != protocol.PurposeGeneral {
+				purposes = append(purposes, purpose)
+			}
+		}
+
+		var keys []protocol.PublicKey
+
+		for i := range patch.PublicKeys {
+			pk, err := toPublicKey(&patch.PublicKeys[i], purposes)
+			if err != nil {
+				return nil, err
+			}
+
+			keys = append(keys, *pk)
+		}
+
+		return &protocol.Patch{Action: protocol.PatchActionAddPublicKeys, PublicKeys: keys}, nil
+	case *vdriapi.RemovePublicKeysPatch:
+		return &protocol.Patch{Action: protocol.PatchActionRemovePublicKeys, IDs: fragments(patch.IDs)}, nil
+	case *vdriapi.AddServicesPatch:
+		return &protocol.Patch{
+			Action:           protocol.PatchActionAddServiceEndpoints,
+			ServiceEndpoints: toServices(patch.Services),
+		}, nil
+	case *vdriapi.RemoveServicesPatch:
+		return &protocol.Patch{Action: protocol.PatchActionRemoveServiceEndpoints, IDs: fragments(patch.IDs)}, nil
+	case *vdriapi.ReplacePatch:
+		if patch.Document == nil {
+			return nil, fmt.Errorf("replacement document is mandatory")
+		}
+
+		document, err := toDocument(patch.Document)
+		if err != nil {
+			return nil, err
+		}
+
+		return &protocol.Patch{Action: protocol.PatchActionReplace, Document: document}, nil
+	default:
+		return nil, fmt.Errorf("patch %T: %w", p, vdriapi.ErrOperationNotSupported)
+	}
+}
+
+func fragments(ids []string) []string {
+	result := make([]string, len(ids))
+
+	for i, id := range ids {
+		result[i] = protocol.Fragment(id)
+	}
+
+	return result
+}
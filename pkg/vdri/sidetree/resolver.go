@@ -0,0 +1,59 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const didResolutionLDJson = `application/ld+json;profile="https://w3id.org/did-resolution"`
+
+// Read implements didresolver.DidMethod.Read interface (https://w3c-ccg.github.io/did-resolution/#resolving-input)
+// The document is resolved by the Sidetree node, its method metadata holds the current commitments.
+func (v *VDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+	resolveOpts := &vdriapi.ResolveDIDOpts{}
+
+	for _, opt := range opts {
+		opt(resolveOpts)
+	}
+
+	if resolveOpts.VersionID != nil || resolveOpts.VersionTime != "" {
+		return nil, errors.New("sidetree document versions are not supported")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, v.endpointURL+identifiersPath+"/"+didID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP create get request failed: %w", err)
+	}
+
+	req.Header.Set("Accept", didResolutionLDJson)
+
+	if resolveOpts.NoCache {
+		req.Header.Set("Cache-Control", "no-cache")
+	}
+
+	data, err := v.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("resolve %s: %w", didID, err)
+	}
+
+	resolution, err := did.ParseDocumentResolution(data)
+	if err != nil {
+		return nil, fmt.Errorf("parse data returned from sidetree node: %w", err)
+	}
+
+	if err = resolution.Error(); err != nil {
+		return nil, fmt.Errorf("sidetree node: %w", err)
+	}
+
+	return resolution, nil
+}
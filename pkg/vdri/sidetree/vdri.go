@@ -0,0 +1,237 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/crypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/storage"
+)
+
+const (
+	// DefaultNamespace is the default DID namespace of the Sidetree node.
+	DefaultNamespace = "did:sidetree"
+	// StoreNamespace is the store name of the operation keys.
+	StoreNamespace = "sidetree"
+
+	operationsPath  = "/operations"
+	identifiersPath = "/identifiers"
+)
+
+var logger = log.New("aries-framework/vdri/sidetree")
+
+// provider contains dependencies of the sidetree vdri and is typically created by using aries.Context().
+type provider interface {
+	KMS() kms.KeyManager
+	Crypto() crypto.Crypto
+	StorageProvider() storage.Provider
+}
+
+// VDRI implements Sidetree based DID method. Documents are created, updated, recovered and deactivated
+// by signed operation requests submitted to the Sidetree node REST API. The update and recovery keys
+// of the operations are kept by the KMS.
+type VDRI struct {
+	endpointURL string
+	namespace   string
+	keyType     kms.KeyType
+	authToken   string
+	client      *http.Client
+	kms         kms.KeyManager
+	crypto      crypto.Crypto
+	store       storage.Store
+}
+
+// Option configures the sidetree vdri.
+type Option func(opts *VDRI)
+
+// New creates new sidetree vdri submitting operations to the Sidetree node REST API at the endpoint URL
+// (e.g. https://sidetree.example.com/sidetree/0.0.1).
+func New(endpointURL string, ctx provider, opts ...Option) (*VDRI, error) {
+	if _, err := url.ParseRequestURI(endpointURL); err != nil {
+		return nil, fmt.Errorf("base URL invalid: %w", err)
+	}
+
+	v := &VDRI{
+		endpointURL: strings.TrimSuffix(endpointURL, "/"),
+		namespace:   DefaultNamespace,
+		keyType:     kms.ED25519Type,
+		client:      &http.Client{},
+		kms:         ctx.KMS(),
+		crypto:      ctx.Crypto(),
+	}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	if !strings.HasPrefix(v.namespace, "did:") || len(strings.Split(v.namespace, ":")) < 2 ||
+		strings.Split(v.namespace, ":")[1] == "" {
+		return nil, fmt.Errorf("invalid DID namespace: %s", v.namespace)
+	}
+
+	if v.keyType != kms.ED25519Type && v.keyType != kms.ECDSAP256TypeIEEEP1363 {
+		return nil, fmt.Errorf("unsupported operation key type: %s", v.keyType)
+	}
+
+	store, err := ctx.StorageProvider().OpenStore(StoreNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("open store : %w", err)
+	}
+
+	v.store = store
+
+	return v, nil
+}
+
+// WithNamespace option is for the DID namespace of the Sidetree node, e.g. did:sidetree:test.
+func WithNamespace(namespace string) Option {
+	return func(opts *VDRI) {
+		opts.namespace = namespace
+	}
+}
+
+// WithOperationKeyType option is for the KMS key type of the update and recovery keys.
+// kms.ED25519Type (default) and kms.ECDSAP256TypeIEEEP1363 are supported.
+func WithOperationKeyType(keyType kms.KeyType) Option {
+	return func(opts *VDRI) {
+		opts.keyType = keyType
+	}
+}
+
+// WithTimeout option is for definition of HTTP(s) timeout value of the Sidetree node requests.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *VDRI) {
+		opts.client.Timeout = timeout
+	}
+}
+
+// WithTLSConfig option is for definition of secured HTTP transport using a tls.Config instance.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(opts *VDRI) {
+		opts.client.Transport = &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	}
+}
+
+// WithAuthToken option is for the bearer token of the Sidetree node requests.
+func WithAuthToken(authToken string) Option {
+	return func(opts *VDRI) {
+		opts.authToken = "Bearer " + authToken
+	}
+}
+
+// Accept did method of the namespace.
+func (v *VDRI) Accept(method string) bool {
+	return strings.Split(v.namespace, ":")[1] == method
+}
+
+// Store does nothing, the document is published to the Sidetree node by Build.
+func (v *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
+	return nil
+}
+
+// Close frees resources being maintained by vdri.
+func (v *VDRI) Close() error {
+	return nil
+}
+
+// suffix returns the unique suffix of the DID of the namespace.
+func (v *VDRI) suffix(didID string) (string, error) {
+	if !strings.HasPrefix(didID, v.namespace+":") {
+		return "", fmt.Errorf("DID %s does not belong to namespace %s", didID, v.namespace)
+	}
+
+	return strings.TrimPrefix(didID, v.namespace+":"), nil
+}
+
+// submit sends the operation request to the Sidetree node and returns the response body, which is parsed by
+// operationResolution once the operation keys are stored.
+func (v *VDRI) submit(req interface{}) ([]byte, error) {
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal operation request: %w", err)
+	}
+
+	httpReq, err := http.NewRequest(http.MethodPost, v.endpointURL+operationsPath, bytes.NewReader(reqBytes))
+	if err != nil {
+		return nil, fmt.Errorf("HTTP create post request failed: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	respBody, err := v.send(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("submit operation: %w", err)
+	}
+
+	return respBody, nil
+}
+
+// operationResolution returns the resolution of the operation response or resolves the DID
+// if the node accepted the operation without returning the document.
+func (v *VDRI) operationResolution(didID string, respBody []byte) (*did.DocResolution, error) {
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		return v.Read(didID, vdriapi.WithNoCache(true))
+	}
+
+	resolution, err := did.ParseDocumentResolution(respBody)
+	if err != nil {
+		return nil, fmt.Errorf("parse operation response: %w", err)
+	}
+
+	return resolution, nil
+}
+
+func (v *VDRI) send(req *http.Request) ([]byte, error) {
+	if v.authToken != "" {
+		req.Header.Set("Authorization", v.authToken)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP %s request failed: %w", req.Method, err)
+	}
+
+	defer closeResponseBody(resp.Body)
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body failed: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return respBody, nil
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("%w: %s", vdriapi.ErrNotFound, strings.TrimSpace(string(respBody)))
+	default:
+		return nil, fmt.Errorf("sidetree node responded [%d]: %s", resp.StatusCode,
+			strings.TrimSpace(string(respBody)))
+	}
+}
+
+func closeResponseBody(respBody io.Closer) {
+	e := respBody.Close()
+	if e != nil {
+		logger.Errorf("Failed to close response body: %v", e)
+	}
+}
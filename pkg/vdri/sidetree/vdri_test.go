@@ -0,0 +1,654 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package sidetree
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/crypto/tinkcrypto"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/kms"
+	"github.com/hyperledger/aries-framework-go/pkg/kms/localkms"
+	mockkms "github.com/hyperledger/aries-framework-go/pkg/mock/kms"
+	mockprovider "github.com/hyperledger/aries-framework-go/pkg/mock/provider"
+	"github.com/hyperledger/aries-framework-go/pkg/mock/storage"
+	"github.com/hyperledger/aries-framework-go/pkg/secretlock/noop"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri"
+	"github.com/hyperledger/aries-framework-go/pkg/vdri/sidetree/internal/node"
+)
+
+const testNamespace = "did:sidetree:test"
+
+func newProvider(t *testing.T) *mockprovider.Provider {
+	storeProvider := storage.NewMockStoreProvider()
+
+	localKMS, err := localkms.New("local-lock://custom/master/key/",
+		mockkms.NewProviderForKMS(storeProvider, &noop.NoLock{}))
+	require.NoError(t, err)
+
+	tinkCrypto, err := tinkcrypto.New()
+	require.NoError(t, err)
+
+	return &mockprovider.Provider{
+		KMSValue:             localKMS,
+		CryptoValue:          tinkCrypto,
+		StorageProviderValue: storeProvider,
+	}
+}
+
+func newVDRI(t *testing.T, opts ...Option) (*VDRI, *node.Node) {
+	n := node.New(testNamespace)
+
+	v, err := New(n.URL(), newProvider(t), append([]Option{WithNamespace(testNamespace)}, opts...)...)
+	require.NoError(t, err)
+
+	return v, n
+}
+
+func newPubKey(t *testing.T) *vdriapi.PubKey {
+	pubKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return &vdriapi.PubKey{ID: "key-1", Value: base58.Encode(pubKey), Type: ed25519KeyType}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("test success", func(t *testing.T) {
+		v, err := New("https://sidetree.example.com/sidetree/0.0.1/", newProvider(t),
+			WithTimeout(time.Second), WithTLSConfig(&tls.Config{}), WithAuthToken("token"))
+		require.NoError(t, err)
+		require.Equal(t, "https://sidetree.example.com/sidetree/0.0.1", v.endpointURL)
+		require.Equal(t, DefaultNamespace, v.namespace)
+		require.Equal(t, "Bearer token", v.authToken)
+		require.True(t, v.Accept("sidetree"))
+		require.False(t, v.Accept("peer"))
+		require.NoError(t, v.Store(nil, nil))
+		require.NoError(t, v.Close())
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		_, err := New("invalid", newProvider(t))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "base URL invalid")
+
+		_, err = New("https://sidetree.example.com", newProvider(t), WithNamespace("sidetree"))
+		require.EqualError(t, err, "invalid DID namespace: sidetree")
+
+		_, err = New("https://sidetree.example.com", newProvider(t), WithOperationKeyType(kms.RSARS256Type))
+		require.EqualError(t, err, "unsupported operation key type: RSARS256")
+
+		_, err = New("https://sidetree.example.com", &mockprovider.Provider{
+			StorageProviderValue: &storage.MockStoreProvider{ErrOpenStoreHandle: errors.New("open error")},
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "open error")
+	})
+}
+
+func TestVDRI_Build(t *testing.T) {
+	for _, keyType := range []kms.KeyType{kms.ED25519Type, kms.ECDSAP256TypeIEEEP1363} {
+		t.Run("test success with operation key type "+string(keyType), func(t *testing.T) {
+			v, n := newVDRI(t, WithOperationKeyType(keyType))
+			defer n.Close()
+
+			pubKey := newPubKey(t)
+
+			doc, err := v.Build(pubKey, vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+				vdriapi.WithServiceEndpoint("https://agent.example.com"))
+			require.NoError(t, err)
+			require.Regexp(t, "^"+testNamespace+":", doc.ID)
+			require.Len(t, doc.PublicKey, 1)
+			require.Equal(t, doc.ID+"#key-1", doc.PublicKey[0].ID)
+			require.Equal(t, base58.Decode(pubKey.Value), doc.PublicKey[0].Value)
+			require.Len(t, doc.Authentication, 1)
+			require.Len(t, doc.AssertionMethod, 1)
+			require.Len(t, doc.Service, 1)
+			require.Equal(t, "https://agent.example.com", doc.Service[0].ServiceEndpoint)
+
+			resolution, err := v.Read(doc.ID)
+			require.NoError(t, err)
+			require.Equal(t, doc.ID, resolution.DIDDocument.ID)
+			require.Equal(t, "1", resolution.DocumentMetadata.VersionID)
+			require.NotNil(t, resolution.DocumentMetadata.Created)
+			require.Equal(t, true, resolution.DocumentMetadata.Method["published"])
+		})
+	}
+
+	t.Run("test default key ID and no service", func(t *testing.T) {
+		v, n := newVDRI(t)
+		defer n.Close()
+
+		pubKey := newPubKey(t)
+		pubKey.ID = ""
+
+		doc, err := v.Build(pubKey)
+		require.NoError(t, err)
+		require.Equal(t, doc.ID+"#"+defaultKeyID, doc.PublicKey[0].ID)
+		require.Empty(t, doc.Service)
+	})
+
+	t.Run("test invalid public key", func(t *testing.T) {
+		v, n := newVDRI(t)
+		defer n.Close()
+
+		_, err := v.Build(nil)
+		require.EqualError(t, err, "build sidetree DID: public key is mandatory")
+
+		_, err = v.Build(&vdriapi.PubKey{Value: "abc", Type: "RsaVerificationKey2018"})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "public key type RsaVerificationKey2018 is not supported without JWK")
+
+		_, err = v.Build(&vdriapi.PubKey{Value: "abc", Type: ed25519KeyType})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "invalid Ed25519 public key")
+	})
+
+	t.Run("test node error", func(t *testing.T) {
+		v, n := newVDRI(t)
+		n.Close()
+
+		_, err := v.Build(newPubKey(t))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "HTTP POST request failed")
+	})
+
+	t.Run("test node responds without document", func(t *testing.T) {
+		n := node.New(testNamespace)
+		defer n.Close()
+
+		// the proxy accepts the operations without returning the document
+		proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			proxyReq, err := http.NewRequest(req.Method, n.URL()+req.URL.Path, req.Body)
+			require.NoError(t, err)
+
+			resp, err := http.DefaultClient.Do(proxyReq)
+			require.NoError(t, err)
+
+			defer func() { require.NoError(t, resp.Body.Close()) }()
+
+			if req.Method == http.MethodGet {
+				_, err = io.Copy(rw, resp.Body)
+				require.NoError(t, err)
+			}
+		}))
+		defer proxy.Close()
+
+		v, err := New(proxy.URL, newProvider(t), WithNamespace(testNamespace))
+		require.NoError(t, err)
+
+		doc, err := v.Build(newPubKey(t))
+		require.NoError(t, err)
+
+		updated, err := v.Update(doc.ID, []vdriapi.Patch{&vdriapi.AddServicesPatch{Services: []did.Service{{
+			ID: "#agent", Type: "did-communication", ServiceEndpoint: "https://agent.example.com",
+		}}}}, nil)
+		require.NoError(t, err)
+		require.Len(t, updated.Service, 1)
+	})
+
+	t.Run("test unexpected document", func(t *testing.T) {
+		n := node.New("did:sidetree:other")
+		defer n.Close()
+
+		v, err := New(n.URL(), newProvider(t), WithNamespace(testNamespace))
+		require.NoError(t, err)
+
+		_, err = v.Build(newPubKey(t))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sidetree node created unexpected DID document")
+	})
+}
+
+func TestVDRI_Read(t *testing.T) {
+	v, n := newVDRI(t)
+	defer n.Close()
+
+	t.Run("test not found", func(t *testing.T) {
+		_, err := v.Read(testNamespace+":unknown", vdriapi.WithNoCache(true))
+		require.Error(t, err)
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+	})
+
+	t.Run("test versions not supported", func(t *testing.T) {
+		_, err := v.Read(testNamespace+":123", vdriapi.WithVersionID("1"))
+		require.EqualError(t, err, "sidetree document versions are not supported")
+	})
+
+	t.Run("test node errors", func(t *testing.T) {
+		_, err := v.Read("did:other:123")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "sidetree node responded [400]: unknown namespace")
+
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			_, err := rw.Write([]byte(`{"didResolutionMetadata": {"error": "internalError"}}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		other, err := New(server.URL, newProvider(t))
+		require.NoError(t, err)
+
+		_, err = other.Read(DefaultNamespace + ":123")
+		require.EqualError(t, err, "sidetree node: internalError")
+	})
+}
+
+func TestVDRI_Update(t *testing.T) {
+	v, n := newVDRI(t)
+	defer n.Close()
+
+	doc, err := v.Build(newPubKey(t), vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+		vdriapi.WithServiceEndpoint("https://agent.example.com"))
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		pubKey := newPubKey(t)
+
+		updated, err := v.Update(doc.ID, []vdriapi.Patch{
+			&vdriapi.AddPublicKeysPatch{
+				PublicKeys: []did.PublicKey{
+					*did.NewPublicKeyFromBytes("#key-2", ed25519KeyType, doc.ID, base58.Decode(pubKey.Value)),
+				},
+				Relationships: []did.VerificationRelationship{did.KeyAgreement, did.VerificationRelationshipGeneral},
+			},
+			&vdriapi.RemoveServicesPatch{IDs: []string{"#agent"}},
+			&vdriapi.AddServicesPatch{Services: []did.Service{{
+				ID:              doc.ID + "#hub",
+				Type:            "hub",
+				ServiceEndpoint: "https://hub.example.com",
+			}}},
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, updated.PublicKey, 2)
+		require.Len(t, updated.KeyAgreement, 1)
+		require.Equal(t, doc.ID+"#key-2", updated.KeyAgreement[0].PublicKey.ID)
+		require.Len(t, updated.Service, 1)
+		require.Equal(t, doc.ID+"#hub", updated.Service[0].ID)
+
+		// next update is signed by the rotated update key
+		updated, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.RemovePublicKeysPatch{IDs: []string{"#key-2"}}}, nil)
+		require.NoError(t, err)
+		require.Len(t, updated.PublicKey, 1)
+		require.Empty(t, updated.KeyAgreement)
+
+		resolution, err := v.Read(doc.ID)
+		require.NoError(t, err)
+		require.Equal(t, "3", resolution.DocumentMetadata.VersionID)
+		require.NotNil(t, resolution.DocumentMetadata.Updated)
+	})
+
+	t.Run("test replace", func(t *testing.T) {
+		replacement := &did.Doc{ID: doc.ID, PublicKey: doc.PublicKey, AssertionMethod: doc.AssertionMethod}
+
+		updated, err := v.Update(doc.ID, []vdriapi.Patch{&vdriapi.ReplacePatch{Document: replacement}}, nil)
+		require.NoError(t, err)
+		require.Len(t, updated.PublicKey, 1)
+		require.Len(t, updated.AssertionMethod, 1)
+		require.Empty(t, updated.Authentication)
+		require.Empty(t, updated.Service)
+	})
+
+	t.Run("test invalid patches", func(t *testing.T) {
+		_, err := v.Update(doc.ID, []vdriapi.Patch{&vdriapi.ReplacePatch{}}, nil)
+		require.EqualError(t, err, "update sidetree DID: replacement document is mandatory")
+
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.AddPublicKeysPatch{
+			Relationships: []did.VerificationRelationship{100},
+		}}, nil)
+		require.EqualError(t, err, "update sidetree DID: unsupported verification relationship: 100")
+
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.AddPublicKeysPatch{
+			PublicKeys: []did.PublicKey{{ID: "#key-3", Type: "RsaVerificationKey2018"}},
+		}}, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "public key #key-3: public key type RsaVerificationKey2018")
+
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&unsupportedPatch{}}, nil)
+		require.Error(t, err)
+		require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+
+		// the node rejects the patch, the update key is not rotated
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.AddServicesPatch{Services: []did.Service{
+			{ID: "#agent"}, {ID: "#agent"},
+		}}}, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "service agent already exists")
+
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.RemoveServicesPatch{IDs: []string{"#agent"}}}, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("test unknown DID", func(t *testing.T) {
+		_, err := v.Update("did:other:123", nil, nil)
+		require.EqualError(t, err, "update sidetree DID: DID did:other:123 does not belong to namespace "+testNamespace)
+
+		_, err = v.Update(testNamespace+":123", nil, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "operation keys of "+testNamespace+":123 not found")
+	})
+}
+
+func TestVDRI_Recover(t *testing.T) {
+	v, n := newVDRI(t)
+	defer n.Close()
+
+	doc, err := v.Build(newPubKey(t))
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		pubKey := newPubKey(t)
+		recoveryDoc := &did.Doc{
+			PublicKey: []did.PublicKey{
+				*did.NewPublicKeyFromBytes("#recovered", ed25519KeyType, doc.ID, base58.Decode(pubKey.Value)),
+			},
+			Service: []did.Service{{ID: "#agent", Type: "did-communication", ServiceEndpoint: "https://agent.com"}},
+		}
+
+		recoveryDoc.Authentication = []did.VerificationMethod{
+			*did.NewReferencedVerificationMethod(&recoveryDoc.PublicKey[0], did.Authentication, false),
+		}
+
+		recovered, err := v.Recover(doc.ID, recoveryDoc)
+		require.NoError(t, err)
+		require.Len(t, recovered.PublicKey, 1)
+		require.Equal(t, doc.ID+"#recovered", recovered.PublicKey[0].ID)
+		require.Len(t, recovered.Authentication, 1)
+		require.Len(t, recovered.Service, 1)
+
+		// operations are signed by the new keys
+		_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.RemoveServicesPatch{IDs: []string{"#agent"}}}, nil)
+		require.NoError(t, err)
+
+		_, err = v.Recover(doc.ID, &did.Doc{})
+		require.NoError(t, err)
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		_, err := v.Recover("did:other:123", &did.Doc{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not belong to namespace")
+
+		_, err = v.Recover(doc.ID, &did.Doc{PublicKey: []did.PublicKey{{ID: "#key", Type: "unknown"}}})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "public key type unknown is not supported without JWK")
+
+		_, err = v.Recover(testNamespace+":123", &did.Doc{})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "operation keys of "+testNamespace+":123 not found")
+	})
+}
+
+func TestVDRI_Deactivate(t *testing.T) {
+	v, n := newVDRI(t)
+	defer n.Close()
+
+	doc, err := v.Build(newPubKey(t))
+	require.NoError(t, err)
+
+	t.Run("test success", func(t *testing.T) {
+		require.NoError(t, v.Deactivate(doc.ID, nil))
+
+		resolution, err := v.Read(doc.ID)
+		require.NoError(t, err)
+		require.True(t, resolution.DocumentMetadata.Deactivated)
+		require.Empty(t, resolution.DIDDocument.PublicKey)
+
+		// operation keys are deleted
+		err = v.Deactivate(doc.ID, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "operation keys of "+doc.ID+" not found")
+	})
+
+	t.Run("test errors", func(t *testing.T) {
+		err := v.Deactivate("did:other:123", nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not belong to namespace")
+	})
+
+	t.Run("test node rejects the operation", func(t *testing.T) {
+		other, err := v.Build(newPubKey(t))
+		require.NoError(t, err)
+
+		keys, err := v.getKeys(other.ID)
+		require.NoError(t, err)
+
+		// the recovery key does not match the commitment
+		keys.RecoveryKeyID = keys.UpdateKeyID
+		require.NoError(t, v.putKeys(other.ID, keys))
+
+		err = v.Deactivate(other.ID, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "key does not match the commitment")
+	})
+}
+
+func TestVDRI_DocumentNotResolved(t *testing.T) {
+	n := node.New(testNamespace)
+	defer n.Close()
+
+	var failRead int32
+
+	// the proxy accepts the operations without returning the document, the document can't be read if failRead is set
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodGet && atomic.LoadInt32(&failRead) == 1 {
+			rw.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		proxyReq, err := http.NewRequest(req.Method, n.URL()+req.URL.Path, req.Body)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(proxyReq)
+		require.NoError(t, err)
+
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+
+		if req.Method == http.MethodGet {
+			_, err = io.Copy(rw, resp.Body)
+			require.NoError(t, err)
+		}
+	}))
+	defer proxy.Close()
+
+	v, err := New(proxy.URL, newProvider(t), WithNamespace(testNamespace))
+	require.NoError(t, err)
+
+	doc, err := v.Build(newPubKey(t))
+	require.NoError(t, err)
+
+	atomic.StoreInt32(&failRead, 1)
+
+	// the operations are accepted by the node, so the keys are rotated even though the document is not returned
+	_, err = v.Update(doc.ID, []vdriapi.Patch{&vdriapi.AddServicesPatch{Services: []did.Service{{
+		ID: "#agent", Type: "did-communication", ServiceEndpoint: "https://agent.example.com",
+	}}}}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "update sidetree DID")
+
+	_, err = v.Recover(doc.ID, &did.Doc{Service: []did.Service{{
+		ID: "#hub", Type: "hub", ServiceEndpoint: "https://hub.example.com",
+	}}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "recover sidetree DID")
+
+	atomic.StoreInt32(&failRead, 0)
+
+	updated, err := v.Update(doc.ID, []vdriapi.Patch{&vdriapi.RemoveServicesPatch{IDs: []string{"#hub"}}}, nil)
+	require.NoError(t, err)
+	require.Empty(t, updated.Service)
+
+	recovered, err := v.Recover(doc.ID, &did.Doc{})
+	require.NoError(t, err)
+	require.Equal(t, doc.ID, recovered.ID)
+}
+
+func TestVDRI_InvalidOperationResponse(t *testing.T) {
+	n := node.New(testNamespace)
+	defer n.Close()
+
+	var invalidResponse int32
+
+	// the proxy returns invalid response to the operations accepted by the node if invalidResponse is set
+	proxy := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		proxyReq, err := http.NewRequest(req.Method, n.URL()+req.URL.Path, req.Body)
+		require.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(proxyReq)
+		require.NoError(t, err)
+
+		defer func() { require.NoError(t, resp.Body.Close()) }()
+
+		if req.Method == http.MethodPost && atomic.LoadInt32(&invalidResponse) == 1 {
+			_, err = rw.Write([]byte("accepted"))
+			require.NoError(t, err)
+
+			return
+		}
+
+		_, err = io.Copy(rw, resp.Body)
+		require.NoError(t, err)
+	}))
+	defer proxy.Close()
+
+	v, err := New(proxy.URL, newProvider(t), WithNamespace(testNamespace))
+	require.NoError(t, err)
+
+	keyStore, ok := v.store.(*storage.MockStore)
+	require.True(t, ok)
+
+	atomic.StoreInt32(&invalidResponse, 1)
+
+	// the operations are accepted by the node, so the keys are kept even though the response is invalid
+	_, err = v.Build(newPubKey(t))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "parse operation response")
+
+	var didID string
+
+	for k := range keyStore.Store {
+		if strings.HasPrefix(k, "did:") {
+			didID = k
+		}
+	}
+
+	require.NotEmpty(t, didID)
+
+	_, err = v.Update(didID, []vdriapi.Patch{&vdriapi.AddServicesPatch{Services: []did.Service{{
+		ID: "#agent", Type: "did-communication", ServiceEndpoint: "https://agent.example.com",
+	}}}}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "parse operation response")
+
+	_, err = v.Recover(didID, &did.Doc{Service: []did.Service{{
+		ID: "#hub", Type: "hub", ServiceEndpoint: "https://hub.example.com",
+	}}})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "parse operation response")
+
+	atomic.StoreInt32(&invalidResponse, 0)
+
+	updated, err := v.Update(didID, []vdriapi.Patch{&vdriapi.RemoveServicesPatch{IDs: []string{"#hub"}}}, nil)
+	require.NoError(t, err)
+	require.Equal(t, didID, updated.ID)
+	require.Empty(t, updated.Service)
+
+	recovered, err := v.Recover(didID, &did.Doc{})
+	require.NoError(t, err)
+	require.Equal(t, didID, recovered.ID)
+}
+
+func TestVDRI_Keys(t *testing.T) {
+	v, n := newVDRI(t)
+	defer n.Close()
+
+	t.Run("test invalid stored keys", func(t *testing.T) {
+		require.NoError(t, v.store.Put(testNamespace+":123", []byte("{")))
+
+		_, err := v.getKeys(testNamespace + ":123")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unmarshal operation keys")
+
+		require.NoError(t, v.putKeys(testNamespace+":123", &operationKeys{UpdateKeyID: "unknown"}))
+
+		_, err = v.Update(testNamespace+":123", nil, nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "get operation key")
+	})
+
+	t.Run("test unsupported key type", func(t *testing.T) {
+		_, err := operationJWK(kms.RSARS256Type, nil)
+		require.EqualError(t, err, "unsupported operation key type: RSARS256")
+
+		_, err = operationJWK(kms.ECDSAP256TypeIEEEP1363, []byte("invalid"))
+		require.EqualError(t, err, "invalid ECDSA P-256 public key")
+	})
+
+	t.Run("test store errors", func(t *testing.T) {
+		v.store = &storage.MockStore{Store: map[string][]byte{}, ErrGet: fmt.Errorf("get error")}
+
+		_, err := v.getKeys(testNamespace + ":123")
+		require.EqualError(t, err, "get operation keys: get error")
+
+		v.store = &storage.MockStore{Store: map[string][]byte{}, ErrPut: fmt.Errorf("put error")}
+
+		_, err = v.Build(newPubKey(t))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "put error")
+	})
+}
+
+type unsupportedPatch struct{}
+
+func (p *unsupportedPatch) Apply(*did.Doc) error {
+	return nil
+}
+
+func TestVDRI_Registry(t *testing.T) {
+	n := node.New(DefaultNamespace)
+	defer n.Close()
+
+	p := newProvider(t)
+
+	v, err := New(n.URL(), p)
+	require.NoError(t, err)
+
+	registry := vdri.New(p, vdri.WithVDRI(v))
+
+	doc, err := registry.Create("sidetree", vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+		vdriapi.WithServiceEndpoint("https://agent.example.com"))
+	require.NoError(t, err)
+
+	resolved, err := registry.Resolve(doc.ID)
+	require.NoError(t, err)
+	require.Equal(t, doc.ID, resolved.ID)
+
+	updated, err := registry.Update(doc.ID, &vdriapi.RemoveServicesPatch{IDs: []string{"#agent"}})
+	require.NoError(t, err)
+	require.Empty(t, updated.Service)
+
+	require.NoError(t, registry.Deactivate(doc.ID))
+
+	resolution, err := registry.ResolveWithMetadata(doc.ID)
+	require.NoError(t, err)
+	require.True(t, resolution.DocumentMetadata.Deactivated)
+}
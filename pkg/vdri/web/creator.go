@@ -0,0 +1,85 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const (
+	schemaV1     = "https://www.w3.org/ns/did/v1"
+	defaultKeyID = "key-1"
+)
+
+// Build builds new did:web DID document for the domain of the VDRI. The document is ready to be hosted
+// at the URL returned by DIDToURL.
+func (v *VDRI) Build(pubKey *vdriapi.PubKey, opts ...vdriapi.DocOpts) (*did.Doc, error) {
+	if v.domain == "" {
+		return nil, errors.New("build did:web DID: domain is not defined")
+	}
+
+	if pubKey == nil || pubKey.Value == "" {
+		return nil, errors.New("build did:web DID: public key is mandatory")
+	}
+
+	docOpts := &vdriapi.CreateDIDOpts{}
+	// Apply options
+	for _, opt := range opts {
+		opt(docOpts)
+	}
+
+	didID, err := DomainToDID(v.domain)
+	if err != nil {
+		return nil, fmt.Errorf("build did:web DID: %w", err)
+	}
+
+	keyID := pubKey.ID
+	if keyID == "" {
+		keyID = defaultKeyID
+	}
+
+	publicKey := did.NewPublicKeyFromBytes(didID+"#"+keyID, pubKey.Type, didID, base58.Decode(pubKey.Value))
+
+	// Service model to be included only if service type is provided through opts
+	var service []did.Service
+
+	if docOpts.ServiceType != "" {
+		s := did.Service{
+			ID:              didID + "#agent",
+			Type:            docOpts.ServiceType,
+			ServiceEndpoint: docOpts.ServiceEndpoint,
+			RoutingKeys:     docOpts.RoutingKeys,
+		}
+
+		if docOpts.ServiceType == vdriapi.DIDCommServiceType {
+			s.RecipientKeys = []string{pubKey.Value}
+		}
+
+		service = append(service, s)
+	}
+
+	// Created/Updated time
+	t := time.Now()
+
+	return &did.Doc{
+		Context:         []string{schemaV1},
+		ID:              didID,
+		PublicKey:       []did.PublicKey{*publicKey},
+		Authentication:  []did.VerificationMethod{*did.NewReferencedVerificationMethod(publicKey, did.Authentication, false)},  //nolint: lll
+		AssertionMethod: []did.VerificationMethod{*did.NewReferencedVerificationMethod(publicKey, did.AssertionMethod, false)}, //nolint: lll
+		Service:         service,
+		Created:         &t,
+		Updated:         &t,
+	}, nil
+}
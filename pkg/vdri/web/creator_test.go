@@ -0,0 +1,72 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+func TestVDRI_Build(t *testing.T) {
+	pubKey := &vdriapi.PubKey{
+		Type:  "Ed25519VerificationKey2018",
+		Value: base58.Encode([]byte("public key")),
+	}
+
+	t.Run("success", func(t *testing.T) {
+		v := New(WithDomain("example.com:8443/issuer"))
+
+		doc, err := v.Build(pubKey, vdriapi.WithServiceType(vdriapi.DIDCommServiceType),
+			vdriapi.WithServiceEndpoint("https://example.com/didcomm"))
+		require.NoError(t, err)
+		require.Equal(t, "did:web:example.com%3A8443:issuer", doc.ID)
+		require.Equal(t, doc.ID+"#key-1", doc.PublicKey[0].ID)
+		require.Equal(t, doc.ID, doc.PublicKey[0].Controller)
+		require.Len(t, doc.Authentication, 1)
+		require.Len(t, doc.AssertionMethod, 1)
+		require.Len(t, doc.Service, 1)
+		require.Equal(t, []string{pubKey.Value}, doc.Service[0].RecipientKeys)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		parsed, err := did.ParseDocument(docBytes)
+		require.NoError(t, err)
+		require.Equal(t, doc.ID, parsed.ID)
+	})
+
+	t.Run("custom key ID", func(t *testing.T) {
+		doc, err := New(WithDomain("example.com")).Build(&vdriapi.PubKey{
+			ID:    "signing",
+			Type:  pubKey.Type,
+			Value: pubKey.Value,
+		})
+		require.NoError(t, err)
+		require.Equal(t, "did:web:example.com#signing", doc.PublicKey[0].ID)
+		require.Empty(t, doc.Service)
+	})
+
+	t.Run("domain is not defined", func(t *testing.T) {
+		_, err := New().Build(pubKey)
+		require.EqualError(t, err, "build did:web DID: domain is not defined")
+	})
+
+	t.Run("public key is not defined", func(t *testing.T) {
+		_, err := New(WithDomain("example.com")).Build(nil)
+		require.EqualError(t, err, "build did:web DID: public key is mandatory")
+	})
+
+	t.Run("invalid domain", func(t *testing.T) {
+		_, err := New(WithDomain("/")).Build(pubKey)
+		require.EqualError(t, err, "build did:web DID: domain is mandatory")
+	})
+}
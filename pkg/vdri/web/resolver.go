@@ -0,0 +1,144 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const (
+	wellKnownPath = "/.well-known"
+	didJSONFile   = "/did.json"
+)
+
+// DIDToURL maps did:web DID to the HTTPS URL of its DID document. Domain-only DIDs are mapped to
+// /.well-known/did.json, path-based DIDs (did:web:example.com:user:alice) are mapped to /user/alice/did.json.
+// Port is percent-encoded in the DID (did:web:example.com%3A8443).
+func DIDToURL(didID string) (string, error) {
+	if !strings.HasPrefix(didID, didPrefix) {
+		return "", fmt.Errorf("invalid did:web DID: %s", didID)
+	}
+
+	segments := strings.Split(strings.TrimPrefix(didID, didPrefix), ":")
+
+	for i, segment := range segments {
+		if segment == "" {
+			return "", fmt.Errorf("invalid did:web DID: %s", didID)
+		}
+
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", fmt.Errorf("invalid did:web DID %s: %w", didID, err)
+		}
+
+		// the decoded segment must not change the document path (e.g. escape it with "..")
+		if strings.Contains(decoded, "/") || decoded == "." || decoded == ".." {
+			return "", fmt.Errorf("invalid did:web DID: %s", didID)
+		}
+
+		segments[i] = decoded
+	}
+
+	docPath := wellKnownPath
+	if len(segments) > 1 {
+		docPath = "/" + strings.Join(segments[1:], "/")
+	}
+
+	u := url.URL{Scheme: "https", Host: segments[0], Path: docPath + didJSONFile}
+
+	if _, err := url.ParseRequestURI(u.String()); err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("invalid did:web DID: %s", didID)
+	}
+
+	return u.String(), nil
+}
+
+// DomainToDID maps the domain (optionally with the port and path, e.g. "example.com:8443/user/alice")
+// to did:web DID.
+func DomainToDID(domain string) (string, error) {
+	segments := strings.Split(strings.Trim(domain, "/"), "/")
+
+	if segments[0] == "" {
+		return "", errors.New("domain is mandatory")
+	}
+
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	// Port delimiter must be percent-encoded.
+	segments[0] = strings.ReplaceAll(segments[0], ":", "%3A")
+
+	return didPrefix + strings.Join(segments, ":"), nil
+}
+
+// Read resolves did:web DID by fetching its DID document over HTTPS. The web server is the source
+// of truth of the did:web DID document, so document versions are not supported.
+func (v *VDRI) Read(didID string, opts ...vdriapi.ResolveOpts) (*did.DocResolution, error) {
+	resolveOpts := &vdriapi.ResolveDIDOpts{}
+
+	for _, opt := range opts {
+		opt(resolveOpts)
+	}
+
+	if resolveOpts.VersionID != nil || resolveOpts.VersionTime != "" {
+		return nil, errors.New("did:web document versions are not supported")
+	}
+
+	docURL, err := DIDToURL(didID)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, docURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP create get request failed: %w", err)
+	}
+
+	if resolveOpts.NoCache {
+		req.Header.Add("Cache-Control", "no-cache")
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP Get request failed: %w", err)
+	}
+
+	defer closeResponseBody(resp.Body)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body failed: %w", err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %s", vdriapi.ErrNotFound, didID)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unsupported response from %s [%d] body [%s]", docURL, resp.StatusCode, body)
+	}
+
+	doc, err := did.ParseDocument(body)
+	if err != nil {
+		return nil, fmt.Errorf("parse DID document: %w", err)
+	}
+
+	if doc.ID != didID {
+		return nil, fmt.Errorf("DID document ID %s does not match the requested DID %s", doc.ID, didID)
+	}
+
+	return did.NewDocResolution(doc), nil
+}
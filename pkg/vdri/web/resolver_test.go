@@ -0,0 +1,197 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+func TestDIDToURL(t *testing.T) {
+	tests := []struct {
+		did string
+		url string
+	}{
+		{did: "did:web:example.com", url: "https://example.com/.well-known/did.json"},
+		{did: "did:web:example.com%3A8443", url: "https://example.com:8443/.well-known/did.json"},
+		{did: "did:web:example.com:user:alice", url: "https://example.com/user/alice/did.json"},
+		{did: "did:web:example.com%3A3000:user:alice", url: "https://example.com:3000/user/alice/did.json"},
+	}
+
+	for _, tc := range tests {
+		u, err := DIDToURL(tc.did)
+		require.NoError(t, err)
+		require.Equal(t, tc.url, u)
+	}
+
+	for _, invalid := range []string{
+		"did:key:abc", "did:web:", "did:web:example.com::alice", "did:web:%zz",
+		"did:web:example.com:user%2Falice", "did:web:example.com%2Fuser", "did:web:example.com:%2F",
+		"did:web:example.com:.", "did:web:example.com:..", "did:web:example.com:%2E", "did:web:example.com:%2E%2E:admin",
+		"did:web:..",
+	} {
+		_, err := DIDToURL(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestDomainToDID(t *testing.T) {
+	didID, err := DomainToDID("example.com")
+	require.NoError(t, err)
+	require.Equal(t, "did:web:example.com", didID)
+
+	didID, err = DomainToDID("example.com:3000/user/alice/")
+	require.NoError(t, err)
+	require.Equal(t, "did:web:example.com%3A3000:user:alice", didID)
+
+	u, err := DIDToURL(didID)
+	require.NoError(t, err)
+	require.Equal(t, "https://example.com:3000/user/alice/did.json", u)
+
+	_, err = DIDToURL("did:web:")
+	require.Error(t, err)
+
+	_, err = DomainToDID("")
+	require.EqualError(t, err, "domain is mandatory")
+}
+
+func TestVDRI_Read(t *testing.T) {
+	docs := map[string][]byte{}
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		doc, ok := docs[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if doc == nil {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		_, err := w.Write(doc)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "https://")
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(server.Certificate())
+
+	tlsConfig := &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+
+	publishDoc := func(t *testing.T, domain string, path string) string {
+		v := New(WithDomain(domain + path))
+
+		doc, err := v.Build(&vdriapi.PubKey{
+			Type:  "Ed25519VerificationKey2018",
+			Value: base58.Encode([]byte("public key")),
+		})
+		require.NoError(t, err)
+
+		docBytes, err := doc.JSONBytes()
+		require.NoError(t, err)
+
+		docURL, err := DIDToURL(doc.ID)
+		require.NoError(t, err)
+
+		docs[strings.TrimPrefix(docURL, "https://"+domain)] = docBytes
+
+		return doc.ID
+	}
+
+	t.Run("success", func(t *testing.T) {
+		v := New(WithTLSConfig(tlsConfig), WithTimeout(time.Second))
+
+		for _, path := range []string{"", "/user/alice"} {
+			didID := publishDoc(t, host, path)
+
+			resolution, err := v.Read(didID, vdriapi.WithNoCache(true))
+			require.NoError(t, err)
+			require.Equal(t, did.ContentTypeDIDLDJSON, resolution.ResolutionMetadata.ContentType)
+
+			doc := resolution.DIDDocument
+			require.Equal(t, didID, doc.ID)
+			require.Len(t, doc.PublicKey, 1)
+			require.Equal(t, []byte("public key"), doc.PublicKey[0].Value)
+		}
+	})
+
+	t.Run("versions not supported", func(t *testing.T) {
+		v := New(WithTLSConfig(tlsConfig))
+
+		_, err := v.Read(publishDoc(t, host, ""), vdriapi.WithVersionID("1"))
+		require.EqualError(t, err, "did:web document versions are not supported")
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		v := New(WithTLSConfig(tlsConfig))
+
+		_, err := v.Read("did:web:" + strings.ReplaceAll(host, ":", "%3A") + ":unknown")
+		require.True(t, errors.Is(err, vdriapi.ErrNotFound))
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		docs["/error/did.json"] = nil
+
+		v := New(WithTLSConfig(tlsConfig))
+
+		_, err := v.Read("did:web:" + strings.ReplaceAll(host, ":", "%3A") + ":error")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "unsupported response")
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		docs["/invalid/did.json"] = []byte("{")
+
+		v := New(WithTLSConfig(tlsConfig))
+
+		_, err := v.Read("did:web:" + strings.ReplaceAll(host, ":", "%3A") + ":invalid")
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "parse DID document")
+	})
+
+	t.Run("DID mismatch", func(t *testing.T) {
+		didID := publishDoc(t, host, "/bob")
+		docs["/mallory/did.json"] = docs["/bob/did.json"]
+
+		v := New(WithTLSConfig(tlsConfig))
+
+		_, err := v.Read(strings.ReplaceAll(didID, ":bob", ":mallory"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "does not match the requested DID")
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		v := New()
+
+		_, err := v.Read(publishDoc(t, host, ""))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "HTTP Get request failed")
+	})
+
+	t.Run("invalid DID", func(t *testing.T) {
+		_, err := New().Read("did:example:123")
+		require.EqualError(t, err, "invalid did:web DID: did:example:123")
+	})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package web implements did:web method support (https://w3c-ccg.github.io/did-method-web).
+// DID documents are resolved from the HTTPS did.json URL derived from the DID.
+package web
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/common/log"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+const (
+	// DIDMethod is the did:web method name.
+	DIDMethod = "web"
+
+	didPrefix = "did:" + DIDMethod + ":"
+)
+
+var logger = log.New("aries-framework/vdri/web")
+
+// VDRI implements did:web method support.
+type VDRI struct {
+	client *http.Client
+	domain string
+}
+
+// Option configures the web vdri.
+type Option func(opts *VDRI)
+
+// New returns new instance of VDRI that works with did:web method.
+func New(opts ...Option) *VDRI {
+	v := &VDRI{client: &http.Client{}}
+
+	for _, opt := range opts {
+		opt(v)
+	}
+
+	return v
+}
+
+// WithTimeout option is for definition of HTTP(s) timeout value used to fetch DID documents.
+func WithTimeout(timeout time.Duration) Option {
+	return func(opts *VDRI) {
+		opts.client.Timeout = timeout
+	}
+}
+
+// WithTLSConfig option is for definition of secured HTTP transport using a tls.Config instance.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(opts *VDRI) {
+		opts.client.Transport = &http.Transport{
+			TLSClientConfig: tlsConfig,
+		}
+	}
+}
+
+// WithDomain defines the domain (optionally with the port and path, e.g. "example.com:8443/user/alice")
+// where the DID documents built by this VDRI are hosted.
+func WithDomain(domain string) Option {
+	return func(opts *VDRI) {
+		opts.domain = domain
+	}
+}
+
+// Accept accepts did:web method.
+func (v *VDRI) Accept(method string) bool {
+	return method == DIDMethod
+}
+
+// Store is not supported as did:web documents are hosted by the DID controller.
+func (v *VDRI) Store(doc *did.Doc, by *[]vdriapi.ModifiedBy) error {
+	logger.Warnf("store not supported in web vdri, host %s at %s", doc.ID, documentURL(doc.ID))
+
+	return nil
+}
+
+// Update is not supported as did:web documents are hosted by the DID controller.
+func (v *VDRI) Update(didID string, _ []vdriapi.Patch, _ *[]vdriapi.ModifiedBy) (*did.Doc, error) {
+	return nil, fmt.Errorf("update %s: %w", didID, vdriapi.ErrOperationNotSupported)
+}
+
+// Deactivate is not supported as did:web documents are hosted by the DID controller.
+func (v *VDRI) Deactivate(didID string, _ *[]vdriapi.ModifiedBy) error {
+	return fmt.Errorf("deactivate %s: %w", didID, vdriapi.ErrOperationNotSupported)
+}
+
+// Close frees resources being maintained by VDRI.
+func (v *VDRI) Close() error {
+	return nil
+}
+
+func documentURL(didID string) string {
+	u, err := DIDToURL(didID)
+	if err != nil {
+		return ""
+	}
+
+	return u
+}
+
+func closeResponseBody(respBody io.Closer) {
+	e := respBody.Close()
+	if e != nil {
+		logger.Errorf("Failed to close response body: %v", e)
+	}
+}
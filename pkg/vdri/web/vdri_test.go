@@ -0,0 +1,41 @@
+/*
+Copyright SecureKey Technologies Inc. All Rights Reserved.
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package web
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	vdriapi "github.com/hyperledger/aries-framework-go/pkg/framework/aries/api/vdri"
+)
+
+func TestVDRI_Accept(t *testing.T) {
+	v := New()
+	require.True(t, v.Accept("web"))
+	require.False(t, v.Accept("key"))
+}
+
+func TestVDRI_Store(t *testing.T) {
+	v := New()
+	require.NoError(t, v.Store(&did.Doc{ID: "did:web:example.com"}, nil))
+	require.NoError(t, v.Store(&did.Doc{ID: "did:example:123"}, nil))
+}
+
+func TestVDRI_UpdateAndDeactivate(t *testing.T) {
+	_, err := New().Update("did:web:example.com", nil, nil)
+	require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+
+	err = New().Deactivate("did:web:example.com", nil)
+	require.True(t, errors.Is(err, vdriapi.ErrOperationNotSupported))
+}
+
+func TestVDRI_Close(t *testing.T) {
+	require.NoError(t, New().Close())
+}